@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/logger"
+	"github.com/spf13/cobra"
+)
+
+const (
+	analyzeExitAnomalies = 2
+	analyzeExitError     = 1
+)
+
+type analyzeOptions struct {
+	runningConfig string
+	dataDir       string
+	configFile    string
+	intentFile    string
+	format        string
+	outputFile    string
+	failOnAnomaly bool
+}
+
+func newAnalyzeCmd() *cobra.Command {
+	options := analyzeOptions{}
+
+	var captureFiles []string
+	for _, file := range aggregator.CaptureFiles {
+		captureFiles = append(captureFiles, fmt.Sprintf("  %-30s %s", file.Name, file.Command))
+	}
+
+	analyzeCmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Analyze captured FRR data once and print a report",
+		Long: "Runs a single anomaly analysis on a running config and captured vtysh outputs,\n" +
+			"without starting the daemon, socket or exporter. The data directory may contain:\n\n" +
+			strings.Join(captureFiles, "\n"),
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(runOfflineAnalysis(options))
+		},
+	}
+
+	analyzeCmd.Flags().StringVar(&options.runningConfig, "running-config", "", "Path to the running config of the router (show running-config)")
+	analyzeCmd.Flags().StringVar(&options.dataDir, "data-dir", "", "Directory containing the captured vtysh json outputs")
+	analyzeCmd.Flags().StringVarP(&options.configFile, "configFile", "c", "", "Optional configuration file providing the analyzer section")
+	analyzeCmd.Flags().StringVar(&options.intentFile, "intent", "", "Optional intent file, overrides the intent file of the configuration")
+	analyzeCmd.Flags().StringVarP(&options.format, "output", "o", analyzer.ReportFormatText, "Report format: text, json or junit")
+	analyzeCmd.Flags().StringVar(&options.outputFile, "output-file", "", "Write the report to a file instead of stdout")
+	analyzeCmd.Flags().BoolVar(&options.failOnAnomaly, "fail-on-anomaly", false, "Exit with code 2 if an anomaly is neither suppressed nor acknowledged")
+	analyzeCmd.MarkFlagRequired("running-config")
+	analyzeCmd.MarkFlagRequired("data-dir")
+
+	return analyzeCmd
+}
+
+// runOfflineAnalysis runs the parsers and a single analysis cycle on captured data and
+// returns the exit code of the analyze command.
+func runOfflineAnalysis(options analyzeOptions) int {
+	switch options.format {
+	case analyzer.ReportFormatText, analyzer.ReportFormatJSON, analyzer.ReportFormatJUnit:
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown output format %q, expected text, json or junit\n", options.format)
+		return analyzeExitError
+	}
+
+	analyzerConfig := configs.AnalyzerConfig{}
+	if options.configFile != "" {
+		config, err := configs.LoadConfig(options.configFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+			return analyzeExitError
+		}
+		analyzerConfig = config.Analyzer
+	}
+	if options.intentFile != "" {
+		analyzerConfig.IntentFile = options.intentFile
+	}
+
+	appLogger, err := newOfflineLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create logger: %v\n", err)
+		return analyzeExitError
+	}
+
+	if analyzerConfig.IntentFile != "" {
+		if _, err := configs.LoadIntentFile(analyzerConfig.IntentFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load intent file: %v\n", err)
+			return analyzeExitError
+		}
+	}
+
+	fullFrrData, missing, err := aggregator.LoadCapturedData(options.runningConfig, options.dataDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load captured data: %v\n", err)
+		return analyzeExitError
+	}
+	for _, file := range missing {
+		fmt.Fprintf(os.Stderr, "Warning: %s not found in %s, its data is treated as empty\n", file, options.dataDir)
+	}
+
+	detection := analyzer.InitAnalyzer(fullFrrData, appLogger, appLogger)
+	configureAnalyzer(detection, analyzerConfig, appLogger)
+	// a single cycle can never pass a hold-down
+	detection.HoldDownCycles = 1
+	detection.AnomalyAnalysis()
+
+	output := os.Stdout
+	if options.outputFile != "" {
+		output, err = os.Create(options.outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to create output file: %v\n", err)
+			return analyzeExitError
+		}
+		defer output.Close()
+	}
+
+	if err := detection.WriteReport(output, options.format); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write report: %v\n", err)
+		return analyzeExitError
+	}
+
+	if options.failOnAnomaly && detection.HasActionableAnomalies() {
+		return analyzeExitAnomalies
+	}
+	return 0
+}
+
+// newOfflineLogger discards all logs, the report of a single offline run contains all findings.
+func newOfflineLogger() (*logger.Logger, error) {
+	offlineLogger, err := logger.NewApplicationLogger("frr-mad-offline", os.DevNull)
+	if err != nil {
+		return nil, err
+	}
+	offlineLogger.SetNoneMode()
+	return offlineLogger, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/frr-mad/frr-mad/src/backend/internal/linter"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type lintOptions struct {
+	format string
+	failOn string
+}
+
+func newLintCmd() *cobra.Command {
+	options := lintOptions{}
+
+	var rules []string
+	for _, rule := range linter.Rules {
+		rules = append(rules, fmt.Sprintf("  %s %-8s %-32s %s", rule.ID, rule.Severity, rule.Name, rule.Description))
+	}
+
+	lintCmd := &cobra.Command{
+		Use:   "lint <frr-config>",
+		Short: "Check an FRR config for common mistakes",
+		Long:  "Checks a static FRR config without runtime data. Rules:\n\n" + strings.Join(rules, "\n"),
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(runLint(args[0], options))
+		},
+	}
+
+	lintCmd.Flags().StringVarP(&options.format, "output", "o", "text", "Output format: text or json")
+	lintCmd.Flags().StringVar(&options.failOn, "fail-on", "", "Exit with code 2 if a finding has at least this severity: info, warning or critical")
+
+	return lintCmd
+}
+
+// runLint prints the lint findings of an FRR config and returns the exit code of the lint command.
+func runLint(configPath string, options lintOptions) int {
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to parse FRR config: %v\n", err)
+		return analyzeExitError
+	}
+
+	result := linter.Lint(config)
+
+	switch options.format {
+	case "text":
+		err = linter.WriteText(os.Stdout, result)
+	case "json":
+		var data []byte
+		data, err = protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(result)
+		if err == nil {
+			fmt.Println(string(data))
+		}
+	default:
+		err = fmt.Errorf("unknown output format %q, expected text or json", options.format)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write findings: %v\n", err)
+		return analyzeExitError
+	}
+
+	if options.failOn != "" && linter.ExceedsSeverity(result, options.failOn) {
+		return analyzeExitAnomalies
+	}
+	return 0
+}
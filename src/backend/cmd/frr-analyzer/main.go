@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -15,7 +17,9 @@ import (
 	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
 	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
 	"github.com/frr-mad/frr-mad/src/backend/internal/exporter"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
 	socket "github.com/frr-mad/frr-mad/src/backend/internal/socket"
+	"github.com/frr-mad/frr-mad/src/backend/internal/supervisor"
 	"github.com/frr-mad/frr-mad/src/logger"
 	"github.com/spf13/cobra"
 )
@@ -38,26 +42,32 @@ type FrrMadApp struct {
 	Aggregator   *aggregator.Collector
 	Exporter     *exporter.Exporter
 	Socket       *socket.Socket
+	Health       *health.Health
 	Logger       *LoggerService
 	Config       ServiceConfig
 	Pid          int
 	PidFile      string
 	PollInterval time.Duration
+
+	// reloadMutex serializes reloads and guards the settings they change
+	reloadMutex sync.Mutex
+	startCmd    *cobra.Command
 }
 
 type ServiceConfig struct {
 	basis      configs.DefaultConfig
 	socket     configs.SocketConfig
 	aggregator configs.AggregatorConfig
+	analyzer   configs.AnalyzerConfig
 	exporter   configs.ExporterConfig
 }
 
 type LoggerService struct {
 	Application *logger.Logger
 	Anomaly     *logger.Logger
+	Audit       *logger.Logger
 }
 
-// TODO: create status command
 func main() {
 	var configFile string
 	var rootCmd = &cobra.Command{
@@ -72,14 +82,10 @@ func main() {
 		Run: func(cmd *cobra.Command, args []string) {
 			app := loadMadApplication(configFile)
 			if os.Getenv("FRR_MAD_DAEMON") != "1" {
-				command := exec.Command(os.Args[0], os.Args[1:]...)
-				command.Env = append(os.Environ(), "FRR_MAD_DAEMON=1")
-				command.Start()
-
-				app.Logger.Application.WithAttrs(map[string]interface{}{
-					"child_pid":   command.Process.Pid,
-					"config_file": configFile,
-				}).Info("FRR-MAD daemon started")
+				if err := app.spawnDaemon(os.Args[1:], configFile); err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
 				os.Exit(0)
 			} else {
 				app.startApp(cmd)
@@ -96,22 +102,17 @@ func main() {
 		},
 	}
 
+	var restartTimeout time.Duration
 	var restartCmd = &cobra.Command{
 		Use:   "restart",
 		Short: "Restart the FRR-MAD application",
+		Long:  "Stops the running daemon, waits until its process exited and starts it again.",
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("Restart FRR-MAD application...")
-			fmt.Println("Not implemented yet. Please restart the application manually with stop and start.")
-		},
-	}
-
-	var reloadCmd = &cobra.Command{
-		Use:    "reload",
-		Short:  "Reload the FRR-MAD configuration",
-		Hidden: true,
-		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("Reloading the FRR-MAD configuration...")
-			fmt.Println("Not implemented yet. Please restart the application manually with stop and start.")
+			app := loadMadApplication(configFile)
+			if err := app.restartApp(configFile, restartTimeout); err != nil {
+				fmt.Println(err)
+				os.Exit(1)
+			}
 		},
 	}
 
@@ -149,6 +150,8 @@ func main() {
 
 	startCmd.Flags().StringVarP(&configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
 	debugCmd.Flags().StringVarP(&configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
+	restartCmd.Flags().StringVarP(&configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
+	restartCmd.Flags().DurationVar(&restartTimeout, "timeout", time.Minute, "Time to wait for the running daemon to exit")
 	startCmd.Flags().Bool("ospf-router", false, "Enable OSPF router metrics")
 	startCmd.Flags().Bool("ospf-network", false, "Enable OSPF network metrics")
 	startCmd.Flags().Bool("ospf-summary", false, "Enable OSPF summary metrics")
@@ -174,9 +177,13 @@ func main() {
 	rootCmd.AddCommand(startCmd)
 	rootCmd.AddCommand(stopCmd)
 	rootCmd.AddCommand(restartCmd)
-	rootCmd.AddCommand(reloadCmd)
+	rootCmd.AddCommand(newReloadCmd())
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(newStatusCmd())
+	rootCmd.AddCommand(newAnalyzeCmd())
+	rootCmd.AddCommand(newWhatIfCmd())
+	rootCmd.AddCommand(newLintCmd())
 	rootCmd.AddCommand(testCmd)
 
 	rootCmd.CompletionOptions.DisableDefaultCmd = false
@@ -196,6 +203,9 @@ func (a *FrrMadApp) startApp(cmd *cobra.Command) {
 
 	pidFile := a.createPidFile()
 	defer os.Remove(pidFile)
+	argsFile := a.createArgsFile(startArgs(cmd))
+	defer os.Remove(argsFile)
+	a.startCmd = cmd
 
 	services := []string{}
 	services = append(services, "analyzer")
@@ -203,70 +213,136 @@ func (a *FrrMadApp) startApp(cmd *cobra.Command) {
 
 	for _, service := range services {
 		serviceLogger := a.Logger.Application.WithComponent(service)
-		serviceLogger.Info("Starting service")
+		serviceLogger.Info("Initializing service")
 
 		switch service {
 		case "analyzer":
 			if a.Aggregator == nil {
 				aggLogger := serviceLogger.WithComponent("aggregator")
-				a.Aggregator = startAggregator(a.Config.aggregator, aggLogger, a.PollInterval)
+				a.Aggregator = initAggregator(a.Config.aggregator, aggLogger, a.PollInterval)
 			}
 
 			analyzerLogger := serviceLogger.WithComponent("analyzer")
-			a.Analyzer = startAnalyzer(analyzerLogger, a.Logger.Anomaly, a.PollInterval, a.Aggregator)
+			a.Analyzer = initAnalyzer(a.Config.analyzer, analyzerLogger, a.Logger.Anomaly, a.PollInterval, a.Aggregator)
 
 		case "exporter":
 			if a.Exporter == nil {
 				expLogger := serviceLogger.WithComponent("exporter")
 				getFlagConfigsFromCmd(cmd, &a.Config.exporter)
-				a.Exporter = startExporter(a.Config.exporter, expLogger, a.PollInterval, a.Aggregator.FullFrrData, a.Analyzer.AnalysisResult)
+				a.Exporter = initExporter(a.Config.exporter, expLogger, a.PollInterval, a.Aggregator.FullFrrData, a.Analyzer.AnalysisResult)
 			}
 		}
 	}
 
 	// TODO: Create a better handler for p2pMapping. This should ideally be part of FullFrrData and not a separate data object.
-	if a.Aggregator != nil && a.Analyzer != nil && a.Exporter != nil {
-		a.Socket = socket.NewSocket(a.Config.socket, a.Aggregator.FullFrrData, a.Analyzer.AnalysisResult, a.Logger.Application, a.Analyzer.AnalyserStateParserResults)
+	if a.Aggregator == nil || a.Analyzer == nil || a.Exporter == nil {
+		a.Logger.Application.Error("Cannot start socket server: required services not available")
+		os.Exit(1)
+	}
 
-		go func() {
-			a.Logger.Application.WithAttrs(map[string]interface{}{
-				"socket_path": fmt.Sprintf("%s/%s",
-					a.Config.socket.UnixSocketLocation,
-					a.Config.socket.UnixSocketName),
-			}).Info("Starting socket server")
-
-			if err := a.Socket.Start(); err != nil {
-				a.Logger.Application.WithAttrs(map[string]interface{}{
-					"error": err.Error(),
-					"socket_path": fmt.Sprintf("%s/%s",
-						a.Config.socket.UnixSocketLocation,
-						a.Config.socket.UnixSocketName),
-				}).Error("Socket server failed")
+	a.Socket = socket.NewSocket(a.Config.socket, a.Aggregator.FullFrrData, a.Analyzer.AnalysisResult, a.Logger.Application, a.Analyzer.AnalyserStateParserResults)
+	a.Socket.SetDaemonInfo(DaemonVersion, enabledFeatures(a.Analyzer))
+	a.Socket.SetAuditLogger(a.Logger.Audit)
 
-				os.Exit(1)
-			}
-		}()
+	a.Health = health.NewHealth(a.PollInterval)
+	a.Aggregator.SetHealth(a.Health)
+	a.Analyzer.SetHealth(a.Health)
+	a.Socket.SetHealth(a.Health)
 
-		a.Logger.Application.Info(fmt.Sprintf("Socket server listening at %s/%s",
-			a.Config.socket.UnixSocketLocation, a.Config.socket.UnixSocketName))
-	} else {
-		a.Logger.Application.Error("Cannot start socket server: required services not available")
-		os.Exit(1)
+	// subscribers of the socket are notified as soon as a cycle produced new data
+	a.Aggregator.AddCollectListener(a.Socket.Publish)
+	a.Analyzer.AddCycleListener(a.Socket.Publish)
+
+	// the REST API has no access control, so it is only served if enabled
+	if a.Config.exporter.RestAPI {
+		api := exporter.NewAPI(a.Socket)
+		a.Exporter.MountAPI(api)
+		a.Aggregator.AddCollectListener(api.NextGeneration)
+		a.Analyzer.AddCycleListener(api.NextGeneration)
+		a.Socket.SetAcknowledgeHandler(api.NextGeneration)
 	}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGTERM)
-	<-sigChan
+	ctx, shutdown := context.WithCancel(context.Background())
+	defer shutdown()
+	// the exit command of the socket stops the daemon like a signal
+	a.Socket.SetShutdownHandler(shutdown)
+	a.Socket.SetReloadHandler(a.reload)
+	go a.handleSignals(ctx, shutdown)
 
-	a.Logger.Application.Info("Received shutdown signal")
+	a.Logger.Application.WithAttrs(map[string]interface{}{
+		"socket_path": fmt.Sprintf("%s/%s",
+			a.Config.socket.UnixSocketLocation,
+			a.Config.socket.UnixSocketName),
+	}).Info("Starting services")
 
-	if a.Socket != nil {
-		a.Socket.Close()
+	if err := a.newSupervisor().Run(ctx); err != nil {
+		a.Logger.Application.WithAttrs(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("FRR-MAD shutdown incomplete")
+		return
 	}
 
 	a.Logger.Application.Info("FRR-MAD shutdown complete")
 }
 
+// newSupervisor registers the components in the order they depend on each other, so the
+// socket is stopped first and the aggregator last.
+func (a *FrrMadApp) newSupervisor() *supervisor.Supervisor {
+	s := supervisor.NewSupervisor(a.Logger.Application.WithComponent("supervisor"))
+	s.Health = a.Health
+	s.Add(supervisor.Component{
+		Name: "aggregator",
+		Run: func(ctx context.Context) error {
+			return aggregator.RunAggregator(ctx, a.Aggregator, a.pollInterval())
+		},
+	})
+	s.Add(supervisor.Component{
+		Name: "analyzer",
+		Run: func(ctx context.Context) error {
+			return analyzer.RunAnalyzer(ctx, a.Analyzer, a.pollInterval())
+		},
+	})
+	s.Add(supervisor.Component{Name: "exporter", Run: a.Exporter.Run})
+	s.Add(supervisor.Component{Name: "socket", Run: a.Socket.Run})
+	return s
+}
+
+// pollInterval returns the current poll interval, a reload may have changed it.
+func (a *FrrMadApp) pollInterval() time.Duration {
+	a.reloadMutex.Lock()
+	defer a.reloadMutex.Unlock()
+	return a.PollInterval
+}
+
+// handleSignals stops the daemon on SIGINT and SIGTERM and reloads the configuration on SIGHUP.
+func (a *FrrMadApp) handleSignals(ctx context.Context, shutdown context.CancelFunc) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-signals:
+			if sig == syscall.SIGHUP {
+				a.Logger.Application.Info("Received SIGHUP, reloading configuration")
+				if _, err := a.reload(); err != nil {
+					a.Logger.Application.WithAttrs(map[string]interface{}{
+						"error": err.Error(),
+					}).Error("Failed to reload configuration")
+				}
+				continue
+			}
+			a.Logger.Application.WithAttrs(map[string]interface{}{
+				"signal": sig.String(),
+			}).Info("Received shutdown signal")
+			shutdown()
+			return
+		}
+	}
+}
+
 func (a *FrrMadApp) createPidFile() string {
 	pid := os.Getpid()
 	pidFile := fmt.Sprintf("%s/frr-mad.pid", a.Config.socket.UnixSocketLocation)
@@ -281,6 +357,45 @@ func (a *FrrMadApp) createPidFile() string {
 	return pidFile
 }
 
+// startFlags are the flags of the start command which a restart passes on to the new daemon.
+var startFlags = []string{
+	"configFile", "ospf-router", "ospf-network", "ospf-summary", "ospf-asbr-summary", "ospf-external",
+	"ospf-nssa-external", "ospf-database", "ospf-neighbors", "interface-list", "route-list",
+}
+
+// startArgs returns the arguments which start a daemon with the flags set on cmd.
+func startArgs(cmd *cobra.Command) []string {
+	args := []string{"start"}
+	for _, name := range startFlags {
+		if cmd.Flags().Changed(name) {
+			args = append(args, fmt.Sprintf("--%s=%s", name, cmd.Flags().Lookup(name).Value))
+		}
+	}
+	return args
+}
+
+// createArgsFile writes the start arguments of the daemon next to its PID file, one per line,
+// so a restart starts the new daemon the same way.
+func (a *FrrMadApp) createArgsFile(args []string) string {
+	argsFile := fmt.Sprintf("%s/frr-mad.args", a.Config.socket.UnixSocketLocation)
+	if err := os.WriteFile(argsFile, []byte(strings.Join(args, "\n")), 0644); err != nil {
+		a.Logger.Application.Error(fmt.Sprintf("Failed to create args file, a restart only keeps the config file: %s", err))
+	}
+	return argsFile
+}
+
+func readArgsFile(argsFile string) ([]string, error) {
+	argsBytes, err := os.ReadFile(argsFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading args file: %v", err)
+	}
+	args := strings.Split(strings.TrimSpace(string(argsBytes)), "\n")
+	if len(args) == 0 || args[0] != "start" {
+		return nil, fmt.Errorf("invalid args file %s", argsFile)
+	}
+	return args, nil
+}
+
 func (a *FrrMadApp) stopApp() {
 	a.Logger.Application.WithAttrs(map[string]interface{}{
 		"pid":      a.Pid,
@@ -320,6 +435,66 @@ func (a *FrrMadApp) stopApp() {
 	}
 }
 
+// spawnDaemon starts the daemon in a child process with the given arguments.
+func (a *FrrMadApp) spawnDaemon(args []string, configFile string) error {
+	command := exec.Command(os.Args[0], args...)
+	command.Env = append(os.Environ(), "FRR_MAD_DAEMON=1")
+	if err := command.Start(); err != nil {
+		a.Logger.Application.Error(fmt.Sprintf("Failed to start daemon: %v", err))
+		return fmt.Errorf("failed to start daemon: %w", err)
+	}
+
+	a.Logger.Application.WithAttrs(map[string]interface{}{
+		"child_pid":   command.Process.Pid,
+		"config_file": configFile,
+	}).Info("FRR-MAD daemon started")
+	return nil
+}
+
+// restartApp stops the running daemon, waits until its process exited and starts a new one
+// with the start arguments of the stopped daemon. A config file given to restart replaces the
+// one of the stopped daemon. A daemon which is not running is only started.
+func (a *FrrMadApp) restartApp(configFile string, timeout time.Duration) error {
+	args, err := readArgsFile(fmt.Sprintf("%s/frr-mad.args", a.Config.socket.UnixSocketLocation))
+	if err != nil {
+		args = []string{"start"}
+	}
+	if configFile != "" {
+		args = append(args, "--configFile="+configFile)
+	}
+
+	if a.Pid != 0 && isProcessRunning(a.Pid) {
+		a.Logger.Application.WithAttrs(map[string]interface{}{
+			"pid": a.Pid,
+		}).Info("Stopping daemon for restart")
+
+		process, err := os.FindProcess(a.Pid)
+		if err != nil {
+			return fmt.Errorf("process with PID %d not found: %w", a.Pid, err)
+		}
+		if err := process.Signal(syscall.SIGTERM); err != nil {
+			return fmt.Errorf("failed to send SIGTERM to process %d: %w", a.Pid, err)
+		}
+		if !waitForExit(a.Pid, timeout) {
+			return fmt.Errorf("daemon with PID %d did not exit within %s, not starting a second one", a.Pid, timeout)
+		}
+	}
+
+	return a.spawnDaemon(args, configFile)
+}
+
+// waitForExit polls until the process exited and reports whether it did before the timeout.
+func waitForExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for isProcessRunning(pid) {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return true
+}
+
 func loadMadApplication(overwriteConfigPath string) *FrrMadApp {
 	configRaw, err := configs.LoadConfig(overwriteConfigPath)
 	if err != nil {
@@ -331,6 +506,7 @@ func loadMadApplication(overwriteConfigPath string) *FrrMadApp {
 		basis:      configRaw.Default,
 		socket:     configRaw.Socket,
 		aggregator: configRaw.Aggregator,
+		analyzer:   configRaw.Analyzer,
 		exporter:   configRaw.Exporter,
 	}
 
@@ -360,9 +536,16 @@ func loadMadApplication(overwriteConfigPath string) *FrrMadApp {
 	}
 	anomalyLogger.SetDebugLevel(logLevel)
 
+	auditLogger, err := logger.NewApplicationLogger("frr-mad-audit",
+		fmt.Sprintf("%v/audit.log", config.basis.LogPath))
+	if err != nil {
+		log.Fatalf("Failed to create audit logger: %v", err)
+	}
+
 	logService := &LoggerService{
 		Application: appLogger,
 		Anomaly:     anomalyLogger,
+		Audit:       auditLogger,
 	}
 
 	app := &FrrMadApp{
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/frr-mad/frr-mad/src/logger"
+	"github.com/spf13/cobra"
+)
+
+func newReloadCmd() *cobra.Command {
+	var configFile string
+	var timeout time.Duration
+
+	reloadCmd := &cobra.Command{
+		Use:   "reload",
+		Short: "Reload the FRR-MAD configuration",
+		Long: "Makes the running daemon re-read its configuration file, like SIGHUP. The poll interval,\n" +
+			"debug level, exporter metrics, analyzer suppressions, hold-down cycles, intent file and\n" +
+			"socket access rules are applied live, other changed settings are reported and need a restart.",
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(runReload(configFile, timeout))
+		},
+	}
+
+	reloadCmd.Flags().StringVarP(&configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
+	reloadCmd.Flags().DurationVar(&timeout, "timeout", 10*time.Second, "Timeout of the request to the daemon")
+
+	return reloadCmd
+}
+
+// runReload asks the daemon to reload its configuration and prints the summary it returns.
+func runReload(configFile string, timeout time.Duration) int {
+	config, err := configs.LoadConfig(configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		return 1
+	}
+	socketPath := fmt.Sprintf("%s/%s", config.Socket.UnixSocketLocation, config.Socket.UnixSocketName)
+
+	response, err := requestSocket(socketPath, &frrProto.Message{Service: "system", Command: "reload"}, timeout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Daemon not reachable: %v\n", err)
+		return 1
+	}
+	if response.Status != "success" {
+		fmt.Fprintln(os.Stderr, response.Message)
+		return 1
+	}
+	fmt.Println(response.Message)
+	return 0
+}
+
+// reload re-reads the configuration file and applies the settings which may change while the
+// daemon runs. An invalid configuration is rejected as a whole. Changed settings which need a
+// restart are reported and keep their running value until then.
+func (a *FrrMadApp) reload() (string, error) {
+	a.reloadMutex.Lock()
+	defer a.reloadMutex.Unlock()
+
+	config, err := configs.LoadConfig("")
+	if err != nil {
+		return "", fmt.Errorf("Failed to load configuration: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return "", fmt.Errorf("Invalid configuration, nothing was applied: %w", err)
+	}
+	// flags of the start command keep precedence over the file
+	if a.startCmd != nil {
+		getFlagConfigsFromCmd(a.startCmd, &config.Exporter)
+	}
+
+	var intent *configs.Intent
+	if config.Analyzer.IntentFile != "" {
+		intent, err = configs.LoadIntentFile(config.Analyzer.IntentFile)
+		if err != nil {
+			return "", fmt.Errorf("Invalid intent file, nothing was applied: %w", err)
+		}
+	}
+
+	running := &a.Config
+	var applied []string
+
+	if config.Aggregator.PollInterval != running.aggregator.PollInterval {
+		a.PollInterval = time.Duration(config.Aggregator.PollInterval) * time.Second
+		a.Aggregator.SetPollInterval(a.PollInterval)
+		a.Analyzer.SetPollInterval(a.PollInterval)
+		a.Exporter.SetPollInterval(a.PollInterval)
+		a.Health.SetPollInterval(a.PollInterval)
+		running.aggregator.PollInterval = config.Aggregator.PollInterval
+		applied = append(applied, "aggregator.pollinterval")
+	}
+
+	if config.Default.DebugLevel != running.basis.DebugLevel {
+		level := logger.ConvertLogLevelFromConfig(config.Default.DebugLevel)
+		a.Logger.Application.SetDebugLevel(level)
+		a.Logger.Anomaly.SetDebugLevel(level)
+		running.basis.DebugLevel = config.Default.DebugLevel
+		applied = append(applied, "default.debuglevel")
+	}
+
+	metrics := config.Exporter
+	metrics.Port = running.exporter.Port
+	metrics.RestAPI = running.exporter.RestAPI
+	if metrics != running.exporter {
+		a.Exporter.Reconfigure(metrics)
+		running.exporter = metrics
+		applied = append(applied, "exporter metrics")
+	}
+
+	if !reflect.DeepEqual(config.Socket.Access, running.socket.Access) {
+		a.Socket.SetAccess(config.Socket.Access)
+		running.socket.Access = config.Socket.Access
+		applied = append(applied, "socket.access")
+	}
+
+	// the intent file is read again on every reload, its content may have changed
+	analyzerConfig := config.Analyzer
+	a.Analyzer.Reconfigure(func(detection *analyzer.Analyzer) {
+		detection.HoldDownCycles = analyzerConfig.HoldDownCycles
+		detection.Suppressions = analyzerConfig.Suppressions
+		detection.Intent = intent
+	})
+	if config.Analyzer.HoldDownCycles != running.analyzer.HoldDownCycles {
+		applied = append(applied, "analyzer.holddowncycles")
+	}
+	if !reflect.DeepEqual(config.Analyzer.Suppressions, running.analyzer.Suppressions) {
+		applied = append(applied, "analyzer.suppressions")
+	}
+	if config.Analyzer.IntentFile != running.analyzer.IntentFile {
+		applied = append(applied, "analyzer.intentfile")
+	}
+	running.analyzer.HoldDownCycles = config.Analyzer.HoldDownCycles
+	running.analyzer.Suppressions = config.Analyzer.Suppressions
+	running.analyzer.IntentFile = config.Analyzer.IntentFile
+
+	restart := restartRequired(*running, config)
+
+	a.Logger.Application.WithAttrs(map[string]interface{}{
+		"config_path":      configs.ConfigLocation,
+		"applied":          applied,
+		"restart_required": restart,
+	}).Info("Configuration reloaded")
+
+	summary := "Configuration reloaded"
+	if len(applied) == 0 && len(restart) == 0 {
+		return summary + ", nothing changed", nil
+	}
+	if len(applied) > 0 {
+		summary += "\nApplied: " + strings.Join(applied, ", ")
+	}
+	if len(restart) > 0 {
+		summary += "\nNot applied, requires a restart: " + strings.Join(restart, ", ")
+	}
+	return summary, nil
+}
+
+// restartRequired lists the changed settings which are only read when the daemon starts.
+func restartRequired(running ServiceConfig, config *configs.Config) []string {
+	socket := config.Socket
+	socket.Access = running.socket.Access
+
+	settings := []struct {
+		name    string
+		changed bool
+	}{
+		{"default.tempfiles", config.Default.TempFiles != running.basis.TempFiles},
+		{"default.logpath", config.Default.LogPath != running.basis.LogPath},
+		{"socket", !reflect.DeepEqual(socket, running.socket)},
+		{"aggregator.frrconfigpath", config.Aggregator.FRRConfigPath != running.aggregator.FRRConfigPath},
+		{"aggregator.socketpath", config.Aggregator.SocketPath != running.aggregator.SocketPath},
+		{"analyzer.checks", !reflect.DeepEqual(config.Analyzer.Checks, running.analyzer.Checks)},
+		{"exporter.Port", config.Exporter.Port != running.exporter.Port},
+		{"exporter.RestAPI", config.Exporter.RestAPI != running.exporter.RestAPI},
+	}
+
+	var changed []string
+	for _, setting := range settings {
+		if setting.changed {
+			changed = append(changed, setting.name)
+		}
+	}
+	return changed
+}
@@ -13,37 +13,75 @@ import (
 	"github.com/frr-mad/frr-mad/src/logger"
 )
 
-func startAggregator(config configs.AggregatorConfig, logging *logger.Logger, pollInterval time.Duration) *aggregator.Collector {
+func initAggregator(config configs.AggregatorConfig, logging *logger.Logger, pollInterval time.Duration) *aggregator.Collector {
 	collector := aggregator.InitAggregator(config, logging)
-	aggregator.StartAggregator(collector, pollInterval)
 	logging.WithAttrs(map[string]interface{}{
 		"poll_interval": pollInterval.String(),
 		"config":        fmt.Sprintf("%+v", config),
-	}).Info("Aggregator service started successfully")
+	}).Info("Aggregator service initialized successfully")
 	return collector
 }
 
-func startAnalyzer(logging *logger.Logger, anomalyLogger *logger.Logger, pollInterval time.Duration, aggregatorService *aggregator.Collector) *analyzer.Analyzer {
+func initAnalyzer(config configs.AnalyzerConfig, logging *logger.Logger, anomalyLogger *logger.Logger, pollInterval time.Duration, aggregatorService *aggregator.Collector) *analyzer.Analyzer {
 	detection := analyzer.InitAnalyzer(aggregatorService.FullFrrData, logging, anomalyLogger)
-	analyzer.StartAnalyzer(detection, pollInterval)
+	configureAnalyzer(detection, config, logging)
 	logging.WithAttrs(map[string]interface{}{
-		"poll_interval": pollInterval.String(),
-	}).Info("Analyzer service started successfully")
+		"poll_interval":    pollInterval.String(),
+		"hold_down_cycles": detection.HoldDownCycles,
+		"suppressions":     len(detection.Suppressions),
+	}).Info("Analyzer service initialized successfully")
 	return detection
 }
 
-func startExporter(config configs.ExporterConfig, logging *logger.Logger, pollInterval time.Duration, frrData *frrProto.FullFRRData, anomalyResult *frrProto.AnomalyAnalysis) *exporter.Exporter {
+func initExporter(config configs.ExporterConfig, logging *logger.Logger, pollInterval time.Duration, frrData *frrProto.FullFRRData, anomalyResult *frrProto.AnomalyAnalysis) *exporter.Exporter {
 	metricsExporter := exporter.NewExporter(config, logging, pollInterval, frrData, anomalyResult)
-
-	metricsExporter.Start()
 	logging.WithAttrs(map[string]interface{}{
 		"poll_interval": pollInterval.String(),
 		"config":        fmt.Sprintf("%+v", config),
-	}).Info("Exporter service started successfully")
+	}).Info("Exporter service initialized successfully")
 	return metricsExporter
 }
 
 // Helper Functions
+func configureAnalyzer(detection *analyzer.Analyzer, config configs.AnalyzerConfig, logging *logger.Logger) {
+	if config.HoldDownCycles > 0 {
+		detection.HoldDownCycles = config.HoldDownCycles
+	}
+	detection.Suppressions = config.Suppressions
+	for name, check := range config.Checks {
+		enabled := check.Enabled == nil || *check.Enabled
+		if err := detection.Checks.Configure(name, enabled, check.Params); err != nil {
+			logging.WithAttrs(map[string]interface{}{
+				"check": name,
+				"error": err.Error(),
+			}).Error("Failed to configure check")
+		}
+	}
+	if config.IntentFile != "" {
+		intent, err := configs.LoadIntentFile(config.IntentFile)
+		if err != nil {
+			logging.WithAttrs(map[string]interface{}{
+				"intent_file": config.IntentFile,
+				"error":       err.Error(),
+			}).Error("Failed to load intent file, continuing without intent analysis")
+		} else {
+			detection.Intent = intent
+		}
+	}
+}
+
+// enabledFeatures lists the optional features of the analyzer the configuration enabled
+func enabledFeatures(detection *analyzer.Analyzer) []string {
+	var features []string
+	if detection.Intent != nil {
+		features = append(features, "intent")
+	}
+	if len(detection.Suppressions) > 0 {
+		features = append(features, "suppressions")
+	}
+	return features
+}
+
 func createDirectories(config *configs.Config) {
 	paths := []string{
 		config.Default.TempFiles,
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// exit codes of the status command, as expected by monitoring checks
+const (
+	statusExitOK       = 0
+	statusExitWarning  = 1
+	statusExitCritical = 2
+	statusExitUnknown  = 3
+)
+
+type statusOptions struct {
+	configFile string
+	format     string
+	timeout    time.Duration
+}
+
+func newStatusCmd() *cobra.Command {
+	options := statusOptions{}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the health of the running FRR-MAD daemon",
+		Long: "Queries the health of the daemon over its socket. The exit code follows the convention\n" +
+			"of monitoring checks: 0 ok, 1 degraded, 2 failed or not running, 3 unknown.",
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(runStatus(options))
+		},
+	}
+
+	statusCmd.Flags().StringVarP(&options.configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
+	statusCmd.Flags().StringVarP(&options.format, "output", "o", "text", "Output format: text or json")
+	statusCmd.Flags().DurationVar(&options.timeout, "timeout", 5*time.Second, "Timeout of the request to the daemon")
+
+	return statusCmd
+}
+
+// runStatus prints the health of the daemon and returns the exit code of the status command.
+func runStatus(options statusOptions) int {
+	if options.format != "text" && options.format != "json" {
+		fmt.Fprintf(os.Stderr, "Unknown output format %q, expected text or json\n", options.format)
+		return statusExitUnknown
+	}
+
+	config, err := configs.LoadConfig(options.configFile)
+	if err != nil {
+		fmt.Printf("FRR-MAD UNKNOWN - failed to load configuration: %v\n", err)
+		return statusExitUnknown
+	}
+	socketPath := fmt.Sprintf("%s/%s", config.Socket.UnixSocketLocation, config.Socket.UnixSocketName)
+
+	response, err := requestSocket(socketPath, &frrProto.Message{Service: "system", Command: "status"}, options.timeout)
+	if err != nil {
+		fmt.Printf("FRR-MAD CRITICAL - daemon not reachable: %v\n", err)
+		return statusExitCritical
+	}
+	status := response.GetData().GetHealthStatus()
+	if response.Status != "success" || status == nil {
+		fmt.Printf("FRR-MAD UNKNOWN - %s\n", response.Message)
+		return statusExitUnknown
+	}
+
+	if options.format == "json" {
+		data, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(status)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write status: %v\n", err)
+			return statusExitUnknown
+		}
+		fmt.Println(string(data))
+	} else {
+		writeStatus(os.Stdout, status, time.Now())
+	}
+
+	switch status.State {
+	case health.StateOK:
+		return statusExitOK
+	case health.StateDegraded:
+		return statusExitWarning
+	case health.StateFailed:
+		return statusExitCritical
+	default:
+		return statusExitUnknown
+	}
+}
+
+// requestSocket sends a single length-prefixed request to the socket of the daemon.
+func requestSocket(socketPath string, message *frrProto.Message, timeout time.Duration) (*frrProto.Response, error) {
+	conn, err := net.DialTimeout("unix", socketPath, timeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, err
+	}
+
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 4)
+	binary.LittleEndian.PutUint32(header, uint32(len(data)))
+	if _, err := conn.Write(append(header, data...)); err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, fmt.Errorf("error reading response size: %w", err)
+	}
+	payload := make([]byte, binary.LittleEndian.Uint32(header))
+	if _, err := io.ReadFull(conn, payload); err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+
+	response := &frrProto.Response{}
+	if err := proto.Unmarshal(payload, response); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return response, nil
+}
+
+// writeStatus prints the health with a first line in the format of monitoring checks.
+func writeStatus(w io.Writer, status *frrProto.HealthStatus, now time.Time) {
+	summary := "all components running"
+	if len(status.Problems) > 0 {
+		summary = strings.Join(status.Problems, "; ")
+	}
+	fmt.Fprintf(w, "FRR-MAD %s - %s\n\n", stateLabel(status.State), summary)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Version:\t%s\n", status.DaemonVersion)
+	fmt.Fprintf(tw, "Started:\t%s\n", ago(status.StartedAt, now))
+	fmt.Fprintf(tw, "Last collection:\t%s, took %s\n", ago(status.LastCollection, now), microseconds(status.CollectionDurationMicroseconds))
+	fmt.Fprintf(tw, "Last analysis:\t%s, took %s\n", ago(status.LastAnalysis, now), microseconds(status.AnalysisDurationMicroseconds))
+	fmt.Fprintf(tw, "Parse errors:\t%d\n", status.ParseErrors)
+	if clients := status.SocketClients; clients != nil {
+		fmt.Fprintf(tw, "Socket clients:\t%d connected, %d subscribed, %d served, %d rejected\n",
+			clients.Connections, clients.Subscribers, clients.Served, clients.Rejected)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nComponents:")
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  NAME\tSTATE\tSINCE\tRESTARTS\tLAST ERROR")
+	for _, component := range status.Components {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%d\t%s\n", component.Name, component.State, ago(component.Since, now), component.Restarts, component.LastError)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nFRR commands:")
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  NAME\tLAST SUCCESS\tDURATION\tFAILURES\tPARSE ERRORS\tLAST ERROR")
+	for _, command := range status.Commands {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%d\t%d\t%s\n", command.Name, ago(command.LastSuccess, now),
+			microseconds(command.DurationMicroseconds), command.Failures, command.ParseErrors, command.LastError)
+	}
+	tw.Flush()
+}
+
+func stateLabel(state string) string {
+	switch state {
+	case health.StateOK:
+		return "OK"
+	case health.StateDegraded:
+		return "WARNING"
+	case health.StateFailed:
+		return "CRITICAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func ago(unix int64, now time.Time) string {
+	if unix == 0 {
+		return "never"
+	}
+	return fmt.Sprintf("%s ago", now.Sub(time.Unix(unix, 0)).Truncate(time.Second))
+}
+
+func microseconds(value int64) string {
+	return (time.Duration(value) * time.Microsecond).String()
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/spf13/cobra"
+)
+
+type whatIfOptions struct {
+	candidateConfig  string
+	runningConfig    string
+	dataDir          string
+	configFile       string
+	failOnNewAnomaly bool
+}
+
+func newWhatIfCmd() *cobra.Command {
+	options := whatIfOptions{}
+
+	whatIfCmd := &cobra.Command{
+		Use:   "whatif",
+		Short: "Predict the impact of a candidate FRR config",
+		Long: "Derives the expected router, external and NSSA external LSAs of the running and a candidate config\n" +
+			"and compares the anomalies both would cause against the same LSDB. Without --data-dir the live state\n" +
+			"of the local FRR daemons is collected, with --data-dir a capture as used by analyze is read.",
+		Run: func(cmd *cobra.Command, args []string) {
+			os.Exit(runWhatIf(options))
+		},
+	}
+
+	whatIfCmd.Flags().StringVar(&options.candidateConfig, "candidate-config", "", "Path to the candidate FRR config")
+	whatIfCmd.Flags().StringVar(&options.runningConfig, "running-config", "", "Path to the running config, required with --data-dir")
+	whatIfCmd.Flags().StringVar(&options.dataDir, "data-dir", "", "Directory containing captured vtysh json outputs instead of the live state")
+	whatIfCmd.Flags().StringVarP(&options.configFile, "configFile", "c", "", "Provide path overwriting default configuration file location.")
+	whatIfCmd.Flags().BoolVar(&options.failOnNewAnomaly, "fail-on-new-anomaly", false, "Exit with code 2 if the candidate introduces an anomaly")
+	whatIfCmd.MarkFlagRequired("candidate-config")
+
+	return whatIfCmd
+}
+
+// runWhatIf prints the predicted impact of the candidate config and returns the exit code of the whatif command.
+func runWhatIf(options whatIfOptions) int {
+	if options.dataDir != "" && options.runningConfig == "" {
+		fmt.Fprintln(os.Stderr, "--running-config is required with --data-dir")
+		return analyzeExitError
+	}
+
+	appLogger, err := newOfflineLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create logger: %v\n", err)
+		return analyzeExitError
+	}
+
+	candidate, err := aggregator.ParseStaticFRRConfig(options.candidateConfig)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to parse candidate config: %v\n", err)
+		return analyzeExitError
+	}
+
+	var analyzerConfig configs.AnalyzerConfig
+	var fullFrrData *frrProto.FullFRRData
+	if options.dataDir != "" {
+		if options.configFile != "" {
+			config, err := configs.LoadConfig(options.configFile)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+				return analyzeExitError
+			}
+			analyzerConfig = config.Analyzer
+		}
+
+		var missing []string
+		fullFrrData, missing, err = aggregator.LoadCapturedData(options.runningConfig, options.dataDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load captured data: %v\n", err)
+			return analyzeExitError
+		}
+		for _, file := range missing {
+			fmt.Fprintf(os.Stderr, "Warning: %s not found in %s, its data is treated as empty\n", file, options.dataDir)
+		}
+	} else {
+		config, err := configs.LoadConfig(options.configFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+			return analyzeExitError
+		}
+		analyzerConfig = config.Analyzer
+
+		collector := aggregator.InitAggregator(config.Aggregator, appLogger)
+		if err := collector.Collect(); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to collect the live state: %v\n", err)
+			return analyzeExitError
+		}
+		fullFrrData = collector.FullFrrData
+	}
+
+	detection := analyzer.InitAnalyzer(fullFrrData, appLogger, appLogger)
+	configureAnalyzer(detection, analyzerConfig, appLogger)
+
+	prediction := detection.PredictChange(candidate)
+	if err := prediction.Write(os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write prediction: %v\n", err)
+		return analyzeExitError
+	}
+
+	if options.failOnNewAnomaly && len(prediction.IntroducedAnomalies) > 0 {
+		return analyzeExitAnomalies
+	}
+	return 0
+}
@@ -0,0 +1,122 @@
+package aggregator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
+)
+
+// CaptureFile is the output of a vtysh command, saved in a capture directory, e.g.
+// vtysh -c "show ip ospf data router self json" > capture/ospf_router_self.json
+type CaptureFile struct {
+	Name    string
+	Command string
+	parse   func(data []byte, fullFrrData *frrProto.FullFRRData) error
+}
+
+// CaptureFiles lists the files LoadCapturedData reads from a capture directory.
+var CaptureFiles = []CaptureFile{
+	{"general_ospf_information.json", "show ip ospf json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.GeneralOspfInformation, data, ParseGeneralOspfInformation)
+	}},
+	{"ospf_router_self.json", "show ip ospf data router self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfRouterData, data, ParseOSPFRouterLSA)
+	}},
+	{"ospf_router.json", "show ip ospf data router json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfRouterDataAll, data, ParseOSPFRouterLSAAll)
+	}},
+	{"ospf_network_self.json", "show ip ospf data network self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfNetworkData, data, ParseOSPFNetworkLSA)
+	}},
+	{"ospf_network.json", "show ip ospf data network json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfNetworkDataAll, data, ParseOSPFNetworkLSAAll)
+	}},
+	{"ospf_summary_self.json", "show ip ospf data summary self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfSummaryData, data, ParseOSPFSummaryLSA)
+	}},
+	{"ospf_summary.json", "show ip ospf data summary json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfSummaryDataAll, data, ParseOSPFSummaryLSAAll)
+	}},
+	{"ospf_asbr_summary_self.json", "show ip ospf data asbr-summary self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfAsbrSummaryData, data, ParseOSPFAsbrSummaryLSA)
+	}},
+	{"ospf_external_self.json", "show ip ospf data external self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfExternalData, data, ParseOSPFExternalLSA)
+	}},
+	{"ospf_nssa_external_self.json", "show ip ospf data nssa-external self json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfNssaExternalData, data, ParseOSPFNssaExternalLSA)
+	}},
+	{"ospf_database.json", "show ip ospf data json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfDatabase, data, ParseFullOSPFDatabase)
+	}},
+	{"ospf_external.json", "show ip ospf data external json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfExternalAll, data, ParseOSPFExternalAll)
+	}},
+	{"ospf_nssa_external.json", "show ip ospf data nssa-external json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfNssaExternalAll, data, ParseOSPFNssaExternalAll)
+	}},
+	{"ospf_neighbors.json", "show ip ospf neighbor json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.OspfNeighbors, data, ParseOSPFNeighbors)
+	}},
+	{"interfaces.json", "show interface json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.Interfaces, data, ParseInterfaceStatus)
+	}},
+	{"rib.json", "show ip route json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.RoutingInformationBase, data, ParseRib)
+	}},
+	{"rib_summary.json", "show ip route summary json", func(data []byte, f *frrProto.FullFRRData) error {
+		return parseInto(f.RibFibSummaryRoutes, data, ParseRibFibSummary)
+	}},
+}
+
+// LoadCapturedData builds the FullFRRData of a router from its running configuration and the
+// vtysh outputs in dataDir, without access to the FRR daemons. Capture files which do not exist
+// are returned as missing and leave their part of the data empty.
+func LoadCapturedData(runningConfigPath, dataDir string) (*frrProto.FullFRRData, []string, error) {
+	fullFrrData := initFullFrrData()
+
+	staticConfig, err := ParseStaticFRRConfig(runningConfigPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse running config: %w", err)
+	}
+	proto.Merge(fullFrrData.StaticFrrConfiguration, staticConfig)
+
+	if info, err := os.Stat(dataDir); err != nil || !info.IsDir() {
+		return nil, nil, fmt.Errorf("capture directory %s not found", dataDir)
+	}
+
+	var missing []string
+	for _, file := range CaptureFiles {
+		data, err := os.ReadFile(filepath.Join(dataDir, file.Name))
+		if errors.Is(err, os.ErrNotExist) {
+			missing = append(missing, file.Name)
+			continue
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+		}
+
+		if err := file.parse(data, fullFrrData); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s (%s): %w", file.Name, file.Command, err)
+		}
+	}
+
+	fullFrrData.FrrRouterData = &frrProto.FRRRouterData{
+		RouterName:   fullFrrData.StaticFrrConfiguration.Hostname,
+		OspfRouterId: fullFrrData.OspfDatabase.RouterId,
+	}
+
+	return fullFrrData, missing, nil
+}
+
+func parseInto[T proto.Message](target T, data []byte, parse func([]byte) (T, error)) error {
+	result, err := parse(data)
+	if err != nil {
+		return err
+	}
+	proto.Merge(target, result)
+	return nil
+}
@@ -1,11 +1,16 @@
 package aggregator
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"sync"
 	"time"
 
 	frrSocket "github.com/frr-mad/frr-mad/src/backend/internal/aggregator/frrsockets"
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
 	"google.golang.org/protobuf/proto"
@@ -16,6 +21,48 @@ type Collector struct {
 	socketPath  string
 	logger      *logger.Logger
 	FullFrrData *frrProto.FullFRRData
+	health      *health.Health
+
+	// pollIntervals passes a changed poll interval to RunAggregator
+	pollIntervals chan time.Duration
+
+	listenerMutex    sync.Mutex
+	collectListeners []func()
+}
+
+// SetHealth sets the health model which records the FRR commands and collection cycles.
+func (c *Collector) SetHealth(h *health.Health) {
+	c.health = h
+}
+
+// SetPollInterval changes the interval of a running RunAggregator, it takes effect after the
+// current collection.
+func (c *Collector) SetPollInterval(interval time.Duration) {
+	select {
+	case <-c.pollIntervals:
+	default:
+	}
+	select {
+	case c.pollIntervals <- interval:
+	default:
+	}
+}
+
+// AddCollectListener registers a function which is called after every successful collection of RunAggregator.
+func (c *Collector) AddCollectListener(listener func()) {
+	c.listenerMutex.Lock()
+	defer c.listenerMutex.Unlock()
+	c.collectListeners = append(c.collectListeners, listener)
+}
+
+func (c *Collector) notifyCollectListeners() {
+	c.listenerMutex.Lock()
+	listeners := slices.Clone(c.collectListeners)
+	c.listenerMutex.Unlock()
+
+	for _, listener := range listeners {
+		listener()
+	}
 }
 
 func NewFRRCommandExecutor(socketDir string, timeout time.Duration) *frrSocket.FRRCommandExecutor {
@@ -29,10 +76,11 @@ func newCollector(configPath, socketPath string, logger *logger.Logger) *Collect
 	fullFrrData := initFullFrrData()
 
 	return &Collector{
-		configPath:  configPath,
-		socketPath:  socketPath,
-		logger:      logger,
-		FullFrrData: fullFrrData,
+		configPath:    configPath,
+		socketPath:    socketPath,
+		logger:        logger,
+		FullFrrData:   fullFrrData,
+		pollIntervals: make(chan time.Duration, 1),
 	}
 }
 
@@ -70,7 +118,9 @@ func (c *Collector) Collect() error {
 		c.logger.Debug("Initializing new FullFrrData structure")
 		c.FullFrrData = initFullFrrData()
 	} else {
+		datalock.Lock()
 		c.ensureFieldsInitialized()
+		datalock.Unlock()
 	}
 
 	executor := NewFRRCommandExecutor(c.socketPath, 2*time.Second)
@@ -79,6 +129,8 @@ func (c *Collector) Collect() error {
 		start := time.Now()
 		result, err := fetchFunc()
 		if err != nil {
+			var parseError *ParseError
+			c.health.CommandFailed(name, err, errors.As(err, &parseError))
 			c.logger.WithAttrs(map[string]any{
 				"component": "aggregator",
 				"operation": name,
@@ -96,10 +148,14 @@ func (c *Collector) Collect() error {
 		// Merge the fetched data into the target.
 		// Reset target by creating a new instance of the same type
 
+		// readers of the data are only locked out while it is replaced, not while FRR is queried
+		datalock.Lock()
 		if p, ok := target.(interface{ Reset() }); ok {
 			p.Reset()
 		}
 		proto.Merge(target, result)
+		datalock.Unlock()
+		c.health.CommandSucceeded(name, time.Since(start))
 
 		c.logger.WithAttrs(map[string]any{
 			"component": "aggregator",
@@ -15,6 +15,27 @@ import (
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 )
 
+// ParseError marks output of FRR which could not be parsed, as opposed to a command which failed.
+type ParseError struct {
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parsed turns the error of a parser into a ParseError.
+func parsed[T any](result T, err error) (T, error) {
+	if err != nil {
+		return result, &ParseError{Err: err}
+	}
+	return result, nil
+}
+
 func fetchStaticFRRConfig() (*frrProto.StaticFRRConfiguration, error) {
 	cmd := exec.Command("vtysh", "-c", "show running-config")
 	output, err := cmd.Output()
@@ -40,7 +61,7 @@ func fetchStaticFRRConfig() (*frrProto.StaticFRRConfiguration, error) {
 	}
 	parsedStaticFRRConfig, err := ParseStaticFRRConfig(tmp.Name())
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse static FRR config: %w", err)
+		return nil, &ParseError{Err: fmt.Errorf("failed to parse static FRR config: %w", err)}
 	}
 
 	return parsedStaticFRRConfig, nil
@@ -52,7 +73,7 @@ func fetchGeneralOSPFInformation(executor *frrSocket.FRRCommandExecutor) (*frrPr
 		return nil, err
 	}
 
-	return ParseGeneralOspfInformation(output)
+	return parsed(ParseGeneralOspfInformation(output))
 }
 
 func fetchOSPFRouterData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFRouterData, error) {
@@ -61,7 +82,7 @@ func fetchOSPFRouterData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPF
 		return nil, err
 	}
 
-	return ParseOSPFRouterLSA(output)
+	return parsed(ParseOSPFRouterLSA(output))
 }
 
 func fetchOSPFRouterDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFRouterData, error) {
@@ -70,7 +91,7 @@ func fetchOSPFRouterDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.O
 		return nil, err
 	}
 
-	return ParseOSPFRouterLSAAll(output)
+	return parsed(ParseOSPFRouterLSAAll(output))
 }
 
 func fetchOSPFNetworkData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFNetworkData, error) {
@@ -79,7 +100,7 @@ func fetchOSPFNetworkData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSP
 		return nil, err
 	}
 
-	return ParseOSPFNetworkLSA(output)
+	return parsed(ParseOSPFNetworkLSA(output))
 }
 
 func fetchOSPFNetworkDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFNetworkData, error) {
@@ -87,7 +108,7 @@ func fetchOSPFNetworkDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.
 	if err != nil {
 		return nil, err
 	}
-	return ParseOSPFNetworkLSAAll(output)
+	return parsed(ParseOSPFNetworkLSAAll(output))
 }
 
 func fetchOSPFSummaryData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFSummaryData, error) {
@@ -96,7 +117,7 @@ func fetchOSPFSummaryData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSP
 		return nil, err
 	}
 
-	return ParseOSPFSummaryLSA(output)
+	return parsed(ParseOSPFSummaryLSA(output))
 }
 
 func fetchOSPFSummaryDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFSummaryData, error) {
@@ -105,7 +126,7 @@ func fetchOSPFSummaryDataAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.
 		return nil, err
 	}
 
-	return ParseOSPFSummaryLSAAll(output)
+	return parsed(ParseOSPFSummaryLSAAll(output))
 }
 
 func fetchOSPFAsbrSummaryData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFAsbrSummaryData, error) {
@@ -114,7 +135,7 @@ func fetchOSPFAsbrSummaryData(executor *frrSocket.FRRCommandExecutor) (*frrProto
 		return nil, err
 	}
 
-	return ParseOSPFAsbrSummaryLSA(output)
+	return parsed(ParseOSPFAsbrSummaryLSA(output))
 }
 
 func fetchOSPFExternalData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFExternalData, error) {
@@ -123,7 +144,7 @@ func fetchOSPFExternalData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OS
 		return nil, err
 	}
 
-	return ParseOSPFExternalLSA(output)
+	return parsed(ParseOSPFExternalLSA(output))
 }
 
 func fetchOSPFNssaExternalData(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFNssaExternalData, error) {
@@ -132,7 +153,7 @@ func fetchOSPFNssaExternalData(executor *frrSocket.FRRCommandExecutor) (*frrProt
 		return nil, err
 	}
 
-	return ParseOSPFNssaExternalLSA(output)
+	return parsed(ParseOSPFNssaExternalLSA(output))
 }
 
 func fetchFullOSPFDatabase(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFDatabase, error) {
@@ -140,7 +161,7 @@ func fetchFullOSPFDatabase(executor *frrSocket.FRRCommandExecutor) (*frrProto.OS
 	if err != nil {
 		return nil, err
 	}
-	return ParseFullOSPFDatabase(output)
+	return parsed(ParseFullOSPFDatabase(output))
 }
 
 func fetchOSPFExternalAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFExternalAll, error) {
@@ -148,7 +169,7 @@ func fetchOSPFExternalAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSP
 	if err != nil {
 		return nil, err
 	}
-	return ParseOSPFExternalAll(output)
+	return parsed(ParseOSPFExternalAll(output))
 }
 
 func fetchOSPFNssaExternalAll(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFNssaExternalAll, error) {
@@ -156,7 +177,7 @@ func fetchOSPFNssaExternalAll(executor *frrSocket.FRRCommandExecutor) (*frrProto
 	if err != nil {
 		return nil, err
 	}
-	return ParseOSPFNssaExternalAll(output)
+	return parsed(ParseOSPFNssaExternalAll(output))
 }
 
 func fetchOSPFNeighbors(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFNeighbors, error) {
@@ -164,7 +185,7 @@ func fetchOSPFNeighbors(executor *frrSocket.FRRCommandExecutor) (*frrProto.OSPFN
 	if err != nil {
 		return nil, err
 	}
-	return ParseOSPFNeighbors(output)
+	return parsed(ParseOSPFNeighbors(output))
 }
 
 func fetchInterfaceStatus(executor *frrSocket.FRRCommandExecutor) (*frrProto.InterfaceList, error) {
@@ -172,7 +193,7 @@ func fetchInterfaceStatus(executor *frrSocket.FRRCommandExecutor) (*frrProto.Int
 	if err != nil {
 		return nil, err
 	}
-	return ParseInterfaceStatus(output)
+	return parsed(ParseInterfaceStatus(output))
 }
 
 func fetchRib(executor *frrSocket.FRRCommandExecutor) (*frrProto.RoutingInformationBase, error) {
@@ -180,7 +201,7 @@ func fetchRib(executor *frrSocket.FRRCommandExecutor) (*frrProto.RoutingInformat
 	if err != nil {
 		return nil, err
 	}
-	return ParseRib(output)
+	return parsed(ParseRib(output))
 }
 
 func fetchRibFibSummary(executor *frrSocket.FRRCommandExecutor) (*frrProto.RibFibSummaryRoutes, error) {
@@ -188,7 +209,7 @@ func fetchRibFibSummary(executor *frrSocket.FRRCommandExecutor) (*frrProto.RibFi
 	if err != nil {
 		return nil, err
 	}
-	return ParseRibFibSummary(output)
+	return parsed(ParseRibFibSummary(output))
 }
 
 func collectSystemMetrics() (*frrProto.SystemMetrics, error) {
@@ -1,6 +1,7 @@
 package aggregator
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,17 +16,30 @@ func InitAggregator(config configs.AggregatorConfig, logger *logger.Logger) *Col
 	return newCollector(configPath, socketPath, logger)
 }
 
-func StartAggregator(collector *Collector, pollInterval time.Duration) {
+// RunAggregator collects the FRR data every poll interval until the context is canceled.
+// A running collection is completed before it returns.
+func RunAggregator(ctx context.Context, collector *Collector, pollInterval time.Duration) error {
 	ticker := time.NewTicker(pollInterval)
-	go func() {
-		defer ticker.Stop()
+	defer ticker.Stop()
 
-		for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case interval := <-collector.pollIntervals:
+			ticker.Reset(interval)
+			collector.logger.WithAttrs(map[string]interface{}{
+				"interval": interval.String(),
+			}).Info("Changed poll interval")
+		case <-ticker.C:
+			start := time.Now()
 			err := collector.Collect()
 			if err != nil {
 				collector.logger.Error(fmt.Sprintf("Collection error: %v", err))
 				continue
 			}
+			collector.health.CollectionCompleted(time.Since(start))
+			collector.notifyCollectListeners()
 		}
-	}()
+	}
 }
@@ -916,6 +916,7 @@ func ParseStaticFRRConfig(path string) (*frrProto.StaticFRRConfiguration, error)
 	scanner := bufio.NewScanner(file)
 
 	var currentInterfacePointer *frrProto.Interface
+	var currentRouteMapPointer *frrProto.RouteMap
 
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -951,13 +952,24 @@ func ParseStaticFRRConfig(path string) (*frrProto.StaticFRRConfiguration, error)
 			continue
 		}
 
+		if handled := parsePrefixListLine(config, line); handled {
+			continue
+		}
+
 		if handled := parseRouteMapLine(config, line); handled {
+			if parts := strings.Fields(line); len(parts) > 1 {
+				currentRouteMapPointer = config.RouteMap[parts[1]]
+			}
 			continue
 		}
 
 		if handled := parseRouteMapMatchLine(config, line); handled {
 			continue
 		}
+
+		if currentRouteMapPointer != nil && parseRouteMapSetLine(currentRouteMapPointer, line) {
+			continue
+		}
 	}
 
 	if currentInterfacePointer != nil {
@@ -1137,6 +1149,70 @@ func parseAccessListLine(config *frrProto.StaticFRRConfiguration, line string) b
 	return true
 }
 
+// parsePrefixListLine parses "ip prefix-list <name> [seq <n>] permit|deny <prefix>|any [ge <n>] [le <n>]"
+func parsePrefixListLine(config *frrProto.StaticFRRConfiguration, line string) bool {
+	if !strings.HasPrefix(line, "ip prefix-list ") {
+		return false
+	}
+	parts := strings.Fields(line)
+	if len(parts) < 5 {
+		log.Printf("short prefix-list line: %q", line)
+		return true
+	}
+
+	name := parts[2]
+	item := &frrProto.PrefixListItem{}
+	rest := parts[3:]
+	if rest[0] == "seq" {
+		if len(rest) < 4 {
+			log.Printf("short prefix-list line: %q", line)
+			return true
+		}
+		seq, _ := strconv.Atoi(rest[1])
+		item.Sequence = uint32(seq)
+		rest = rest[2:]
+	}
+	if rest[0] != "permit" && rest[0] != "deny" {
+		// e.g. "ip prefix-list <name> description <text>"
+		return true
+	}
+	item.AccessControl = rest[0]
+
+	if rest[1] == "any" {
+		item.Any = true
+	} else if ip, ipnet, err := net.ParseCIDR(rest[1]); err == nil && ipnet != nil {
+		prefixLength, _ := ipnet.Mask.Size()
+		item.IpPrefix = &frrProto.IPPrefix{
+			IpAddress:    ip.String(),
+			PrefixLength: uint32(prefixLength),
+		}
+	} else {
+		log.Printf("bad CIDR %q in prefix-list %q", rest[1], line)
+	}
+
+	for i := 2; i+1 < len(rest); i++ {
+		value, err := strconv.ParseUint(rest[i+1], 10, 32)
+		if err != nil {
+			continue
+		}
+		switch rest[i] {
+		case "ge":
+			item.Ge = uint32(value)
+		case "le":
+			item.Le = uint32(value)
+		}
+	}
+
+	if config.PrefixList == nil {
+		config.PrefixList = make(map[string]*frrProto.PrefixList)
+	}
+	if _, ok := config.PrefixList[name]; !ok {
+		config.PrefixList[name] = &frrProto.PrefixList{Name: name}
+	}
+	config.PrefixList[name].PrefixListItems = append(config.PrefixList[name].PrefixListItems, item)
+	return true
+}
+
 func parseRouteMapLine(config *frrProto.StaticFRRConfiguration, line string) bool {
 	if !strings.HasPrefix(line, "route-map ") {
 		return false
@@ -1162,9 +1238,24 @@ func parseRouteMapMatchLine(config *frrProto.StaticFRRConfiguration, line string
 		return false
 	}
 	parts := strings.Fields(line)
+	if parts[3] == "prefix-list" {
+		if len(parts) < 5 {
+			log.Printf("short route-map match line: %q", line)
+			return true
+		}
+		for _, rm := range config.RouteMap {
+			if rm.AccessList == "" && rm.PrefixList == "" {
+				rm.Match = "ip address prefix-list"
+				rm.PrefixList = parts[4]
+				break
+			}
+		}
+		return true
+	}
+
 	accessListName := parts[3]
 	for _, rm := range config.RouteMap {
-		if rm.AccessList == "" {
+		if rm.AccessList == "" && rm.PrefixList == "" {
 			rm.Match = "ip address"
 			rm.AccessList = accessListName
 			break
@@ -1173,6 +1264,32 @@ func parseRouteMapMatchLine(config *frrProto.StaticFRRConfiguration, line string
 	return true
 }
 
+// parseRouteMapSetLine parses "set metric <n>", "set metric-type type-1|type-2" and "set tag <n>"
+func parseRouteMapSetLine(routeMap *frrProto.RouteMap, line string) bool {
+	if !strings.HasPrefix(line, "set ") {
+		return false
+	}
+	parts := strings.Fields(line)
+	if len(parts) < 3 {
+		return true
+	}
+	switch parts[1] {
+	case "metric":
+		if metric, err := strconv.ParseInt(parts[2], 10, 32); err == nil {
+			routeMap.HasSetMetric = true
+			routeMap.SetMetric = int32(metric)
+		}
+	case "metric-type":
+		routeMap.SetMetricType = parts[2]
+	case "tag":
+		if tag, err := strconv.ParseUint(parts[2], 10, 32); err == nil {
+			routeMap.HasSetTag = true
+			routeMap.SetTag = uint32(tag)
+		}
+	}
+	return true
+}
+
 func parseRouterOSPFConfig(scanner *bufio.Scanner, config *frrProto.StaticFRRConfiguration) {
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -1196,32 +1313,77 @@ func parseRouterOSPFConfig(scanner *bufio.Scanner, config *frrProto.StaticFRRCon
 			redistributionType := ""
 			redistributionMetric := ""
 			redistributionRouteMap := ""
+			hasMetricValue := false
+			var metricValue int32
 			for i, part := range parts {
+				if i+1 >= len(parts) {
+					break
+				}
 				if part == "redistribute" {
 					redistributionType = parts[i+1]
 				}
 				if part == "metric-type" {
 					redistributionMetric = parts[i+1]
 				}
+				if part == "metric" {
+					if metric, err := strconv.ParseInt(parts[i+1], 10, 32); err == nil {
+						hasMetricValue = true
+						metricValue = int32(metric)
+					}
+				}
 				if part == "route-map" {
 					redistributionRouteMap = parts[i+1]
 				}
 			}
 			config.OspfConfig.Redistribution = append(config.OspfConfig.Redistribution, &frrProto.Redistribution{
-				Type:     redistributionType,
-				Metric:   redistributionMetric,
-				RouteMap: redistributionRouteMap,
+				Type:           redistributionType,
+				Metric:         redistributionMetric,
+				RouteMap:       redistributionRouteMap,
+				HasMetricValue: hasMetricValue,
+				MetricValue:    metricValue,
 			})
 
+		case strings.HasPrefix(line, "default-information originate"):
+			if config.OspfConfig == nil {
+				config.OspfConfig = &frrProto.OSPFConfig{}
+			}
+			config.OspfConfig.DefaultInformation = parseDefaultInformationLine(strings.Fields(line))
+
+		case strings.HasPrefix(line, "default-metric "):
+			if config.OspfConfig == nil {
+				config.OspfConfig = &frrProto.OSPFConfig{}
+			}
+			parts := strings.Fields(line)
+			if metric, err := strconv.ParseInt(parts[1], 10, 32); err == nil {
+				config.OspfConfig.HasDefaultMetric = true
+				config.OspfConfig.DefaultMetric = int32(metric)
+			}
+
 		case strings.HasPrefix(line, "area "):
 			if config.OspfConfig == nil {
 				config.OspfConfig = &frrProto.OSPFConfig{}
 			}
 			parts := strings.Fields(line)
+			if len(parts) > 3 && parts[2] == "range" {
+				if areaRange := parseAreaRangeLine(parts); areaRange != nil {
+					config.OspfConfig.AreaRange = append(config.OspfConfig.AreaRange, areaRange)
+				}
+				continue
+			}
 			area := &frrProto.Area{Name: parts[1]}
 			if len(parts) > 2 {
 				area.Type = parts[2]
 			}
+			for _, part := range parts[2:] {
+				switch part {
+				case "no-summary":
+					area.NoSummary = true
+				case "translate-always", "translate-candidate", "translate-never":
+					area.NssaTranslatorRole = part
+				case "default-information-originate":
+					area.NssaDefaultOriginate = true
+				}
+			}
 			for i, part := range parts {
 				if part == "virtual-link" && i+1 < len(parts) {
 					area.Type = "transit (virtual-link)"
@@ -1234,6 +1396,71 @@ func parseRouterOSPFConfig(scanner *bufio.Scanner, config *frrProto.StaticFRRCon
 	}
 }
 
+// parseDefaultInformationLine parses "default-information originate [always] [metric <n>] [metric-type 1|2] [route-map <name>]"
+func parseDefaultInformationLine(parts []string) *frrProto.DefaultInformation {
+	defaultInformation := &frrProto.DefaultInformation{Originate: true}
+
+	for i := 2; i < len(parts); i++ {
+		switch parts[i] {
+		case "always":
+			defaultInformation.Always = true
+		case "metric":
+			if i+1 < len(parts) {
+				if metric, err := strconv.ParseInt(parts[i+1], 10, 32); err == nil {
+					defaultInformation.HasMetric = true
+					defaultInformation.Metric = int32(metric)
+				}
+				i++
+			}
+		case "metric-type":
+			if i+1 < len(parts) {
+				defaultInformation.MetricType = parts[i+1]
+				i++
+			}
+		case "route-map":
+			if i+1 < len(parts) {
+				defaultInformation.RouteMap = parts[i+1]
+				i++
+			}
+		}
+	}
+
+	return defaultInformation
+}
+
+// parseAreaRangeLine parses "area <id> range <prefix> [advertise|not-advertise] [cost <n>]"
+func parseAreaRangeLine(parts []string) *frrProto.AreaRange {
+	_, ipNet, err := net.ParseCIDR(parts[3])
+	if err != nil {
+		return nil
+	}
+	prefixLength, _ := ipNet.Mask.Size()
+	areaRange := &frrProto.AreaRange{
+		Area: parts[1],
+		IpPrefix: &frrProto.IPPrefix{
+			IpAddress:    ipNet.IP.String(),
+			PrefixLength: uint32(prefixLength),
+		},
+	}
+
+	for i := 4; i < len(parts); i++ {
+		switch parts[i] {
+		case "not-advertise":
+			areaRange.NotAdvertise = true
+		case "cost":
+			if i+1 < len(parts) {
+				if cost, err := strconv.ParseUint(parts[i+1], 10, 32); err == nil {
+					areaRange.HasCost = true
+					areaRange.Cost = uint32(cost)
+				}
+				i++
+			}
+		}
+	}
+
+	return areaRange
+}
+
 func (c *Collector) ReadConfig() (string, error) {
 	file, err := os.Open(c.configPath)
 	if err != nil {
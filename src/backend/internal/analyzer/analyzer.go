@@ -28,6 +28,27 @@ type OspfRedistribution struct {
 	Metric   string `json:"metric"`
 }
 
+// analysisCycle holds the parsed should- and is-state shared by the built-in checks of a cycle.
+type analysisCycle struct {
+	accessList               map[string]*frrProto.AccessListAnalyzer
+	isNssa                   bool
+	shouldRouterLSDB         *frrProto.IntraAreaLsa
+	shouldExternalLSDB       *frrProto.InterAreaLsa
+	shouldNssaExternalLSDB   *frrProto.InterAreaLsa
+	shouldSummaryLSDB        *frrProto.InterAreaLsa
+	shouldAsbrSummaryLSDB    *frrProto.InterAreaLsa
+	isRouterLSDB             *frrProto.IntraAreaLsa
+	isExternalLSDB           *frrProto.InterAreaLsa
+	isNssaExternalLSDB       *frrProto.InterAreaLsa
+	isSummaryLSDB            *frrProto.InterAreaLsa
+	isAsbrSummaryLSDB        *frrProto.InterAreaLsa
+	fibMap                   map[string]*frrProto.RibPrefixes
+	receivedNetworkLSDB      *frrProto.IntraAreaLsa
+	receivedSummaryLSDB      *frrProto.InterAreaLsa
+	receivedExternalLSDB     *frrProto.InterAreaLsa
+	receivedNssaExternalLSDB *frrProto.InterAreaLsa
+}
+
 func (a *Analyzer) AnomalyAnalysis() {
 	a.Logger.Debug("Starting full anomaly analysis cycle")
 	start := time.Now()
@@ -41,6 +62,8 @@ func (a *Analyzer) AnomalyAnalysis() {
 	isNssa, shouldRouterLSDB := a.GetStaticFileRouterData(a.metrics.StaticFrrConfiguration)
 	shouldExternalLSDB := a.GetStaticFileExternalData(a.metrics.StaticFrrConfiguration, accessList, staticRouteMap)
 	shouldNssaExternalLSDB := a.GetStaticFileNssaExternalData(a.metrics.StaticFrrConfiguration, accessList, staticRouteMap)
+	shouldSummaryLSDB := a.GetStaticFileSummaryData(a.metrics.StaticFrrConfiguration)
+	shouldAsbrSummaryLSDB := a.GetStaticFileAsbrSummaryData(a.metrics.StaticFrrConfiguration)
 
 	fibMap := GetFIB(a.metrics.RoutingInformationBase, a.Logger)
 	receivedSummaryLSDB := GetRuntimeSummaryData(a.metrics.OspfSummaryDataAll, hostname, a.Logger)
@@ -51,33 +74,52 @@ func (a *Analyzer) AnomalyAnalysis() {
 	isRouterLSDB, p2pMap := GetRuntimeRouterDataSelf(a.metrics.OspfRouterData, hostname, peerNeighborMap, a.Logger)
 	isExternalLSDB := GetRuntimeExternalDataSelf(a.metrics.OspfExternalData, staticRouteMap, hostname, a.Logger)
 	isNssaExternalLSDB := GetNssaExternalData(a.metrics.OspfNssaExternalData, staticRouteMap, a.metrics.StaticFrrConfiguration.Hostname, a.Logger)
+	isSummaryLSDB := GetRuntimeSummaryDataSelf(a.metrics.OspfSummaryData, hostname, a.Logger)
+	isAsbrSummaryLSDB := GetRuntimeAsbrSummaryDataSelf(a.metrics.OspfAsbrSummaryData, hostname, a.Logger)
 
 	a.Logger.WithAttrs(map[string]any{
 		"access_lists":  len(GetAccessList(a.metrics.StaticFrrConfiguration)),
 		"static_routes": len(GetStaticRouteList(a.metrics.StaticFrrConfiguration, nil)),
 	}).Debug("Parsed configuration data")
 
-	a.Logger.Debug("Running router LSDB analysis")
-	a.RouterAnomalyAnalysisLSDB(accessList, shouldRouterLSDB, isRouterLSDB)
-
-	a.Logger.Debug("Running external LSDB analysis")
-	a.ExternalAnomalyAnalysisLSDB(shouldExternalLSDB, isExternalLSDB)
-
-	if isNssa {
-		a.Logger.Debug("Running NSSA external analysis")
-		a.NssaExternalAnomalyAnalysis(accessList, shouldNssaExternalLSDB, isNssaExternalLSDB, isExternalLSDB)
-	}
-
-	// TODO: implement ribMap -> fibMap analysis, if necessary?
-	a.Logger.Debug("Running FIB analysis")
-	a.AnomalyAnalysisFIB(fibMap, receivedNetworkLSDB, receivedSummaryLSDB, receivedExternalLSDB, receivedNssaExternalLSDB)
+	a.runChecks(&analysisCycle{
+		accessList:               accessList,
+		isNssa:                   isNssa,
+		shouldRouterLSDB:         shouldRouterLSDB,
+		shouldExternalLSDB:       shouldExternalLSDB,
+		shouldNssaExternalLSDB:   shouldNssaExternalLSDB,
+		shouldSummaryLSDB:        shouldSummaryLSDB,
+		shouldAsbrSummaryLSDB:    shouldAsbrSummaryLSDB,
+		isRouterLSDB:             isRouterLSDB,
+		isExternalLSDB:           isExternalLSDB,
+		isNssaExternalLSDB:       isNssaExternalLSDB,
+		isSummaryLSDB:            isSummaryLSDB,
+		isAsbrSummaryLSDB:        isAsbrSummaryLSDB,
+		fibMap:                   fibMap,
+		receivedNetworkLSDB:      receivedNetworkLSDB,
+		receivedSummaryLSDB:      receivedSummaryLSDB,
+		receivedExternalLSDB:     receivedExternalLSDB,
+		receivedNssaExternalLSDB: receivedNssaExternalLSDB,
+	})
+
+	a.ExplainAnomalies()
+
+	a.Logger.Debug("Updating anomaly lifecycle")
+	now := time.Now()
+	a.UpdateLifecycle(now)
+	a.ClassifyAnomalies(now)
+	a.AnalysisResult.AreaAnomalies = a.groupAnomaliesByArea()
 
 	a.AnalyserStateParserResults.ShouldRouterLsdb.Reset()
 	a.AnalyserStateParserResults.ShouldExternalLsdb.Reset()
 	a.AnalyserStateParserResults.ShouldNssaExternalLsdb.Reset()
+	a.AnalyserStateParserResults.ShouldSummaryLsdb.Reset()
+	a.AnalyserStateParserResults.ShouldAsbrSummaryLsdb.Reset()
 	proto.Merge(a.AnalyserStateParserResults.ShouldRouterLsdb, shouldRouterLSDB)
 	proto.Merge(a.AnalyserStateParserResults.ShouldExternalLsdb, shouldExternalLSDB)
 	proto.Merge(a.AnalyserStateParserResults.ShouldNssaExternalLsdb, shouldNssaExternalLSDB)
+	proto.Merge(a.AnalyserStateParserResults.ShouldSummaryLsdb, shouldSummaryLSDB)
+	proto.Merge(a.AnalyserStateParserResults.ShouldAsbrSummaryLsdb, shouldAsbrSummaryLSDB)
 	proto.Merge(a.AnalyserStateParserResults.P2PMap, p2pMap)
 	proto.Merge(a.P2pMap, p2pMap)
 
@@ -203,16 +245,14 @@ func GetPeerNeighbor(config *frrProto.OSPFNeighbors, peerInterface map[string]st
 }
 
 func (a *Analyzer) logAnalysisSummary(start time.Time) {
-	counts := map[string]int{
-		"over_advertised": len(a.AnalysisResult.RouterAnomaly.SuperfluousEntries) +
-			len(a.AnalysisResult.ExternalAnomaly.SuperfluousEntries) +
-			len(a.AnalysisResult.NssaExternalAnomaly.SuperfluousEntries),
-		"unadvertised": len(a.AnalysisResult.RouterAnomaly.MissingEntries) +
-			len(a.AnalysisResult.ExternalAnomaly.MissingEntries) +
-			len(a.AnalysisResult.NssaExternalAnomaly.MissingEntries),
-		"duplicate": len(a.AnalysisResult.RouterAnomaly.DuplicateEntries) +
-			len(a.AnalysisResult.ExternalAnomaly.DuplicateEntries) +
-			len(a.AnalysisResult.NssaExternalAnomaly.DuplicateEntries),
+	counts := map[string]int{}
+	for _, source := range a.anomalySources() {
+		if source.detection == nil {
+			continue
+		}
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			counts[anomalyType] += len(entries)
+		}
 	}
 
 	a.Logger.WithAttrs(map[string]any{
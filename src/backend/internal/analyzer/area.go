@@ -0,0 +1,87 @@
+package analyzer
+
+import (
+	"encoding/binary"
+	"net"
+	"sort"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+// NoArea groups anomalies without an OSPF area, e.g. of AS external LSAs or the FIB.
+const NoArea = "none"
+
+// AnomalyArea returns the area an anomaly is grouped under.
+func AnomalyArea(entry *frrProto.Advertisement) string {
+	if entry.GetOspfArea() == "" {
+		return NoArea
+	}
+	return entry.GetOspfArea()
+}
+
+// groupAnomaliesByArea splits the published anomalies of all sources by area. It runs after
+// ClassifyAnomalies, so the actionable and critical counts respect suppressions and acknowledgements.
+func (a *Analyzer) groupAnomaliesByArea() []*frrProto.AreaAnomalies {
+	areas := map[string]*frrProto.AreaAnomalies{}
+
+	for _, entry := range a.reportEntries() {
+		name := AnomalyArea(entry.entry)
+		area, exists := areas[name]
+		if !exists {
+			area = &frrProto.AreaAnomalies{Area: name, Sources: map[string]*frrProto.AnomalyDetection{}}
+			areas[name] = area
+		}
+
+		detection, exists := area.Sources[entry.source]
+		if !exists {
+			detection = initAnomalyDetection()
+			area.Sources[entry.source] = detection
+		}
+
+		switch entry.anomalyType {
+		case AnomalyTypeOverAdvertised:
+			detection.HasOverAdvertisedPrefixes = true
+			detection.SuperfluousEntries = append(detection.SuperfluousEntries, entry.entry)
+		case AnomalyTypeUnAdvertised:
+			detection.HasUnAdvertisedPrefixes = true
+			detection.MissingEntries = append(detection.MissingEntries, entry.entry)
+		case AnomalyTypeDuplicate:
+			detection.HasDuplicatePrefixes = true
+			detection.DuplicateEntries = append(detection.DuplicateEntries, entry.entry)
+		case AnomalyTypeMisconfigured:
+			detection.HasMisconfiguredPrefixes = true
+			detection.MisconfiguredEntries = append(detection.MisconfiguredEntries, entry.entry)
+		}
+
+		area.Total++
+		if !entry.entry.Suppressed && !entry.entry.Acknowledged {
+			area.Actionable++
+			if entry.entry.Severity == SeverityCritical {
+				area.Critical++
+			}
+		}
+	}
+
+	result := make([]*frrProto.AreaAnomalies, 0, len(areas))
+	for _, area := range areas {
+		result = append(result, area)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return lessArea(result[i].Area, result[j].Area)
+	})
+
+	return result
+}
+
+// lessArea orders areas numerically, anomalies without an area come last.
+func lessArea(first, second string) bool {
+	if first == NoArea || second == NoArea {
+		return second == NoArea && first != NoArea
+	}
+
+	firstIP, secondIP := net.ParseIP(first).To4(), net.ParseIP(second).To4()
+	if firstIP == nil || secondIP == nil {
+		return first < second
+	}
+	return binary.BigEndian.Uint32(firstIP) < binary.BigEndian.Uint32(secondIP)
+}
@@ -0,0 +1,305 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/frr-mad/frr-mad/src/logger"
+)
+
+// Check is a single analysis of the anomaly analysis cycle. Its findings are published
+// under the name of the check as anomaly source, e.g. in the lifecycle, the exporter and the TUI.
+type Check interface {
+	Name() string
+	Description() string
+	// Inputs lists the parts of the FullFRRData the check reads, e.g. OspfRouterData
+	Inputs() []string
+	Run(ctx *CheckContext) (*frrProto.AnomalyDetection, error)
+}
+
+// CheckContext is passed to every check of a cycle.
+type CheckContext struct {
+	Metrics       *frrProto.FullFRRData
+	Params        map[string]string
+	Logger        *logger.Logger
+	AnomalyLogger *logger.Logger
+
+	analyzer *Analyzer
+	cycle    *analysisCycle
+}
+
+// CheckRegistry holds the checks of an analyzer in their execution order.
+type CheckRegistry struct {
+	checks []*registeredCheck
+}
+
+type registeredCheck struct {
+	check   Check
+	builtin bool
+	enabled bool
+	params  map[string]string
+}
+
+// NewCheckRegistry returns a registry containing the built-in checks, all enabled.
+func NewCheckRegistry() *CheckRegistry {
+	registry := &CheckRegistry{}
+	for _, check := range builtinChecks() {
+		registry.checks = append(registry.checks, &registeredCheck{check: check, builtin: true, enabled: true})
+	}
+	return registry
+}
+
+// Register appends an enabled check. Names are unique, ignoring case.
+func (r *CheckRegistry) Register(check Check) error {
+	if check.Name() == "" {
+		return fmt.Errorf("check without name")
+	}
+	if r.lookup(check.Name()) != nil {
+		return fmt.Errorf("check %q is already registered", check.Name())
+	}
+	r.checks = append(r.checks, &registeredCheck{check: check, enabled: true})
+	return nil
+}
+
+// Configure enables or disables a registered check and sets its parameters.
+func (r *CheckRegistry) Configure(name string, enabled bool, params map[string]string) error {
+	registered := r.lookup(name)
+	if registered == nil {
+		return fmt.Errorf("unknown check %q, expected one of %s", name, strings.Join(r.Names(), ", "))
+	}
+	registered.enabled = enabled
+	registered.params = params
+	return nil
+}
+
+// Names returns the names of all registered checks in execution order.
+func (r *CheckRegistry) Names() []string {
+	names := make([]string, 0, len(r.checks))
+	for _, registered := range r.checks {
+		names = append(names, registered.check.Name())
+	}
+	return names
+}
+
+func (r *CheckRegistry) lookup(name string) *registeredCheck {
+	for _, registered := range r.checks {
+		if strings.EqualFold(registered.check.Name(), name) {
+			return registered
+		}
+	}
+	return nil
+}
+
+// runChecks runs all enabled checks and records their results in the analysis result.
+func (a *Analyzer) runChecks(cycle *analysisCycle) {
+	results := make([]*frrProto.CheckResult, 0, len(a.Checks.checks))
+
+	for _, registered := range a.Checks.checks {
+		check := registered.check
+		result := newCheckResult(registered, a.AnalysisResult)
+		results = append(results, result)
+
+		// a disabled, skipped or failed built-in check must not keep the findings of an earlier cycle
+		clearAnomalyDetection(result.Findings)
+		if !registered.enabled {
+			continue
+		}
+
+		a.Logger.WithAttrs(map[string]any{
+			"check": check.Name(),
+		}).Debug("Running check")
+
+		start := time.Now()
+		findings, err := runCheck(check, &CheckContext{
+			Metrics:       a.metrics,
+			Params:        registered.params,
+			Logger:        a.Logger,
+			AnomalyLogger: a.AnomalyLogger,
+			analyzer:      a,
+			cycle:         cycle,
+		})
+		result.DurationMicroseconds = time.Since(start).Microseconds()
+
+		if err != nil {
+			result.Error = err.Error()
+			a.Logger.WithAttrs(map[string]any{
+				"check": check.Name(),
+				"error": err.Error(),
+			}).Error("Check failed")
+			continue
+		}
+		if findings != nil {
+			if !registered.builtin {
+				setDetectionFlags(findings)
+			}
+			result.Findings = findings
+		}
+	}
+
+	a.AnalysisResult.CheckResults = results
+}
+
+// checkResults returns the results of all registered checks without findings, to publish
+// the checks before the first analysis cycle.
+func (r *CheckRegistry) checkResults(analysis *frrProto.AnomalyAnalysis) []*frrProto.CheckResult {
+	results := make([]*frrProto.CheckResult, 0, len(r.checks))
+	for _, registered := range r.checks {
+		results = append(results, newCheckResult(registered, analysis))
+	}
+	return results
+}
+
+// newCheckResult describes a registered check. The findings of a built-in check with a
+// compatibility field are that field, so both always publish the same anomalies.
+func newCheckResult(registered *registeredCheck, analysis *frrProto.AnomalyAnalysis) *frrProto.CheckResult {
+	check := registered.check
+	result := &frrProto.CheckResult{
+		Name:        check.Name(),
+		Description: check.Description(),
+		Inputs:      check.Inputs(),
+		Enabled:     registered.enabled,
+		Builtin:     registered.builtin,
+		Findings:    initAnomalyDetection(),
+	}
+	if wrapped, ok := check.(*builtinCheck); ok && wrapped.field(analysis) != nil {
+		result.Findings = wrapped.field(analysis)
+	}
+	return result
+}
+
+// runCheck runs a single check and turns a panic into an error, so a faulty check
+// doesn't stop the remaining checks of the cycle.
+func runCheck(check Check, ctx *CheckContext) (findings *frrProto.AnomalyDetection, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			findings = nil
+			err = fmt.Errorf("check panicked: %v", recovered)
+		}
+	}()
+	return check.Run(ctx)
+}
+
+// builtinCheck wraps one of the analyses which write their findings to a field of the
+// AnomalyAnalysis. The field keeps publishing the findings for clients of earlier versions,
+// new built-in checks implement Check and publish their findings only in the check results.
+type builtinCheck struct {
+	name        string
+	description string
+	inputs      []string
+	field       func(*frrProto.AnomalyAnalysis) *frrProto.AnomalyDetection
+	run         func(a *Analyzer, cycle *analysisCycle)
+}
+
+func (c *builtinCheck) Name() string        { return c.name }
+func (c *builtinCheck) Description() string { return c.description }
+func (c *builtinCheck) Inputs() []string    { return c.inputs }
+
+func (c *builtinCheck) Run(ctx *CheckContext) (*frrProto.AnomalyDetection, error) {
+	if ctx.analyzer == nil || ctx.cycle == nil {
+		return nil, fmt.Errorf("built-in check %s can only run in an analysis cycle", c.name)
+	}
+	c.run(ctx.analyzer, ctx.cycle)
+	return c.field(ctx.analyzer.AnalysisResult), nil
+}
+
+func builtinChecks() []Check {
+	return []Check{
+		&builtinCheck{
+			name:        "RouterAnomaly",
+			description: "Compares the expected with the advertised router LSAs",
+			inputs:      []string{"StaticFrrConfiguration", "OspfRouterData", "OspfNeighbors"},
+			field:       (*frrProto.AnomalyAnalysis).GetRouterAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.RouterAnomalyAnalysisLSDB(cycle.accessList, cycle.shouldRouterLSDB, cycle.isRouterLSDB)
+			},
+		},
+		&builtinCheck{
+			name:        "ExternalAnomaly",
+			description: "Compares the expected with the advertised external LSAs",
+			inputs:      []string{"StaticFrrConfiguration", "OspfExternalData", "RoutingInformationBase"},
+			field:       (*frrProto.AnomalyAnalysis).GetExternalAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.ExternalAnomalyAnalysisLSDB(cycle.shouldExternalLSDB, cycle.isExternalLSDB)
+			},
+		},
+		&builtinCheck{
+			name:        "NssaExternalAnomaly",
+			description: "Compares the expected with the advertised NSSA external LSAs",
+			inputs:      []string{"StaticFrrConfiguration", "OspfNssaExternalData", "RoutingInformationBase"},
+			field:       (*frrProto.AnomalyAnalysis).GetNssaExternalAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				if cycle.isNssa {
+					a.NssaExternalAnomalyAnalysis(cycle.accessList, cycle.shouldNssaExternalLSDB, cycle.isNssaExternalLSDB)
+				}
+			},
+		},
+		&builtinCheck{
+			name:        "SummaryAnomaly",
+			description: "Compares the expected with the advertised summary LSAs",
+			inputs:      []string{"StaticFrrConfiguration", "OspfSummaryData"},
+			field:       (*frrProto.AnomalyAnalysis).GetSummaryAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.SummaryAnomalyAnalysis(a.metrics.StaticFrrConfiguration.GetOspfConfig().GetAreaRange(), cycle.shouldSummaryLSDB, cycle.isSummaryLSDB)
+			},
+		},
+		&builtinCheck{
+			name:        "AsbrSummaryAnomaly",
+			description: "Compares the expected with the advertised ASBR summary LSAs",
+			inputs:      []string{"StaticFrrConfiguration", "OspfAsbrSummaryData"},
+			field:       (*frrProto.AnomalyAnalysis).GetAsbrSummaryAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.AsbrSummaryAnomalyAnalysis(cycle.shouldAsbrSummaryLSDB, cycle.isAsbrSummaryLSDB)
+			},
+		},
+		// TODO: implement ribMap -> fibMap analysis, if necessary?
+		&builtinCheck{
+			name:        "LsdbToRib",
+			description: "Finds LSDB prefixes which are not installed by OSPF in the FIB",
+			inputs:      []string{"RoutingInformationBase", "OspfNetworkDataAll", "OspfSummaryDataAll", "OspfExternalAll", "OspfNssaExternalAll"},
+			field:       (*frrProto.AnomalyAnalysis).GetLsdbToRibAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.AnomalyAnalysisFIB(cycle.fibMap, cycle.receivedNetworkLSDB, cycle.receivedSummaryLSDB, cycle.receivedExternalLSDB, cycle.receivedNssaExternalLSDB)
+			},
+		},
+		&builtinCheck{
+			name:        "Ecmp",
+			description: "Compares the equal-cost paths of the LSDB with the installed next hops",
+			inputs:      []string{"GeneralOspfInformation", "RoutingInformationBase", "OspfDatabase"},
+			field:       (*frrProto.AnomalyAnalysis).GetEcmpAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.EcmpAnalysis()
+			},
+		},
+		&builtinCheck{
+			name:        "IntentAnomaly",
+			description: "Evaluates the declared intent file",
+			inputs:      []string{"StaticFrrConfiguration", "OspfDatabase", "OspfNeighbors", "RoutingInformationBase"},
+			field:       (*frrProto.AnomalyAnalysis).GetIntentAnomaly,
+			run: func(a *Analyzer, cycle *analysisCycle) {
+				a.IntentAnalysis()
+			},
+		},
+	}
+}
+
+func clearAnomalyDetection(detection *frrProto.AnomalyDetection) {
+	detection.HasOverAdvertisedPrefixes = false
+	detection.HasUnAdvertisedPrefixes = false
+	detection.HasDuplicatePrefixes = false
+	detection.HasMisconfiguredPrefixes = false
+	detection.SuperfluousEntries = []*frrProto.Advertisement{}
+	detection.MissingEntries = []*frrProto.Advertisement{}
+	detection.DuplicateEntries = []*frrProto.Advertisement{}
+	detection.MisconfiguredEntries = []*frrProto.Advertisement{}
+	detection.ExplainedEntries = []*frrProto.Advertisement{}
+}
+
+// setDetectionFlags sets the flags of a detection for all entry types it contains.
+func setDetectionFlags(detection *frrProto.AnomalyDetection) {
+	detection.HasOverAdvertisedPrefixes = detection.HasOverAdvertisedPrefixes || len(detection.SuperfluousEntries) > 0
+	detection.HasUnAdvertisedPrefixes = detection.HasUnAdvertisedPrefixes || len(detection.MissingEntries) > 0
+	detection.HasDuplicatePrefixes = detection.HasDuplicatePrefixes || len(detection.DuplicateEntries) > 0
+	detection.HasMisconfiguredPrefixes = detection.HasMisconfiguredPrefixes || len(detection.MisconfiguredEntries) > 0
+}
@@ -0,0 +1,374 @@
+package analyzer
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+const (
+	defaultIntentProtocol      = "ospf"
+	defaultIntentNeighborState = "Full"
+)
+
+// lsdbPrefixes maps lsa type -> area -> prefix -> advertising routers.
+// AS-external LSAs are flooded AS-wide and stored with an empty area.
+type lsdbPrefixes map[string]map[string]map[string][]string
+
+func (l lsdbPrefixes) add(lsaType, area, prefix, advertisingRouter string) {
+	if l[lsaType] == nil {
+		l[lsaType] = map[string]map[string][]string{}
+	}
+	if l[lsaType][area] == nil {
+		l[lsaType][area] = map[string][]string{}
+	}
+	l[lsaType][area][prefix] = append(l[lsaType][area][prefix], advertisingRouter)
+}
+
+// lookup returns the advertising routers of a prefix. Empty lsaType or area match all.
+func (l lsdbPrefixes) lookup(lsaType, area, prefix string) []string {
+	var result []string
+	for currentType, areas := range l {
+		if lsaType != "" && currentType != lsaType {
+			continue
+		}
+		for currentArea, prefixes := range areas {
+			if area != "" && currentArea != area && currentArea != "" {
+				continue
+			}
+			result = append(result, prefixes[prefix]...)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// IntentAnalysis evaluates the assertions of the intent file against the RIB, LSDB,
+// neighbor and area state of the current cycle.
+func (a *Analyzer) IntentAnalysis() {
+	result := &frrProto.AnomalyDetection{
+		SuperfluousEntries:   []*frrProto.Advertisement{},
+		MissingEntries:       []*frrProto.Advertisement{},
+		DuplicateEntries:     []*frrProto.Advertisement{},
+		MisconfiguredEntries: []*frrProto.Advertisement{},
+	}
+
+	if a.Intent != nil {
+		lsdb := a.getLsdbPrefixes()
+
+		for _, intent := range a.Intent.Prefixes.MustHave {
+			if entry := a.checkMustHavePrefix(intent, lsdb); entry != nil {
+				result.MissingEntries = append(result.MissingEntries, entry)
+			}
+		}
+		for _, intent := range a.Intent.Prefixes.MustNotHave {
+			if entry := a.checkMustNotHavePrefix(intent, lsdb); entry != nil {
+				result.SuperfluousEntries = append(result.SuperfluousEntries, entry)
+			}
+		}
+		for _, intent := range a.Intent.Neighbors {
+			if entry := a.checkNeighbor(intent); entry != nil {
+				result.MissingEntries = append(result.MissingEntries, entry)
+			}
+		}
+		for _, intent := range a.Intent.Areas {
+			if entry := a.checkAreaMembership(intent); entry != nil {
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, entry)
+			}
+		}
+		for _, intent := range a.Intent.LsaLimits {
+			if entry := a.checkLsaLimit(intent); entry != nil {
+				result.SuperfluousEntries = append(result.SuperfluousEntries, entry)
+			}
+		}
+	}
+
+	for _, entries := range [][]*frrProto.Advertisement{result.MissingEntries, result.SuperfluousEntries, result.MisconfiguredEntries} {
+		for i, entry := range entries {
+			if i >= 3 {
+				break
+			}
+			a.AnomalyLogger.WithAttrs(map[string]any{
+				"link_state_id": entry.LinkStateId,
+				"area":          entry.OspfArea,
+				"reason":        entry.Reason,
+			}).Warning("Intent violated")
+		}
+	}
+
+	a.AnalysisResult.IntentAnomaly.HasOverAdvertisedPrefixes = len(result.SuperfluousEntries) > 0
+	a.AnalysisResult.IntentAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
+	a.AnalysisResult.IntentAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
+	a.AnalysisResult.IntentAnomaly.SuperfluousEntries = result.SuperfluousEntries
+	a.AnalysisResult.IntentAnomaly.MissingEntries = result.MissingEntries
+	a.AnalysisResult.IntentAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
+
+	a.Logger.WithAttrs(map[string]any{
+		"missing":       len(result.MissingEntries),
+		"superfluous":   len(result.SuperfluousEntries),
+		"misconfigured": len(result.MisconfiguredEntries),
+	}).Debug("Completed intent analysis")
+}
+
+func (a *Analyzer) checkMustHavePrefix(intent configs.IntentPrefix, lsdb lsdbPrefixes) *frrProto.Advertisement {
+	address, prefixLength, ok := normalizeIntentPrefix(intent.Prefix)
+	if !ok {
+		// rejected when the intent file is loaded
+		return nil
+	}
+	prefix := address + "/" + prefixLength
+	entry := &frrProto.Advertisement{
+		LinkStateId:  address,
+		PrefixLength: prefixLength,
+		LinkType:     "must-have prefix",
+		OspfArea:     intent.Area,
+	}
+
+	if intent.Area != "" || intent.LsaType != "" {
+		if len(lsdb.lookup(intent.LsaType, intent.Area, prefix)) == 0 {
+			entry.Reason = fmt.Sprintf("intent: %s must be in the LSDB%s, but no LSA is found", prefix, describeLsdbScope(intent))
+			return entry
+		}
+	}
+
+	if intent.Protocol != "" || intent.LsaType == "" {
+		protocol := intent.Protocol
+		if protocol == "" {
+			protocol = defaultIntentProtocol
+		}
+		if selected := getSelectedRoute(a.metrics.RoutingInformationBase, prefix); selected == nil {
+			entry.Reason = fmt.Sprintf("intent: %s must be reachable via %s, but no route is selected", prefix, protocol)
+			return entry
+		} else if selected.Protocol != protocol {
+			entry.Reason = fmt.Sprintf("intent: %s must be reachable via %s, but is served by %s", prefix, protocol, selected.Protocol)
+			return entry
+		}
+	}
+
+	return nil
+}
+
+func (a *Analyzer) checkMustNotHavePrefix(intent configs.IntentPrefix, lsdb lsdbPrefixes) *frrProto.Advertisement {
+	address, prefixLength, ok := normalizeIntentPrefix(intent.Prefix)
+	if !ok {
+		// rejected when the intent file is loaded
+		return nil
+	}
+	prefix := address + "/" + prefixLength
+	entry := &frrProto.Advertisement{
+		LinkStateId:  address,
+		PrefixLength: prefixLength,
+		LinkType:     "must-not-have prefix",
+		OspfArea:     intent.Area,
+	}
+
+	if intent.Protocol == "" {
+		if routers := lsdb.lookup(intent.LsaType, intent.Area, prefix); len(routers) > 0 {
+			entry.Reason = fmt.Sprintf("intent: %s must never be in the LSDB%s, but is advertised by %s",
+				prefix, describeLsdbScope(intent), strings.Join(routers, ", "))
+			return entry
+		}
+		return nil
+	}
+
+	if selected := getSelectedRoute(a.metrics.RoutingInformationBase, prefix); selected != nil && selected.Protocol == intent.Protocol {
+		entry.Reason = fmt.Sprintf("intent: %s must never be reachable via %s, but a %s route is selected", prefix, intent.Protocol, intent.Protocol)
+		return entry
+	}
+
+	return nil
+}
+
+func (a *Analyzer) checkNeighbor(intent configs.IntentNeighbor) *frrProto.Advertisement {
+	expectedState := intent.State
+	if expectedState == "" {
+		expectedState = defaultIntentNeighborState
+	}
+
+	entry := &frrProto.Advertisement{
+		LinkStateId: intent.RouterId,
+		LinkType:    "neighbor",
+	}
+
+	var states []string
+	for _, neighbor := range a.metrics.GetOspfNeighbors().GetNeighbors()[intent.RouterId].GetNeighbors() {
+		iface := strings.Split(neighbor.IfaceName, ":")[0]
+		if intent.Interface != "" && iface != intent.Interface {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(neighbor.State), strings.ToLower(expectedState)) {
+			return nil
+		}
+		states = append(states, fmt.Sprintf("%s on %s", neighbor.State, iface))
+	}
+
+	if len(states) == 0 {
+		if intent.Interface != "" {
+			entry.Reason = fmt.Sprintf("intent: neighbor %s expected on %s, but no adjacency exists", intent.RouterId, intent.Interface)
+		} else {
+			entry.Reason = fmt.Sprintf("intent: neighbor %s expected, but no adjacency exists", intent.RouterId)
+		}
+		return entry
+	}
+
+	entry.Reason = fmt.Sprintf("intent: neighbor %s expected in state %s, but is %s", intent.RouterId, expectedState, strings.Join(states, ", "))
+	return entry
+}
+
+func (a *Analyzer) checkAreaMembership(intent configs.IntentArea) *frrProto.Advertisement {
+	entry := &frrProto.Advertisement{
+		LinkStateId: intent.Interface,
+		LinkType:    "area membership",
+		OspfArea:    intent.Area,
+	}
+
+	for _, iface := range a.metrics.GetStaticFrrConfiguration().GetInterfaces() {
+		if iface.Name != intent.Interface {
+			continue
+		}
+		if iface.Area == intent.Area {
+			return nil
+		}
+		if iface.Area == "" {
+			entry.Reason = fmt.Sprintf("intent: %s must be in area %s, but is not part of OSPF", intent.Interface, intent.Area)
+		} else {
+			entry.Reason = fmt.Sprintf("intent: %s must be in area %s, but is in area %s", intent.Interface, intent.Area, iface.Area)
+		}
+		return entry
+	}
+
+	entry.Reason = fmt.Sprintf("intent: %s must be in area %s, but the interface is not configured", intent.Interface, intent.Area)
+	return entry
+}
+
+func (a *Analyzer) checkLsaLimit(intent configs.IntentLsaLimit) *frrProto.Advertisement {
+	general := a.metrics.GetGeneralOspfInformation()
+	if general == nil || intent.Max <= 0 {
+		return nil
+	}
+
+	count := 0
+	if intent.LsaType == "external" {
+		count = int(general.LsaExternalCounter)
+	} else {
+		for areaName, area := range general.Areas {
+			if intent.Area != "" && areaName != intent.Area {
+				continue
+			}
+			switch intent.LsaType {
+			case "router":
+				count += int(area.LsaRouterNumber)
+			case "network":
+				count += int(area.LsaNetworkNumber)
+			case "summary":
+				count += int(area.LsaSummaryNumber)
+			case "asbr-summary":
+				count += int(area.LsaAsbrNumber)
+			case "nssa-external":
+				count += int(area.LsaNssaNumber)
+			case "total":
+				count += int(area.LsaNumber)
+			default:
+				return nil
+			}
+		}
+		if intent.LsaType == "total" && intent.Area == "" {
+			count += int(general.LsaExternalCounter)
+		}
+	}
+
+	if count <= intent.Max {
+		return nil
+	}
+
+	scope := ""
+	if intent.Area != "" {
+		scope = " in area " + intent.Area
+	}
+	return &frrProto.Advertisement{
+		LinkStateId: intent.LsaType,
+		LinkType:    "lsa count",
+		OspfArea:    intent.Area,
+		Metric:      int32(count),
+		Reason:      fmt.Sprintf("intent: at most %d %s LSAs%s, but %d are in the LSDB", intent.Max, intent.LsaType, scope, count),
+	}
+}
+
+// getLsdbPrefixes collects the prefixes of all LSAs in the LSDB by lsa type and area
+func (a *Analyzer) getLsdbPrefixes() lsdbPrefixes {
+	result := lsdbPrefixes{}
+
+	for areaName, routerArea := range a.metrics.GetOspfRouterDataAll().GetRouterStates() {
+		for _, lsa := range routerArea.LsaEntries {
+			for _, link := range lsa.RouterLinks {
+				if link.LinkType != "Stub Network" {
+					continue
+				}
+				prefixLength, _ := strconv.Atoi(maskToPrefixLength(link.NetworkMask))
+				result.add("router", areaName, getNetworkAddress(link.NetworkAddress, int32(prefixLength))+"/"+strconv.Itoa(prefixLength), lsa.AdvertisingRouter)
+			}
+		}
+	}
+
+	for areaName, netArea := range a.metrics.GetOspfNetworkDataAll().GetNetStates() {
+		for _, lsa := range netArea.LsaEntries {
+			result.add("network", areaName, getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask)+"/"+strconv.Itoa(int(lsa.NetworkMask)), lsa.AdvertisingRouter)
+		}
+	}
+
+	for areaName, summaryArea := range a.metrics.GetOspfSummaryDataAll().GetSummaryStates() {
+		for _, lsa := range summaryArea.LsaEntries {
+			result.add("summary", areaName, getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask)+"/"+strconv.Itoa(int(lsa.NetworkMask)), lsa.AdvertisingRouter)
+		}
+	}
+
+	for _, lsa := range a.metrics.GetOspfExternalAll().GetAsExternalLinkStates() {
+		result.add("external", "", getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask)+"/"+strconv.Itoa(int(lsa.NetworkMask)), lsa.AdvertisingRouter)
+	}
+
+	for areaName, nssaArea := range a.metrics.GetOspfNssaExternalAll().GetNssaExternalAllLinkStates() {
+		for _, lsa := range nssaArea.Data {
+			result.add("nssa-external", areaName, getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask)+"/"+strconv.Itoa(int(lsa.NetworkMask)), lsa.AdvertisingRouter)
+		}
+	}
+
+	return result
+}
+
+func getSelectedRoute(rib *frrProto.RoutingInformationBase, prefix string) *frrProto.Route {
+	if rib == nil || rib.Routes[prefix] == nil {
+		return nil
+	}
+	for _, route := range rib.Routes[prefix].Routes {
+		if route.Selected {
+			return route
+		}
+	}
+	return nil
+}
+
+// normalizeIntentPrefix returns the network address and prefix length of a CIDR prefix
+func normalizeIntentPrefix(prefix string) (string, string, bool) {
+	_, network, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", "", false
+	}
+	ones, _ := network.Mask.Size()
+	return network.IP.String(), strconv.Itoa(ones), true
+}
+
+func describeLsdbScope(intent configs.IntentPrefix) string {
+	var scope string
+	if intent.LsaType != "" {
+		scope += " as " + intent.LsaType + " LSA"
+	}
+	if intent.Area != "" {
+		scope += " of area " + intent.Area
+	}
+	return scope
+}
@@ -246,6 +246,90 @@ func GetRuntimeSummaryData(config *frrProto.OSPFSummaryData, hostname string, lo
 	return result
 }
 
+// GetRuntimeSummaryDataSelf parses the self-originated summary-LSAs (type 3) per area
+func GetRuntimeSummaryDataSelf(config *frrProto.OSPFSummaryData, hostname string, logger *logger.Logger) *frrProto.InterAreaLsa {
+	if config == nil {
+		logger.Debug("Skipping self-originated summary data parsing - nil input")
+		return nil
+	}
+
+	logger.Debug("Parsing self-originated summary LSDB data")
+	start := time.Now()
+
+	result := &frrProto.InterAreaLsa{
+		Hostname: hostname,
+		RouterId: config.RouterId,
+		Areas:    []*frrProto.AreaAnalyzer{},
+	}
+
+	for areaName, sumStates := range config.SummaryStates {
+		summaryArea := &frrProto.AreaAnalyzer{
+			AreaName: areaName,
+			LsaType:  "summary-LSA",
+			Links:    []*frrProto.Advertisement{},
+		}
+		for _, lsaEntry := range sumStates.LsaEntries {
+			summaryArea.Links = append(summaryArea.Links, &frrProto.Advertisement{
+				LinkStateId:  getNetworkAddress(lsaEntry.LinkStateId, lsaEntry.NetworkMask),
+				PrefixLength: strconv.Itoa(int(lsaEntry.NetworkMask)),
+				LinkType:     "inter-area prefix",
+				Options:      lsaEntry.Options,
+				Metric:       lsaEntry.Tos0Metric,
+			})
+		}
+		result.Areas = append(result.Areas, summaryArea)
+	}
+
+	logger.WithAttrs(map[string]any{
+		"duration":     time.Since(start).String(),
+		"summary_lsas": countTotalLinksNSSA(result),
+	}).Debug("Completed self-originated summary LSDB parsing")
+
+	return result
+}
+
+// GetRuntimeAsbrSummaryDataSelf parses the self-originated ASBR-summary-LSAs (type 4) per area
+func GetRuntimeAsbrSummaryDataSelf(config *frrProto.OSPFAsbrSummaryData, hostname string, logger *logger.Logger) *frrProto.InterAreaLsa {
+	if config == nil {
+		logger.Debug("Skipping self-originated ASBR summary data parsing - nil input")
+		return nil
+	}
+
+	logger.Debug("Parsing self-originated ASBR summary LSDB data")
+	start := time.Now()
+
+	result := &frrProto.InterAreaLsa{
+		Hostname: hostname,
+		RouterId: config.RouterId,
+		Areas:    []*frrProto.AreaAnalyzer{},
+	}
+
+	for areaName, asbrStates := range config.AsbrSummaryStates {
+		asbrArea := &frrProto.AreaAnalyzer{
+			AreaName: areaName,
+			LsaType:  "asbr-summary-LSA",
+			Links:    []*frrProto.Advertisement{},
+		}
+		for _, lsaEntry := range asbrStates.LsaEntries {
+			asbrArea.Links = append(asbrArea.Links, &frrProto.Advertisement{
+				LinkStateId:  lsaEntry.LinkStateId,
+				PrefixLength: "32",
+				LinkType:     "asbr-summary",
+				Options:      lsaEntry.Options,
+				Metric:       lsaEntry.Tos0Metric,
+			})
+		}
+		result.Areas = append(result.Areas, asbrArea)
+	}
+
+	logger.WithAttrs(map[string]any{
+		"duration":          time.Since(start).String(),
+		"asbr_summary_lsas": countTotalLinksNSSA(result),
+	}).Debug("Completed self-originated ASBR summary LSDB parsing")
+
+	return result
+}
+
 // lsa type 5 parsing, this will only return static routes, as BGP routes aren't useful in ospf analysis
 // Since AS-external-LSA (type 5) doesn't belong to a specific area,
 // we'll create a single "area" to represent the AS external links
@@ -273,7 +357,7 @@ func GetRuntimeExternalDataSelf(config *frrProto.OSPFExternalData, staticRouteMa
 	result.Areas = append(result.Areas, &externalArea)
 
 	for key, lsa := range config.AsExternalLinkStates {
-		if _, exists := staticRouteMap[key]; !exists {
+		if _, exists := staticRouteMap[key]; !exists || isDefaultPrefix(key, strconv.Itoa(int(lsa.NetworkMask))) {
 			continue
 		}
 		adv := frrProto.Advertisement{
@@ -359,15 +443,11 @@ func GetNssaExternalData(config *frrProto.OSPFNssaExternalData, staticRouteMap m
 		}
 
 		for key, lsa := range nssaArea.Data {
-			if _, exists := staticRouteMap[key]; !exists {
+			if _, exists := staticRouteMap[key]; !exists || isDefaultPrefix(key, strconv.Itoa(int(lsa.NetworkMask))) {
 				continue
 			}
 
-			pBitSet := false
-			fields := strings.Split(lsa.Options, "|")
-			if len(fields) > 4 && strings.Contains(fields[4], "P") {
-				pBitSet = true
-			}
+			pBitSet := hasPBit(lsa.Options)
 
 			logger.Info(fmt.Sprintf("NSSA route %s/%s has P-bit: %v", lsa.LinkStateId, strconv.Itoa(int(lsa.NetworkMask)), pBitSet))
 
@@ -375,6 +455,7 @@ func GetNssaExternalData(config *frrProto.OSPFNssaExternalData, staticRouteMap m
 				LinkStateId:  lsa.LinkStateId,
 				PrefixLength: strconv.Itoa(int(lsa.NetworkMask)),
 				LinkType:     "nssa-external",
+				PBit:         pBitSet,
 			}
 
 			nssaAreaObj.Links = append(nssaAreaObj.Links, &adv)
@@ -0,0 +1,205 @@
+package analyzer
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+const (
+	defaultHoldDownCycles = 1
+	lifecycleRetention    = 24 * time.Hour
+)
+
+const (
+	AnomalyTypeOverAdvertised = "overadvertised"
+	AnomalyTypeUnAdvertised   = "unadvertised"
+	AnomalyTypeDuplicate      = "duplicate"
+	AnomalyTypeMisconfigured  = "misconfigured"
+)
+
+type anomalySource struct {
+	name      string
+	detection *frrProto.AnomalyDetection
+}
+
+// anomalySources lists the findings of all enabled checks in execution order, named like
+// the check. The findings of the built-in checks are the compatibility fields of the
+// AnomalyAnalysis, e.g. RouterAnomaly.
+func (a *Analyzer) anomalySources() []anomalySource {
+	return resultAnomalySources(a.AnalysisResult)
+}
+
+func resultAnomalySources(analysis *frrProto.AnomalyAnalysis) []anomalySource {
+	var sources []anomalySource
+	for _, result := range analysis.GetCheckResults() {
+		if result.Enabled {
+			sources = append(sources, anomalySource{result.Name, result.Findings})
+		}
+	}
+	return sources
+}
+
+// AnomalyID builds the stable identifier of an anomaly: source:type:prefix:area.
+func AnomalyID(source, anomalyType string, entry *frrProto.Advertisement) string {
+	return strings.Join([]string{source, anomalyType, advertisementPrefix(entry), entry.GetOspfArea()}, ":")
+}
+
+func advertisementPrefix(entry *frrProto.Advertisement) string {
+	address := entry.GetInterfaceAddress()
+	if address == "" {
+		address = entry.GetLinkStateId()
+	}
+	if entry.GetPrefixLength() == "" {
+		return address
+	}
+	return address + "/" + entry.GetPrefixLength()
+}
+
+// UpdateLifecycle records the anomalies of the current cycle in the lifecycle store,
+// resolves the ones that disappeared and hides anomalies still within the hold-down.
+func (a *Analyzer) UpdateLifecycle(now time.Time) {
+	if a.lifecycle == nil {
+		a.lifecycle = map[string]*frrProto.AnomalyLifecycle{}
+	}
+
+	holdDown := a.HoldDownCycles
+	if holdDown < 1 {
+		holdDown = defaultHoldDownCycles
+	}
+
+	timestamp := now.Unix()
+	current := map[string]bool{}
+
+	for _, source := range a.anomalySources() {
+		if source.detection == nil {
+			continue
+		}
+
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			for _, entry := range entries {
+				id := AnomalyID(source.name, anomalyType, entry)
+				if current[id] {
+					continue
+				}
+				current[id] = true
+
+				state, exists := a.lifecycle[id]
+				switch {
+				case !exists:
+					state = &frrProto.AnomalyLifecycle{
+						Id:          id,
+						Source:      source.name,
+						AnomalyType: anomalyType,
+						Prefix:      advertisementPrefix(entry),
+						Area:        entry.GetOspfArea(),
+						FirstSeen:   timestamp,
+						ActiveSince: timestamp,
+					}
+					a.lifecycle[id] = state
+				case !state.Active:
+					state.FlapCount++
+					state.ActiveSince = timestamp
+					state.ResolvedAt = 0
+					state.ConsecutiveCycles = 0
+					state.Confirmed = false
+				}
+
+				state.Active = true
+				state.LastSeen = timestamp
+				state.ConsecutiveCycles++
+				state.DurationSeconds = timestamp - state.ActiveSince
+
+				if !state.Confirmed && state.ConsecutiveCycles >= uint32(holdDown) {
+					state.Confirmed = true
+					a.AnomalyLogger.WithAttrs(map[string]any{
+						"id":         id,
+						"flap_count": state.FlapCount,
+					}).Debug("Anomaly confirmed after hold-down")
+				}
+			}
+		}
+	}
+
+	for id, state := range a.lifecycle {
+		if current[id] {
+			continue
+		}
+
+		if state.Active {
+			state.Active = false
+			state.ResolvedAt = timestamp
+			state.ConsecutiveCycles = 0
+			state.DurationSeconds = timestamp - state.ActiveSince
+
+			if state.Confirmed {
+				a.AnomalyLogger.WithAttrs(map[string]any{
+					"id":       id,
+					"duration": (time.Duration(state.DurationSeconds) * time.Second).String(),
+				}).Info("Anomaly resolved")
+			}
+			continue
+		}
+
+		if now.Sub(time.Unix(state.ResolvedAt, 0)) > lifecycleRetention {
+			delete(a.lifecycle, id)
+		}
+	}
+
+	a.applyHoldDown()
+
+	lifecycle := make([]*frrProto.AnomalyLifecycle, 0, len(a.lifecycle))
+	for _, state := range a.lifecycle {
+		lifecycle = append(lifecycle, state)
+	}
+	sort.Slice(lifecycle, func(i, j int) bool {
+		return lifecycle[i].Id < lifecycle[j].Id
+	})
+	a.AnalysisResult.Lifecycle = lifecycle
+}
+
+// applyHoldDown removes entries which have not yet been active for the configured
+// number of consecutive cycles from the published analysis result.
+func (a *Analyzer) applyHoldDown() {
+	if a.HoldDownCycles <= 1 {
+		return
+	}
+
+	for _, source := range a.anomalySources() {
+		detection := source.detection
+		if detection == nil {
+			continue
+		}
+
+		detection.SuperfluousEntries = a.confirmedEntries(source.name, AnomalyTypeOverAdvertised, detection.SuperfluousEntries)
+		detection.MissingEntries = a.confirmedEntries(source.name, AnomalyTypeUnAdvertised, detection.MissingEntries)
+		detection.DuplicateEntries = a.confirmedEntries(source.name, AnomalyTypeDuplicate, detection.DuplicateEntries)
+		detection.MisconfiguredEntries = a.confirmedEntries(source.name, AnomalyTypeMisconfigured, detection.MisconfiguredEntries)
+
+		detection.HasOverAdvertisedPrefixes = detection.HasOverAdvertisedPrefixes && len(detection.SuperfluousEntries) > 0
+		detection.HasUnAdvertisedPrefixes = detection.HasUnAdvertisedPrefixes && len(detection.MissingEntries) > 0
+		detection.HasDuplicatePrefixes = detection.HasDuplicatePrefixes && len(detection.DuplicateEntries) > 0
+		detection.HasMisconfiguredPrefixes = detection.HasMisconfiguredPrefixes && len(detection.MisconfiguredEntries) > 0
+	}
+}
+
+func (a *Analyzer) confirmedEntries(source, anomalyType string, entries []*frrProto.Advertisement) []*frrProto.Advertisement {
+	result := []*frrProto.Advertisement{}
+	for _, entry := range entries {
+		if state, exists := a.lifecycle[AnomalyID(source, anomalyType, entry)]; exists && state.Confirmed {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+func detectionEntries(detection *frrProto.AnomalyDetection) map[string][]*frrProto.Advertisement {
+	return map[string][]*frrProto.Advertisement{
+		AnomalyTypeOverAdvertised: detection.GetSuperfluousEntries(),
+		AnomalyTypeUnAdvertised:   detection.GetMissingEntries(),
+		AnomalyTypeDuplicate:      detection.GetDuplicateEntries(),
+		AnomalyTypeMisconfigured:  detection.GetMisconfiguredEntries(),
+	}
+}
@@ -1,8 +1,14 @@
 package analyzer
 
 import (
+	"context"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
 )
@@ -16,6 +22,19 @@ type Analyzer struct {
 	P2pMap                     *frrProto.PeerInterfaceMap
 	Logger                     *logger.Logger
 	AnomalyLogger              *logger.Logger
+	HoldDownCycles             int
+	Suppressions               []configs.SuppressionRule
+	Intent                     *configs.Intent
+	Checks                     *CheckRegistry
+	lifecycle                  map[string]*frrProto.AnomalyLifecycle
+	health                     *health.Health
+
+	// pollIntervals passes a changed poll interval to RunAnalyzer
+	pollIntervals chan time.Duration
+
+	listenerMutex  sync.Mutex
+	cycleListeners []func()
+	pendingChanges []func(*Analyzer)
 }
 
 func InitAnalyzer(
@@ -31,6 +50,10 @@ func InitAnalyzer(
 		NssaExternalAnomaly: initAnomalyDetection(),
 		RibToFibAnomaly:     initAnomalyDetection(),
 		LsdbToRibAnomaly:    initAnomalyDetection(),
+		SummaryAnomaly:      initAnomalyDetection(),
+		AsbrSummaryAnomaly:  initAnomalyDetection(),
+		EcmpAnomaly:         initAnomalyDetection(),
+		IntentAnomaly:       initAnomalyDetection(),
 	}
 
 	logger.Debug("Created empty anomaly detection structures")
@@ -39,11 +62,16 @@ func InitAnalyzer(
 		ShouldRouterLsdb:       &frrProto.IntraAreaLsa{},
 		ShouldExternalLsdb:     &frrProto.InterAreaLsa{},
 		ShouldNssaExternalLsdb: &frrProto.InterAreaLsa{},
+		ShouldSummaryLsdb:      &frrProto.InterAreaLsa{},
+		ShouldAsbrSummaryLsdb:  &frrProto.InterAreaLsa{},
 		P2PMap: &frrProto.PeerInterfaceMap{
 			PeerInterfaceToAddress: map[string]string{},
 		},
 	}
 
+	checks := NewCheckRegistry()
+	anomalyAnalysis.CheckResults = checks.checkResults(anomalyAnalysis)
+
 	return &Analyzer{
 		AnalysisResult:             anomalyAnalysis,
 		AnalyserStateParserResults: analyserStateParserResults,
@@ -51,29 +79,109 @@ func InitAnalyzer(
 		P2pMap: &frrProto.PeerInterfaceMap{
 			PeerInterfaceToAddress: map[string]string{},
 		},
-		Logger:        logger,
-		AnomalyLogger: anomalyLogger,
+		Logger:         logger,
+		AnomalyLogger:  anomalyLogger,
+		HoldDownCycles: defaultHoldDownCycles,
+		Checks:         checks,
+		lifecycle:      map[string]*frrProto.AnomalyLifecycle{},
+		pollIntervals:  make(chan time.Duration, 1),
 	}
 }
 
-func StartAnalyzer(analyzer *Analyzer, pollInterval time.Duration) {
+// RunAnalyzer analyzes the collected data every poll interval until the context is canceled.
+// A running analysis is completed before it returns.
+func RunAnalyzer(ctx context.Context, analyzer *Analyzer, pollInterval time.Duration) error {
 	analyzer.Logger.WithAttrs(map[string]any{
 		"interval": pollInterval.String(),
 	}).Info("Starting analyzer")
 
 	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
 
-	go func() {
-		defer ticker.Stop()
-		for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			analyzer.Logger.Info("Analyzer stopped")
+			return nil
+		case interval := <-analyzer.pollIntervals:
+			ticker.Reset(interval)
+			analyzer.Logger.WithAttrs(map[string]any{
+				"interval": interval.String(),
+			}).Info("Changed poll interval")
+		case <-ticker.C:
+			analyzer.applyPendingChanges()
 			start := time.Now()
-			analyzer.AnomalyAnalysis()
+			analyzer.lockedAnomalyAnalysis()
+			duration := time.Since(start)
+			analyzer.health.AnalysisCompleted(duration)
 			analyzer.Logger.WithAttrs(map[string]any{
-				"duration": time.Since(start).String(),
+				"duration": duration.String(),
 			}).Debug("Completed analysis cycle")
+			analyzer.notifyCycleListeners()
 		}
-	}()
+	}
+}
+
+// lockedAnomalyAnalysis runs an analysis cycle under the data lock, as it updates the analysis
+// result in place and reads the collected data.
+func (a *Analyzer) lockedAnomalyAnalysis() {
+	datalock.Lock()
+	defer datalock.Unlock()
+	a.AnomalyAnalysis()
+}
+
+// SetHealth sets the health model which records the duration of the analysis cycles.
+func (a *Analyzer) SetHealth(h *health.Health) {
+	a.health = h
+}
+
+// SetPollInterval changes the interval of a running RunAnalyzer, it takes effect after the
+// current analysis cycle.
+func (a *Analyzer) SetPollInterval(interval time.Duration) {
+	select {
+	case <-a.pollIntervals:
+	default:
+	}
+	select {
+	case a.pollIntervals <- interval:
+	default:
+	}
+}
+
+// Reconfigure queues a change of the settings, e.g. the suppression rules. RunAnalyzer applies
+// it before the next analysis cycle, so a running cycle keeps its settings.
+func (a *Analyzer) Reconfigure(change func(*Analyzer)) {
+	a.listenerMutex.Lock()
+	defer a.listenerMutex.Unlock()
+	a.pendingChanges = append(a.pendingChanges, change)
+}
+
+func (a *Analyzer) applyPendingChanges() {
+	a.listenerMutex.Lock()
+	changes := a.pendingChanges
+	a.pendingChanges = nil
+	a.listenerMutex.Unlock()
+
+	for _, change := range changes {
+		change(a)
+	}
+}
+
+// AddCycleListener registers a function which is called after every analysis cycle of RunAnalyzer.
+func (a *Analyzer) AddCycleListener(listener func()) {
+	a.listenerMutex.Lock()
+	defer a.listenerMutex.Unlock()
+	a.cycleListeners = append(a.cycleListeners, listener)
+}
+
+func (a *Analyzer) notifyCycleListeners() {
+	a.listenerMutex.Lock()
+	listeners := slices.Clone(a.cycleListeners)
+	a.listenerMutex.Unlock()
 
+	for _, listener := range listeners {
+		listener()
+	}
 }
 
 // TODO: implement misconfiguredPrefixes functionality
@@ -86,5 +194,7 @@ func initAnomalyDetection() *frrProto.AnomalyDetection {
 		SuperfluousEntries:        []*frrProto.Advertisement{},
 		MissingEntries:            []*frrProto.Advertisement{},
 		DuplicateEntries:          []*frrProto.Advertisement{},
+		MisconfiguredEntries:      []*frrProto.Advertisement{},
+		ExplainedEntries:          []*frrProto.Advertisement{},
 	}
 }
@@ -1,13 +1,17 @@
 package analyzer
 
 import (
+	"bytes"
 	"fmt"
+	"net"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
 )
 
 func (a *Analyzer) AnomalyAnalysisFIB(fibMap map[string]*frrProto.RibPrefixes, receivedNetworkLSDB *frrProto.IntraAreaLsa, receivedSummaryLSDB *frrProto.InterAreaLsa, receivedExternalLSDB *frrProto.InterAreaLsa, receivedNssaExternalLSDB *frrProto.InterAreaLsa) {
@@ -23,7 +27,8 @@ func (a *Analyzer) AnomalyAnalysisFIB(fibMap map[string]*frrProto.RibPrefixes, r
 	}).Debug("Analysis input counts")
 
 	result := &frrProto.AnomalyDetection{
-		MissingEntries: []*frrProto.Advertisement{},
+		MissingEntries:   []*frrProto.Advertisement{},
+		ExplainedEntries: []*frrProto.Advertisement{},
 	}
 
 	lsdbList := []string{}
@@ -50,41 +55,114 @@ func (a *Analyzer) AnomalyAnalysisFIB(fibMap map[string]*frrProto.RibPrefixes, r
 	sort.Strings(lsdbList)
 
 	for _, entry := range lsdbList {
-		_, exists := fibMap[entry]
-		if !exists {
-			result.HasUnAdvertisedPrefixes = true
-			result.MissingEntries = append(result.MissingEntries, &frrProto.Advertisement{
-				LinkStateId:  strings.Split(entry, "/")[0],
-				PrefixLength: strings.Split(entry, "/")[1],
-			})
+		parts := strings.Split(entry, "/")
+		if len(parts) != 2 {
+			continue
 		}
+
+		var selection, reason string
+		if a.metrics.RoutingInformationBase != nil {
+			selection, reason = classifyRouteSelection(a.metrics.RoutingInformationBase.Routes[entry])
+		} else if _, exists := fibMap[entry]; !exists {
+			selection, reason = RouteSelectionMissing, "no route for the prefix in the FIB"
+		}
+		if selection == "" {
+			continue
+		}
+
+		advertisement := &frrProto.Advertisement{
+			LinkStateId:    parts[0],
+			PrefixLength:   parts[1],
+			Reason:         reason,
+			RouteSelection: selection,
+		}
+		// a shadowed prefix is reachable, it is recorded with its explanation but no anomaly
+		if selection == RouteSelectionShadowed {
+			result.ExplainedEntries = append(result.ExplainedEntries, advertisement)
+			continue
+		}
+		result.HasUnAdvertisedPrefixes = true
+		result.MissingEntries = append(result.MissingEntries, advertisement)
 	}
 
 	a.AnalysisResult.LsdbToRibAnomaly.HasUnAdvertisedPrefixes = result.HasUnAdvertisedPrefixes
 	a.AnalysisResult.LsdbToRibAnomaly.MissingEntries = result.MissingEntries
+	a.AnalysisResult.LsdbToRibAnomaly.ExplainedEntries = result.ExplainedEntries
 
-	if len(result.MissingEntries) > 0 {
+	if result.HasUnAdvertisedPrefixes {
 		missingExamples := make([]string, 0, 3)
-		for i, entry := range result.MissingEntries {
-			if i >= 3 {
+		for _, entry := range result.MissingEntries {
+			if len(missingExamples) >= 3 {
 				break
 			}
 			missingExamples = append(missingExamples,
-				fmt.Sprintf("%s/%s", entry.LinkStateId, entry.PrefixLength))
+				fmt.Sprintf("%s/%s (%s)", entry.LinkStateId, entry.PrefixLength, entry.RouteSelection))
 		}
 
 		a.AnomalyLogger.WithAttrs(map[string]any{
 			"missing_count":    len(result.MissingEntries),
 			"missing_examples": missingExamples,
-			"analysis":         "LSDB contains prefixes not found in FIB",
+			"analysis":         "LSDB contains prefixes not installed by OSPF in the FIB",
 		}).Warning("Found prefixes in LSDB missing from FIB")
 	}
 	a.Logger.WithAttrs(map[string]any{
-		"duration":      time.Since(start).String(),
-		"missing_count": len(result.MissingEntries),
+		"duration":       time.Since(start).String(),
+		"missing_count":  len(result.MissingEntries),
+		"shadowed_count": len(result.ExplainedEntries),
 	}).Debug("Completed FIB-LSDB analysis")
 }
 
+// Route selection classification of an LSDB prefix not installed by OSPF
+const (
+	RouteSelectionShadowed    = "shadowed"
+	RouteSelectionNotSelected = "not-selected"
+	RouteSelectionMissing     = "missing"
+)
+
+// classifyRouteSelection inspects all RIB candidates of a prefix and explains why the
+// OSPF route isn't the installed one. It returns an empty classification if it is.
+func classifyRouteSelection(routes *frrProto.RouteEntry) (string, string) {
+	if routes == nil || len(routes.Routes) == 0 {
+		return RouteSelectionMissing, "no route for the prefix in the RIB"
+	}
+
+	var ospfRoute, selectedRoute *frrProto.Route
+	for _, route := range routes.Routes {
+		if route.Protocol == "ospf" && ospfRoute == nil {
+			ospfRoute = route
+		}
+		if route.Selected && selectedRoute == nil {
+			selectedRoute = route
+		}
+	}
+
+	if ospfRoute != nil && ospfRoute.Selected {
+		if ospfRoute.Installed {
+			return "", ""
+		}
+		return RouteSelectionNotSelected, "OSPF route is selected, but not installed in the FIB"
+	}
+
+	if ospfRoute == nil {
+		if selectedRoute != nil {
+			return RouteSelectionMissing, fmt.Sprintf("no OSPF route in the RIB, prefix is served by %s (distance %d)",
+				selectedRoute.Protocol, selectedRoute.Distance)
+		}
+		return RouteSelectionMissing, "no OSPF route in the RIB"
+	}
+
+	if selectedRoute != nil && selectedRoute.Distance < ospfRoute.Distance {
+		return RouteSelectionShadowed, fmt.Sprintf("shadowed by %s with better administrative distance %d than ospf %d",
+			selectedRoute.Protocol, selectedRoute.Distance, ospfRoute.Distance)
+	}
+	if selectedRoute != nil {
+		return RouteSelectionNotSelected, fmt.Sprintf("OSPF route with distance %d not selected, %s (distance %d) is preferred",
+			ospfRoute.Distance, selectedRoute.Protocol, selectedRoute.Distance)
+	}
+
+	return RouteSelectionNotSelected, fmt.Sprintf("OSPF route with distance %d not selected, no route is selected", ospfRoute.Distance)
+}
+
 func (a *Analyzer) RouterAnomalyAnalysisLSDB(accessList map[string]*frrProto.AccessListAnalyzer, shouldState *frrProto.IntraAreaLsa, isState *frrProto.IntraAreaLsa) (map[string]*frrProto.Advertisement, map[string]*frrProto.Advertisement) {
 	a.Logger.Debug("Starting router LSDB analysis")
 	start := time.Now()
@@ -225,6 +303,9 @@ func (a *Analyzer) ExternalAnomalyAnalysisLSDB(shouldState *frrProto.InterAreaLs
 		}
 	}
 
+	// default route origination, which is not covered by the redistribute based should state
+	a.DefaultRouteAnalysis(result)
+
 	if len(result.MissingEntries) > 0 {
 		missingPrefixes := make([]string, 0, 3)
 		for i, entry := range result.MissingEntries {
@@ -261,27 +342,289 @@ func (a *Analyzer) ExternalAnomalyAnalysisLSDB(shouldState *frrProto.InterAreaLs
 		}).Warning("Over-advertised external LSAs detected")
 	}
 
+	// metric, metric-type, tag and forwarding address validation
+	result.MisconfiguredEntries = append(result.MisconfiguredEntries, a.ExternalAttributeAnalysis()...)
+
+	if len(result.MisconfiguredEntries) > 0 {
+		misconfiguredDetails := make([]map[string]any, 0, 3)
+		for i, entry := range result.MisconfiguredEntries {
+			if i >= 3 {
+				break
+			}
+			misconfiguredDetails = append(misconfiguredDetails, map[string]any{
+				"prefix": fmt.Sprintf("%s/%s", entry.LinkStateId, entry.PrefixLength),
+				"reason": entry.Reason,
+			})
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":                 "external",
+			"count":                len(result.MisconfiguredEntries),
+			"misconfigured_routes": misconfiguredDetails,
+			"analysis":             "External route attributes differ from redistribute configuration",
+		}).Warning("Misconfigured external LSAs detected")
+	}
+
 	a.Logger.WithAttrs(map[string]any{
 		"duration":       time.Since(start).String(),
 		"areas_analyzed": len(isState.Areas),
 		"missing":        len(result.MissingEntries),
 		"extra":          len(result.SuperfluousEntries),
 		"duplicates":     len(result.DuplicateEntries),
+		"misconfigured":  len(result.MisconfiguredEntries),
 	}).Info("Completed external LSDB analysis")
 
 	a.AnalysisResult.ExternalAnomaly.HasOverAdvertisedPrefixes = len(result.SuperfluousEntries) > 0
 	a.AnalysisResult.ExternalAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
 	a.AnalysisResult.ExternalAnomaly.HasDuplicatePrefixes = len(result.DuplicateEntries) > 0
+	a.AnalysisResult.ExternalAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
 	a.AnalysisResult.ExternalAnomaly.MissingEntries = result.MissingEntries
 	a.AnalysisResult.ExternalAnomaly.SuperfluousEntries = result.SuperfluousEntries
 	a.AnalysisResult.ExternalAnomaly.DuplicateEntries = result.DuplicateEntries
+	a.AnalysisResult.ExternalAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
+}
+
+// ExternalAttributeAnalysis compares metric-type, metric, tag and forwarding address
+// of self-originated Type-5 LSAs with the values expected from the redistribute configuration
+func (a *Analyzer) ExternalAttributeAnalysis() []*frrProto.Advertisement {
+	misconfigured := []*frrProto.Advertisement{}
+	if a.metrics == nil || a.metrics.OspfExternalData == nil {
+		return misconfigured
+	}
+
+	config := a.metrics.StaticFrrConfiguration
+	accessList := GetAccessList(config)
+
+	for _, lsa := range a.metrics.OspfExternalData.AsExternalLinkStates {
+		prefixLength := strconv.Itoa(int(lsa.NetworkMask))
+		if isDefaultPrefix(lsa.LinkStateId, prefixLength) {
+			continue
+		}
+		expected := a.getExpectedExternalAttributes(config, accessList, lsa.LinkStateId, prefixLength)
+		if expected == nil {
+			continue
+		}
+
+		problems := compareExternalAttributes(expected, lsa.MetricType, lsa.Metric, uint32(lsa.ExternalRouteTag), lsa.ForwardAddress, true)
+		if len(problems) == 0 {
+			continue
+		}
+
+		misconfigured = append(misconfigured, &frrProto.Advertisement{
+			LinkStateId:  lsa.LinkStateId,
+			PrefixLength: prefixLength,
+			LinkType:     "external",
+			Metric:       lsa.Metric,
+			Reason:       fmt.Sprintf("redistribute %s: %s", expected.Source, strings.Join(problems, ", ")),
+		})
+	}
+
+	sortAdvertisements(misconfigured)
+	return misconfigured
+}
+
+// NssaExternalAttributeAnalysis compares metric-type, metric and tag of self-originated
+// Type-7 LSAs with the redistribute configuration, the forwarding address is checked by NssaTranslatorAnalysis
+func (a *Analyzer) NssaExternalAttributeAnalysis() []*frrProto.Advertisement {
+	misconfigured := []*frrProto.Advertisement{}
+	if a.metrics == nil || a.metrics.OspfNssaExternalData == nil {
+		return misconfigured
+	}
+
+	config := a.metrics.StaticFrrConfiguration
+	accessList := GetAccessList(config)
+
+	for _, area := range a.metrics.OspfNssaExternalData.NssaExternalLinkStates {
+		for _, lsa := range area.Data {
+			prefixLength := strconv.Itoa(int(lsa.NetworkMask))
+			if isDefaultPrefix(lsa.LinkStateId, prefixLength) {
+				continue
+			}
+			expected := a.getExpectedExternalAttributes(config, accessList, lsa.LinkStateId, prefixLength)
+			if expected == nil {
+				continue
+			}
+
+			problems := compareExternalAttributes(expected, lsa.MetricType, lsa.Metric, uint32(lsa.ExternalRouteTag), "", false)
+			if len(problems) == 0 {
+				continue
+			}
+
+			misconfigured = append(misconfigured, &frrProto.Advertisement{
+				LinkStateId:  lsa.LinkStateId,
+				PrefixLength: prefixLength,
+				LinkType:     "nssa-external",
+				Metric:       lsa.Metric,
+				Reason:       fmt.Sprintf("redistribute %s: %s", expected.Source, strings.Join(problems, ", ")),
+			})
+		}
+	}
+
+	sortAdvertisements(misconfigured)
+	return misconfigured
+}
+
+// DefaultRouteAnalysis verifies the self-originated Type-5 default route against
+// "default-information originate" and reports other routers also originating a default
+func (a *Analyzer) DefaultRouteAnalysis(result *frrProto.AnomalyDetection) {
+	config := a.metrics.StaticFrrConfiguration
+	if config == nil || config.OspfConfig == nil {
+		return
+	}
+
+	expected := a.getExpectedDefaultRoute(config)
+	defaultInformation := config.OspfConfig.GetDefaultInformation()
+
+	var originated *frrProto.ExternalLSA
+	if a.metrics.OspfExternalData != nil {
+		if lsa, exists := a.metrics.OspfExternalData.AsExternalLinkStates["0.0.0.0"]; exists && lsa.NetworkMask == 0 {
+			originated = lsa
+		}
+	}
+
+	adv := &frrProto.Advertisement{
+		LinkStateId:  "0.0.0.0",
+		PrefixLength: "0",
+		LinkType:     "external",
+	}
+
+	switch {
+	case expected != nil && originated == nil:
+		adv.Reason = expected.Source + " configured, but no default route is originated"
+		result.MissingEntries = append(result.MissingEntries, adv)
+	case expected == nil && originated != nil:
+		adv.Metric = originated.Metric
+		if defaultInformation.GetOriginate() {
+			adv.Reason = "default route originated, but no default route exists in the RIB"
+		} else {
+			adv.Reason = "default route originated without default-information originate"
+		}
+		result.SuperfluousEntries = append(result.SuperfluousEntries, adv)
+	case expected != nil:
+		problems := compareExternalAttributes(expected, originated.MetricType, originated.Metric, uint32(originated.ExternalRouteTag), "", false)
+		if len(problems) > 0 {
+			adv.Metric = originated.Metric
+			adv.Reason = fmt.Sprintf("%s: %s", expected.Source, strings.Join(problems, ", "))
+			result.MisconfiguredEntries = append(result.MisconfiguredEntries, adv)
+		}
+	}
+
+	if expected == nil || a.metrics.OspfExternalAll == nil {
+		return
+	}
+
+	selfRouterId := config.OspfConfig.GetRouterId()
+	for _, lsa := range a.metrics.OspfExternalAll.AsExternalLinkStates {
+		if lsa.LinkStateId != "0.0.0.0" || lsa.NetworkMask != 0 || lsa.AdvertisingRouter == selfRouterId {
+			continue
+		}
+		result.DuplicateEntries = append(result.DuplicateEntries, &frrProto.Advertisement{
+			LinkStateId:  "0.0.0.0",
+			PrefixLength: "0",
+			LinkType:     "external",
+			Metric:       lsa.Metric,
+			Reason:       "default route also originated by " + lsa.AdvertisingRouter,
+		})
+	}
+}
+
+// NssaDefaultRouteAnalysis verifies the self-originated Type-7 default route of every NSSA area
+// with "default-information-originate" and reports other routers also originating one into the area
+func (a *Analyzer) NssaDefaultRouteAnalysis(result *frrProto.AnomalyDetection) {
+	config := a.metrics.StaticFrrConfiguration
+	if config == nil || config.OspfConfig == nil {
+		return
+	}
+
+	selfRouterId := config.OspfConfig.GetRouterId()
+
+	for _, area := range config.OspfConfig.Area {
+		if area.Type != "nssa" {
+			continue
+		}
+
+		expected := a.isNssaDefaultRouteExpected(config, area)
+		originated := false
+		if a.metrics.OspfNssaExternalData != nil {
+			if nssaArea, exists := a.metrics.OspfNssaExternalData.NssaExternalLinkStates[area.Name]; exists {
+				lsa, exists := nssaArea.Data["0.0.0.0"]
+				originated = exists && lsa.NetworkMask == 0
+			}
+		}
+
+		adv := &frrProto.Advertisement{
+			LinkStateId:  "0.0.0.0",
+			PrefixLength: "0",
+			LinkType:     "nssa-external",
+			OspfArea:     area.Name,
+		}
+
+		switch {
+		case expected && !originated:
+			adv.Reason = "nssa default-information-originate configured, but no Type-7 default route is originated"
+			result.MissingEntries = append(result.MissingEntries, adv)
+		case !expected && originated && area.NssaDefaultOriginate:
+			adv.Reason = "Type-7 default route originated, but no default route exists in the RIB"
+			result.SuperfluousEntries = append(result.SuperfluousEntries, adv)
+		case !expected && originated:
+			adv.Reason = "Type-7 default route originated without nssa default-information-originate"
+			result.SuperfluousEntries = append(result.SuperfluousEntries, adv)
+		}
+
+		if !expected || a.metrics.OspfNssaExternalAll == nil {
+			continue
+		}
+
+		nssaArea, exists := a.metrics.OspfNssaExternalAll.NssaExternalAllLinkStates[area.Name]
+		if !exists {
+			continue
+		}
+		for _, lsa := range nssaArea.Data {
+			if lsa.LinkStateId != "0.0.0.0" || lsa.NetworkMask != 0 || lsa.AdvertisingRouter == selfRouterId {
+				continue
+			}
+			result.DuplicateEntries = append(result.DuplicateEntries, &frrProto.Advertisement{
+				LinkStateId:  "0.0.0.0",
+				PrefixLength: "0",
+				LinkType:     "nssa-external",
+				OspfArea:     area.Name,
+				Metric:       lsa.Metric,
+				Reason:       "Type-7 default route also originated by " + lsa.AdvertisingRouter,
+			})
+		}
+	}
+}
+
+func compareExternalAttributes(expected *externalAttributes, metricType string, metric int32, tag uint32, forwardingAddress string, checkForwardingAddress bool) []string {
+	var problems []string
+
+	if normalizeMetricType(metricType) != expected.MetricType {
+		problems = append(problems, fmt.Sprintf("metric-type %s, expected %s", metricType, expected.MetricType))
+	}
+	if metric != expected.Metric {
+		problems = append(problems, fmt.Sprintf("metric %d, expected %d", metric, expected.Metric))
+	}
+	if tag != expected.Tag {
+		problems = append(problems, fmt.Sprintf("tag %d, expected %d", tag, expected.Tag))
+	}
+	if checkForwardingAddress && forwardingAddress != "" && forwardingAddress != expected.ForwardingAddress {
+		problems = append(problems, fmt.Sprintf("forwarding address %s, expected %s", forwardingAddress, expected.ForwardingAddress))
+	}
+
+	return problems
+}
+
+func sortAdvertisements(advertisements []*frrProto.Advertisement) {
+	sort.Slice(advertisements, func(i, j int) bool {
+		return getAdvertisementKey(advertisements[i]) < getAdvertisementKey(advertisements[j])
+	})
 }
 
 func normalizeNetworkAddress(address string) string {
 	return strings.ToLower(strings.TrimSpace(address))
 }
 
-func (a *Analyzer) NssaExternalAnomalyAnalysis(accessList map[string]*frrProto.AccessListAnalyzer, shouldState *frrProto.InterAreaLsa, isState *frrProto.InterAreaLsa, externalState *frrProto.InterAreaLsa) {
+func (a *Analyzer) NssaExternalAnomalyAnalysis(accessList map[string]*frrProto.AccessListAnalyzer, shouldState *frrProto.InterAreaLsa, isState *frrProto.InterAreaLsa) {
 	a.Logger.Debug("Starting NSSA external analysis")
 	start := time.Now()
 
@@ -295,6 +638,7 @@ func (a *Analyzer) NssaExternalAnomalyAnalysis(accessList map[string]*frrProto.A
 		SuperfluousEntries:       []*frrProto.Advertisement{},
 		MissingEntries:           []*frrProto.Advertisement{},
 		DuplicateEntries:         []*frrProto.Advertisement{},
+		MisconfiguredEntries:     []*frrProto.Advertisement{},
 	}
 
 	isStateMap := make(map[string]map[string]*frrProto.Advertisement)
@@ -396,16 +740,40 @@ func (a *Analyzer) NssaExternalAnomalyAnalysis(accessList map[string]*frrProto.A
 		}).Warning("Over-advertised NSSA external LSAs detected")
 	}
 
-	// P-bit validation
-	a.checkNssaPBitTranslation(isState, externalState, result)
+	// Type-7 to Type-5 translation and forwarding address validation
+	a.NssaTranslatorAnalysis(result)
+	result.MisconfiguredEntries = append(result.MisconfiguredEntries, a.NssaExternalAttributeAnalysis()...)
+	a.NssaDefaultRouteAnalysis(result)
+
+	if len(result.MisconfiguredEntries) > 0 {
+		misconfiguredDetails := make([]map[string]any, 0, 3)
+		for i, entry := range result.MisconfiguredEntries {
+			if i >= 3 {
+				break
+			}
+			misconfiguredDetails = append(misconfiguredDetails, map[string]any{
+				"prefix": fmt.Sprintf("%s/%s", entry.LinkStateId, entry.PrefixLength),
+				"reason": entry.Reason,
+			})
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":                 "nssa",
+			"count":                len(result.MisconfiguredEntries),
+			"misconfigured_routes": misconfiguredDetails,
+			"analysis":             "NSSA translation or forwarding address problems",
+		}).Warning("Misconfigured NSSA external LSAs detected")
+	}
 
 	// Update analysis result
 	a.AnalysisResult.NssaExternalAnomaly.HasOverAdvertisedPrefixes = len(result.SuperfluousEntries) > 0
 	a.AnalysisResult.NssaExternalAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
 	a.AnalysisResult.NssaExternalAnomaly.HasDuplicatePrefixes = len(result.DuplicateEntries) > 0
+	a.AnalysisResult.NssaExternalAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
 	a.AnalysisResult.NssaExternalAnomaly.MissingEntries = result.MissingEntries
 	a.AnalysisResult.NssaExternalAnomaly.SuperfluousEntries = result.SuperfluousEntries
 	a.AnalysisResult.NssaExternalAnomaly.DuplicateEntries = result.DuplicateEntries
+	a.AnalysisResult.NssaExternalAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
 
 	a.Logger.WithAttrs(map[string]any{
 		"duration":   time.Since(start).String(),
@@ -449,35 +817,209 @@ func filterUnique(lsaList []string) []string {
 	return uniqueNames
 }
 
-func (a *Analyzer) checkNssaPBitTranslation(nssaState *frrProto.InterAreaLsa, externalState *frrProto.InterAreaLsa, result *frrProto.AnomalyDetection) {
-	if externalState == nil {
+// NssaTranslatorAnalysis verifies the Type-7 to Type-5 translation of every
+// attached NSSA area. The elected translator is the NSSA border router with the
+// Nt-bit (translate-always) or else the one with the highest router id. Every
+// P-bit Type-7 must show up as Type-5 originated by that translator and every
+// forwarding address involved must be reachable in the RIB.
+func (a *Analyzer) NssaTranslatorAnalysis(result *frrProto.AnomalyDetection) {
+	config := a.metrics.StaticFrrConfiguration
+	if config == nil || config.OspfConfig == nil || a.metrics.OspfNssaExternalAll == nil {
+		a.Logger.Debug("Skipping NSSA translator analysis - missing input data")
 		return
 	}
 
-	externalMap := make(map[string]bool)
-	for _, area := range externalState.Areas {
-		for _, link := range area.Links {
-			key := getAdvertisementKey(link)
-			externalMap[key] = true
+	externalMap := make(map[string][]*frrProto.ASExternalLinkState)
+	if a.metrics.OspfExternalAll != nil {
+		for _, lsa := range a.metrics.OspfExternalAll.AsExternalLinkStates {
+			key := getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask) + "/" + strconv.Itoa(int(lsa.NetworkMask))
+			externalMap[key] = append(externalMap[key], lsa)
 		}
 	}
 
-	for _, area := range nssaState.Areas {
-		if area.LsaType != "NSSA-LSA" {
+	// Type-5 LSAs are not flooded into an NSSA, so only a border router of the area sees
+	// whether its Type-7 LSAs were translated. Internal routers check the forwarding address.
+	attachedAreas := getAttachedAreas(config)
+	isAbr := isAreaBorderRouter(attachedAreas, getOspfArea(a.metrics.GeneralOspfInformation))
+
+	for _, area := range config.OspfConfig.Area {
+		if area.Type != "nssa" {
 			continue
 		}
-		for _, link := range area.Links {
-			if link.PBit {
-				key := getAdvertisementKey(link)
-				if !externalMap[key] {
-					// P-bit set, but no matching Type-5 exists
-					result.MissingEntries = append(result.MissingEntries, link)
+
+		nssaArea, exists := a.metrics.OspfNssaExternalAll.NssaExternalAllLinkStates[area.Name]
+		if !exists {
+			continue
+		}
+
+		checkTranslation := isAbr && slices.Contains(attachedAreas, area.Name)
+		translators := a.getNssaTranslators(area)
+		a.Logger.WithAttrs(map[string]any{
+			"nssa_area":   area.Name,
+			"translators": translators,
+		}).Debug("Determined elected NSSA translators")
+
+		for _, lsa := range nssaArea.Data {
+			prefix := getNetworkAddress(lsa.LinkStateId, lsa.NetworkMask)
+			prefixLength := strconv.Itoa(int(lsa.NetworkMask))
+			// a Type-7 default route is never translated (RFC 3101), see NssaDefaultRouteAnalysis
+			if isDefaultPrefix(prefix, prefixLength) {
+				continue
+			}
+			adv := &frrProto.Advertisement{
+				LinkStateId:  prefix,
+				PrefixLength: prefixLength,
+				LinkType:     "nssa-external",
+				PBit:         hasPBit(lsa.Options),
+				Options:      lsa.Options,
+				OspfArea:     area.Name,
+			}
+
+			if reason := a.getForwardingAddressProblem(lsa.NssaForwardAddress); reason != "" {
+				misconfigured := proto.Clone(adv).(*frrProto.Advertisement)
+				misconfigured.Reason = "Type-7 " + reason
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, misconfigured)
+			}
+
+			if !checkTranslation || !adv.PBit {
+				continue
+			}
+
+			if len(translators) == 0 {
+				adv.Reason = "P-bit set, but no NSSA border router is available to translate it"
+				result.MissingEntries = append(result.MissingEntries, adv)
+				continue
+			}
+
+			if lsa.NssaForwardAddress == "" || lsa.NssaForwardAddress == "0.0.0.0" {
+				adv.Reason = "P-bit set, but forwarding address is 0.0.0.0, so the LSA cannot be translated"
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, adv)
+				continue
+			}
+
+			var translated *frrProto.ASExternalLinkState
+			var foreignOriginators []string
+			for _, external := range externalMap[prefix+"/"+prefixLength] {
+				if slices.Contains(translators, external.AdvertisingRouter) {
+					translated = external
+					break
+				}
+				foreignOriginators = append(foreignOriginators, external.AdvertisingRouter)
+			}
+
+			switch {
+			case translated != nil:
+				if reason := a.getForwardingAddressProblem(translated.ForwardAddress); reason != "" {
+					adv.Reason = "translated Type-5 " + reason
+					result.MisconfiguredEntries = append(result.MisconfiguredEntries, adv)
 				}
+			case len(foreignOriginators) > 0:
+				adv.Reason = fmt.Sprintf("Type-5 originated by %s instead of elected translator %s",
+					strings.Join(foreignOriginators, ", "), strings.Join(translators, ", "))
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, adv)
+			default:
+				adv.Reason = fmt.Sprintf("P-bit set, but not translated to Type-5 by elected translator %s",
+					strings.Join(translators, ", "))
+				result.MissingEntries = append(result.MissingEntries, adv)
 			}
 		}
 	}
 }
 
+// getNssaTranslators returns the router ids expected to translate Type-7 LSAs of
+// the given NSSA area into Type-5 LSAs
+func (a *Analyzer) getNssaTranslators(area *frrProto.Area) []string {
+	const (
+		routerLsaBorderBit     = 0x01
+		routerLsaTranslatorBit = 0x10
+	)
+
+	selfRouterId := a.metrics.StaticFrrConfiguration.OspfConfig.GetRouterId()
+	var candidates, alwaysTranslators []string
+
+	if a.metrics.OspfRouterDataAll != nil {
+		if routerArea, exists := a.metrics.OspfRouterDataAll.RouterStates[area.Name]; exists {
+			for _, lsa := range routerArea.LsaEntries {
+				if lsa.AdvertisingRouter == selfRouterId || lsa.Flags&routerLsaBorderBit == 0 {
+					continue
+				}
+				if lsa.Flags&routerLsaTranslatorBit != 0 {
+					alwaysTranslators = append(alwaysTranslators, lsa.AdvertisingRouter)
+				}
+				candidates = append(candidates, lsa.AdvertisingRouter)
+			}
+		}
+	}
+
+	// the own role comes from the configuration, as the Nt-bit is only set once translating
+	attachedAreas := getAttachedAreas(a.metrics.StaticFrrConfiguration)
+	if isAreaBorderRouter(attachedAreas, getOspfArea(a.metrics.GeneralOspfInformation)) {
+		switch area.NssaTranslatorRole {
+		case "translate-always":
+			alwaysTranslators = append(alwaysTranslators, selfRouterId)
+		case "translate-never":
+		default:
+			candidates = append(candidates, selfRouterId)
+		}
+	}
+
+	if len(alwaysTranslators) > 0 {
+		sort.Strings(alwaysTranslators)
+		return alwaysTranslators
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	elected := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if compareRouterIds(candidate, elected) > 0 {
+			elected = candidate
+		}
+	}
+
+	return []string{elected}
+}
+
+// getForwardingAddressProblem returns a description if a non-zero forwarding
+// address isn't covered by a more specific route than the default route
+func (a *Analyzer) getForwardingAddressProblem(forwardingAddress string) string {
+	if forwardingAddress == "" || forwardingAddress == "0.0.0.0" || a.metrics.RoutingInformationBase == nil {
+		return ""
+	}
+
+	address := net.ParseIP(forwardingAddress)
+	if address == nil {
+		return fmt.Sprintf("forwarding address %s is invalid", forwardingAddress)
+	}
+
+	for prefix := range a.metrics.RoutingInformationBase.Routes {
+		_, network, err := net.ParseCIDR(prefix)
+		if err != nil {
+			continue
+		}
+		if ones, _ := network.Mask.Size(); ones > 0 && network.Contains(address) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("forwarding address %s is not reachable in the RIB", forwardingAddress)
+}
+
+func hasPBit(options string) bool {
+	fields := strings.Split(options, "|")
+	return len(fields) > 4 && strings.Contains(fields[4], "P")
+}
+
+func compareRouterIds(first, second string) int {
+	firstIp := net.ParseIP(first).To4()
+	secondIp := net.ParseIP(second).To4()
+	if firstIp == nil || secondIp == nil {
+		return strings.Compare(first, second)
+	}
+	return bytes.Compare(firstIp, secondIp)
+}
+
 func getAdvertisementKey(adv *frrProto.Advertisement) string {
 	if adv.LinkType == "transit network" {
 		return normalizeNetworkAddress(adv.InterfaceAddress)
@@ -547,6 +1089,10 @@ func getLsdbStateMap(lsdbState any) map[string]*frrProto.Advertisement {
 				LinkStateId:      link.LinkStateId,
 				LinkType:         link.LinkType,
 				PrefixLength:     link.PrefixLength,
+				OspfArea:         link.OspfArea,
+			}
+			if area.AreaName != "" {
+				adv.OspfArea = area.AreaName
 			}
 			if strings.ToLower(link.LinkType) == "unknown" {
 				prefixLength := "/" + link.PrefixLength
@@ -562,3 +1108,438 @@ func getLsdbStateMap(lsdbState any) map[string]*frrProto.Advertisement {
 
 	return result
 }
+
+// SummaryAnomalyAnalysis compares the expected summary-LSAs (type 3) of an ABR
+// with the self-originated ones. Inter-area routes learned over the backbone are
+// re-advertised as well and cannot be predicted from the configuration, so extra
+// summaries are only reported when they clearly violate the area configuration.
+func (a *Analyzer) SummaryAnomalyAnalysis(areaRanges []*frrProto.AreaRange, shouldState *frrProto.InterAreaLsa, isState *frrProto.InterAreaLsa) {
+	a.Logger.Debug("Starting summary LSDB analysis")
+	start := time.Now()
+
+	if isState == nil || shouldState == nil {
+		a.Logger.Warning("Skipping summary analysis - missing input data")
+		return
+	}
+
+	result := &frrProto.AnomalyDetection{
+		SuperfluousEntries:   []*frrProto.Advertisement{},
+		MissingEntries:       []*frrProto.Advertisement{},
+		DuplicateEntries:     []*frrProto.Advertisement{},
+		MisconfiguredEntries: []*frrProto.Advertisement{},
+	}
+
+	shouldStateMap := getAreaLsdbStateMap(shouldState)
+	isStateMap := getAreaLsdbStateMap(isState)
+	areaTypes := make(map[string]string)
+	for _, area := range shouldState.Areas {
+		areaTypes[area.AreaName] = area.AreaType
+	}
+
+	for areaName, shouldLinks := range shouldStateMap {
+		for key, shouldLink := range shouldLinks {
+			isLink, exists := isStateMap[areaName][key]
+			if !exists {
+				result.MissingEntries = append(result.MissingEntries, shouldLink)
+				continue
+			}
+			if shouldLink.Metric > 0 && isLink.Metric != shouldLink.Metric {
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, isLink)
+			}
+		}
+	}
+
+	for areaName, isLinks := range isStateMap {
+		for key, isLink := range isLinks {
+			if _, exists := shouldStateMap[areaName][key]; exists {
+				continue
+			}
+			if isSummaryForbidden(isLink, areaName, areaTypes[areaName], areaRanges) {
+				result.SuperfluousEntries = append(result.SuperfluousEntries, isLink)
+			}
+		}
+	}
+
+	if len(result.MissingEntries) > 0 {
+		missingPrefixes := make([]string, 0, 3)
+		for i, entry := range result.MissingEntries {
+			if i >= 3 {
+				break
+			}
+			missingPrefixes = append(missingPrefixes,
+				fmt.Sprintf("%s/%s (area %s)", entry.LinkStateId, entry.PrefixLength, entry.OspfArea))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":             "summary",
+			"count":            len(result.MissingEntries),
+			"missing_prefixes": missingPrefixes,
+			"analysis":         "Expected inter-area prefixes not advertised by ABR",
+		}).Warning("Missing summary LSAs detected")
+	}
+
+	if len(result.SuperfluousEntries) > 0 {
+		extraPrefixes := make([]string, 0, 3)
+		for i, entry := range result.SuperfluousEntries {
+			if i >= 3 {
+				break
+			}
+			extraPrefixes = append(extraPrefixes,
+				fmt.Sprintf("%s/%s (area %s)", entry.LinkStateId, entry.PrefixLength, entry.OspfArea))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":           "summary",
+			"count":          len(result.SuperfluousEntries),
+			"extra_prefixes": extraPrefixes,
+			"analysis":       "Inter-area prefixes advertised despite range or no-summary configuration",
+		}).Warning("Over-advertised summary LSAs detected")
+	}
+
+	if len(result.MisconfiguredEntries) > 0 {
+		costExamples := make([]string, 0, 3)
+		for i, entry := range result.MisconfiguredEntries {
+			if i >= 3 {
+				break
+			}
+			costExamples = append(costExamples,
+				fmt.Sprintf("%s/%s cost %d", entry.LinkStateId, entry.PrefixLength, entry.Metric))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":          "summary",
+			"count":         len(result.MisconfiguredEntries),
+			"cost_examples": costExamples,
+			"analysis":      "Summary LSAs advertised with unexpected cost",
+		}).Warning("Misconfigured summary LSAs detected")
+	}
+
+	a.Logger.WithAttrs(map[string]any{
+		"duration":       time.Since(start).String(),
+		"areas_analyzed": len(isState.Areas),
+		"missing":        len(result.MissingEntries),
+		"extra":          len(result.SuperfluousEntries),
+		"misconfigured":  len(result.MisconfiguredEntries),
+	}).Info("Completed summary LSDB analysis")
+
+	a.AnalysisResult.SummaryAnomaly.HasOverAdvertisedPrefixes = len(result.SuperfluousEntries) > 0
+	a.AnalysisResult.SummaryAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
+	a.AnalysisResult.SummaryAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
+	a.AnalysisResult.SummaryAnomaly.MissingEntries = result.MissingEntries
+	a.AnalysisResult.SummaryAnomaly.SuperfluousEntries = result.SuperfluousEntries
+	a.AnalysisResult.SummaryAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
+}
+
+// AsbrSummaryAnomalyAnalysis compares the expected ASBR-summary-LSAs (type 4) of an
+// ABR with the self-originated ones.
+func (a *Analyzer) AsbrSummaryAnomalyAnalysis(shouldState *frrProto.InterAreaLsa, isState *frrProto.InterAreaLsa) {
+	a.Logger.Debug("Starting ASBR summary LSDB analysis")
+	start := time.Now()
+
+	if isState == nil || shouldState == nil {
+		a.Logger.Warning("Skipping ASBR summary analysis - missing input data")
+		return
+	}
+
+	result := &frrProto.AnomalyDetection{
+		SuperfluousEntries:   []*frrProto.Advertisement{},
+		MissingEntries:       []*frrProto.Advertisement{},
+		DuplicateEntries:     []*frrProto.Advertisement{},
+		MisconfiguredEntries: []*frrProto.Advertisement{},
+	}
+
+	shouldStateMap := getAreaLsdbStateMap(shouldState)
+	isStateMap := getAreaLsdbStateMap(isState)
+	areaTypes := make(map[string]string)
+	for _, area := range shouldState.Areas {
+		areaTypes[area.AreaName] = area.AreaType
+	}
+
+	for areaName, shouldLinks := range shouldStateMap {
+		for key, shouldLink := range shouldLinks {
+			isLink, exists := isStateMap[areaName][key]
+			if !exists {
+				result.MissingEntries = append(result.MissingEntries, shouldLink)
+				continue
+			}
+			// the metric is the intra-area cost to the ASBR, unknown if it is not reachable
+			if shouldLink.Metric > 0 && isLink.Metric != shouldLink.Metric {
+				result.MisconfiguredEntries = append(result.MisconfiguredEntries, isLink)
+			}
+		}
+	}
+
+	for areaName, isLinks := range isStateMap {
+		for key, isLink := range isLinks {
+			if _, exists := shouldStateMap[areaName][key]; exists {
+				continue
+			}
+			// type 4 LSAs must neither describe the router itself nor enter stub or NSSA areas
+			areaType, known := areaTypes[areaName]
+			if isLink.LinkStateId == shouldState.RouterId || (known && areaType != "normal") {
+				result.SuperfluousEntries = append(result.SuperfluousEntries, isLink)
+			}
+		}
+	}
+
+	if len(result.MissingEntries) > 0 {
+		missingRouters := make([]string, 0, 3)
+		for i, entry := range result.MissingEntries {
+			if i >= 3 {
+				break
+			}
+			missingRouters = append(missingRouters,
+				fmt.Sprintf("%s (area %s)", entry.LinkStateId, entry.OspfArea))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":            "asbr-summary",
+			"count":           len(result.MissingEntries),
+			"missing_routers": missingRouters,
+			"analysis":        "Expected ASBR summaries not advertised by ABR",
+		}).Warning("Missing ASBR summary LSAs detected")
+	}
+
+	if len(result.SuperfluousEntries) > 0 {
+		extraRouters := make([]string, 0, 3)
+		for i, entry := range result.SuperfluousEntries {
+			if i >= 3 {
+				break
+			}
+			extraRouters = append(extraRouters,
+				fmt.Sprintf("%s (area %s)", entry.LinkStateId, entry.OspfArea))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":          "asbr-summary",
+			"count":         len(result.SuperfluousEntries),
+			"extra_routers": extraRouters,
+			"analysis":      "ASBR summaries advertised into stub/NSSA areas or for the router itself",
+		}).Warning("Over-advertised ASBR summary LSAs detected")
+	}
+
+	if len(result.MisconfiguredEntries) > 0 {
+		costExamples := make([]string, 0, 3)
+		for i, entry := range result.MisconfiguredEntries {
+			if i >= 3 {
+				break
+			}
+			costExamples = append(costExamples,
+				fmt.Sprintf("%s (area %s) cost %d", entry.LinkStateId, entry.OspfArea, entry.Metric))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":          "asbr-summary",
+			"count":         len(result.MisconfiguredEntries),
+			"cost_examples": costExamples,
+			"analysis":      "ASBR summaries advertised with a cost other than the intra-area cost to the ASBR",
+		}).Warning("Misconfigured ASBR summary LSAs detected")
+	}
+
+	a.Logger.WithAttrs(map[string]any{
+		"duration":       time.Since(start).String(),
+		"areas_analyzed": len(isState.Areas),
+		"missing":        len(result.MissingEntries),
+		"extra":          len(result.SuperfluousEntries),
+		"misconfigured":  len(result.MisconfiguredEntries),
+	}).Info("Completed ASBR summary LSDB analysis")
+
+	a.AnalysisResult.AsbrSummaryAnomaly.HasOverAdvertisedPrefixes = len(result.SuperfluousEntries) > 0
+	a.AnalysisResult.AsbrSummaryAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
+	a.AnalysisResult.AsbrSummaryAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
+	a.AnalysisResult.AsbrSummaryAnomaly.MissingEntries = result.MissingEntries
+	a.AnalysisResult.AsbrSummaryAnomaly.SuperfluousEntries = result.SuperfluousEntries
+	a.AnalysisResult.AsbrSummaryAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
+}
+
+// isSummaryForbidden reports whether a summary-LSA in the given area contradicts the
+// configuration: no-summary areas only receive the default route, not-advertise
+// ranges suppress their prefixes and components of a range must not leak.
+func isSummaryForbidden(adv *frrProto.Advertisement, areaName, areaType string, areaRanges []*frrProto.AreaRange) bool {
+	if strings.HasSuffix(areaType, "no-summary") {
+		return adv.LinkStateId != "0.0.0.0" || adv.PrefixLength != "0"
+	}
+
+	for _, areaRange := range areaRanges {
+		if areaRange.Area == areaName || areaRange.IpPrefix == nil {
+			continue
+		}
+		if !isPrefixCoveredBy(adv.LinkStateId, adv.PrefixLength, areaRange.IpPrefix) {
+			continue
+		}
+		if areaRange.NotAdvertise || adv.PrefixLength != strconv.Itoa(int(areaRange.IpPrefix.PrefixLength)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getAreaLsdbStateMap keys the links of every area by prefix, keeping the area
+// separation that getLsdbStateMap flattens
+func getAreaLsdbStateMap(lsdbState *frrProto.InterAreaLsa) map[string]map[string]*frrProto.Advertisement {
+	result := make(map[string]map[string]*frrProto.Advertisement)
+
+	for _, area := range lsdbState.Areas {
+		if result[area.AreaName] == nil {
+			result[area.AreaName] = make(map[string]*frrProto.Advertisement)
+		}
+		for _, link := range area.Links {
+			adv := proto.Clone(link).(*frrProto.Advertisement)
+			adv.OspfArea = area.AreaName
+			result[area.AreaName][getKeyWithFallback(link.LinkStateId, link.InterfaceAddress, link.PrefixLength)] = adv
+		}
+	}
+
+	return result
+}
+
+// EcmpAnalysis compares the equal-cost paths derived from the LSDB with the next hops
+// of the selected OSPF routes in the RIB. A prefix with several equal-cost paths is
+// expected to install as many next hops as maximum-paths allows, and every installed
+// next hop must be active and in the FIB.
+func (a *Analyzer) EcmpAnalysis() {
+	a.Logger.Debug("Starting ECMP analysis")
+	start := time.Now()
+
+	result := &frrProto.AnomalyDetection{
+		MissingEntries:       []*frrProto.Advertisement{},
+		MisconfiguredEntries: []*frrProto.Advertisement{},
+	}
+
+	if a.metrics.RoutingInformationBase == nil {
+		a.Logger.Warning("Skipping ECMP analysis - missing RIB")
+		return
+	}
+
+	maximumPaths := a.metrics.GeneralOspfInformation.GetMaximumPaths()
+	equalCostPaths := a.GetLsdbEqualCostPaths()
+
+	prefixes := make([]string, 0, len(a.metrics.RoutingInformationBase.Routes))
+	for prefix := range a.metrics.RoutingInformationBase.Routes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	for _, prefix := range prefixes {
+		var route *frrProto.Route
+		for _, r := range a.metrics.RoutingInformationBase.Routes[prefix].Routes {
+			if r.Protocol == "ospf" && r.Selected {
+				route = r
+				break
+			}
+		}
+		if route == nil {
+			continue
+		}
+
+		parts := strings.Split(prefix, "/")
+		if len(parts) != 2 {
+			continue
+		}
+
+		installed := 0
+		for _, nextHop := range route.Nexthops {
+			if nextHop.Duplicate || (nextHop.Ip == "" && nextHop.InterfaceName == "") {
+				continue
+			}
+
+			problem := ""
+			switch {
+			case !nextHop.Active:
+				problem = "is inactive"
+			case !nextHop.Fib:
+				problem = "is not installed in the FIB"
+			default:
+				installed++
+				continue
+			}
+
+			if nextHop.DirectlyConnected {
+				continue
+			}
+
+			result.MisconfiguredEntries = append(result.MisconfiguredEntries, &frrProto.Advertisement{
+				InterfaceAddress: nextHop.Ip,
+				LinkStateId:      parts[0],
+				PrefixLength:     parts[1],
+				LinkType:         "ecmp",
+				Metric:           route.Metric,
+				Reason:           fmt.Sprintf("next-hop %s via %s %s", nextHop.Ip, nextHop.InterfaceName, problem),
+			})
+		}
+
+		path, exists := equalCostPaths[prefix]
+		// a different cost means the RIB route isn't the intra-area route computed from the LSDB
+		if !exists || path.Cost != route.Metric {
+			continue
+		}
+
+		expected := len(path.NextHops)
+		if maximumPaths > 0 && expected > int(maximumPaths) {
+			expected = int(maximumPaths)
+		}
+		if expected > 1 && installed < expected {
+			result.MissingEntries = append(result.MissingEntries, &frrProto.Advertisement{
+				LinkStateId:  parts[0],
+				PrefixLength: parts[1],
+				LinkType:     "ecmp",
+				OspfArea:     path.Area,
+				Metric:       path.Cost,
+				Reason: fmt.Sprintf("%d equal-cost paths with cost %d (maximum-paths %d), but %d next-hop(s) installed",
+					len(path.NextHops), path.Cost, maximumPaths, installed),
+			})
+		}
+	}
+
+	if len(result.MissingEntries) > 0 {
+		missingPrefixes := make([]string, 0, 3)
+		for i, entry := range result.MissingEntries {
+			if i >= 3 {
+				break
+			}
+			missingPrefixes = append(missingPrefixes,
+				fmt.Sprintf("%s/%s", entry.LinkStateId, entry.PrefixLength))
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":             "ecmp",
+			"count":            len(result.MissingEntries),
+			"missing_prefixes": missingPrefixes,
+			"analysis":         "Equal-cost paths in the LSDB not installed as next-hops",
+		}).Warning("Missing ECMP next-hops detected")
+	}
+
+	if len(result.MisconfiguredEntries) > 0 {
+		misconfiguredDetails := make([]map[string]any, 0, 3)
+		for i, entry := range result.MisconfiguredEntries {
+			if i >= 3 {
+				break
+			}
+			misconfiguredDetails = append(misconfiguredDetails, map[string]any{
+				"prefix": fmt.Sprintf("%s/%s", entry.LinkStateId, entry.PrefixLength),
+				"reason": entry.Reason,
+			})
+		}
+
+		a.AnomalyLogger.WithAttrs(map[string]any{
+			"type":                 "ecmp",
+			"count":                len(result.MisconfiguredEntries),
+			"misconfigured_routes": misconfiguredDetails,
+			"analysis":             "OSPF next-hops inactive or not installed in the FIB",
+		}).Warning("Inactive OSPF next-hops detected")
+	}
+
+	a.Logger.WithAttrs(map[string]any{
+		"duration":         time.Since(start).String(),
+		"equal_cost_paths": len(equalCostPaths),
+		"maximum_paths":    maximumPaths,
+		"missing":          len(result.MissingEntries),
+		"misconfigured":    len(result.MisconfiguredEntries),
+	}).Info("Completed ECMP analysis")
+
+	a.AnalysisResult.EcmpAnomaly.HasUnAdvertisedPrefixes = len(result.MissingEntries) > 0
+	a.AnalysisResult.EcmpAnomaly.HasMisconfiguredPrefixes = len(result.MisconfiguredEntries) > 0
+	a.AnalysisResult.EcmpAnomaly.MissingEntries = result.MissingEntries
+	a.AnalysisResult.EcmpAnomaly.MisconfiguredEntries = result.MisconfiguredEntries
+}
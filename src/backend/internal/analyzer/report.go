@@ -0,0 +1,211 @@
+package analyzer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	ReportFormatText  = "text"
+	ReportFormatJSON  = "json"
+	ReportFormatJUnit = "junit"
+)
+
+type reportEntry struct {
+	source      string
+	anomalyType string
+	entry       *frrProto.Advertisement
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteReport writes the result of the last analysis cycle as text, json or junit.
+func (a *Analyzer) WriteReport(w io.Writer, format string) error {
+	switch format {
+	case ReportFormatText:
+		return a.writeTextReport(w)
+	case ReportFormatJSON:
+		data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(a.AnalysisResult)
+		if err != nil {
+			return fmt.Errorf("failed to marshal analysis result: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case ReportFormatJUnit:
+		return a.writeJUnitReport(w)
+	default:
+		return fmt.Errorf("unknown report format %q, expected text, json or junit", format)
+	}
+}
+
+// HasActionableAnomalies reports whether any anomaly is neither suppressed nor acknowledged.
+func (a *Analyzer) HasActionableAnomalies() bool {
+	for _, entry := range a.reportEntries() {
+		if !entry.entry.Suppressed && !entry.entry.Acknowledged {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Analyzer) writeTextReport(w io.Writer) error {
+	hostname := a.metrics.GetStaticFrrConfiguration().GetHostname()
+	entries := a.reportEntries()
+
+	actionable := 0
+	bySource := map[string][]reportEntry{}
+	for _, entry := range entries {
+		bySource[entry.source] = append(bySource[entry.source], entry)
+		if !entry.entry.Suppressed && !entry.entry.Acknowledged {
+			actionable++
+		}
+	}
+
+	fmt.Fprintf(w, "FRR-MAD analysis report for %s\n\n", hostname)
+	for _, source := range a.anomalySources() {
+		sourceEntries := bySource[source.name]
+		if len(sourceEntries) == 0 {
+			fmt.Fprintf(w, "%-20s ok\n", source.name)
+			continue
+		}
+
+		fmt.Fprintf(w, "%-20s %d anomalies\n", source.name, len(sourceEntries))
+		for _, entry := range sourceEntries {
+			line := fmt.Sprintf("  [%s] %s %s", entry.entry.Severity, entry.anomalyType, advertisementPrefix(entry.entry))
+			if entry.entry.OspfArea != "" {
+				line += " area " + entry.entry.OspfArea
+			}
+			if entry.entry.Reason != "" {
+				line += ": " + entry.entry.Reason
+			}
+			if status := reportStatus(entry.entry); status != "" {
+				line += " (" + status + ")"
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d anomalies, %d actionable\n", len(entries), actionable)
+	return err
+}
+
+func (a *Analyzer) writeJUnitReport(w io.Writer) error {
+	hostname := a.metrics.GetStaticFrrConfiguration().GetHostname()
+	bySource := map[string][]reportEntry{}
+	for _, entry := range a.reportEntries() {
+		bySource[entry.source] = append(bySource[entry.source], entry)
+	}
+
+	suites := junitTestSuites{Name: "frr-mad " + hostname}
+	for _, source := range a.anomalySources() {
+		suite := junitTestSuite{Name: source.name}
+
+		if len(bySource[source.name]) == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{Name: "no anomalies", ClassName: source.name})
+		}
+		for _, entry := range bySource[source.name] {
+			testCase := junitTestCase{
+				Name:      AnomalyID(entry.source, entry.anomalyType, entry.entry),
+				ClassName: source.name,
+			}
+			if status := reportStatus(entry.entry); status != "" {
+				testCase.Skipped = &junitSkipped{Message: status}
+				suite.Skipped++
+			} else {
+				testCase.Failure = &junitFailure{
+					Message: entry.anomalyType + " " + advertisementPrefix(entry.entry),
+					Type:    entry.entry.Severity,
+					Text:    entry.entry.Reason,
+				}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return fmt.Errorf("failed to encode junit report: %w", err)
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// reportEntries returns all published anomalies ordered by source and anomaly id.
+func (a *Analyzer) reportEntries() []reportEntry {
+	var result []reportEntry
+	for _, source := range a.anomalySources() {
+		if source.detection == nil {
+			continue
+		}
+
+		var sourceEntries []reportEntry
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			for _, entry := range entries {
+				sourceEntries = append(sourceEntries, reportEntry{source: source.name, anomalyType: anomalyType, entry: entry})
+			}
+		}
+		sort.SliceStable(sourceEntries, func(i, j int) bool {
+			return AnomalyID(sourceEntries[i].source, sourceEntries[i].anomalyType, sourceEntries[i].entry) <
+				AnomalyID(sourceEntries[j].source, sourceEntries[j].anomalyType, sourceEntries[j].entry)
+		})
+		result = append(result, sourceEntries...)
+	}
+	return result
+}
+
+func reportStatus(entry *frrProto.Advertisement) string {
+	switch {
+	case entry.Suppressed:
+		return "suppressed"
+	case entry.Acknowledged:
+		return "acknowledged"
+	default:
+		return ""
+	}
+}
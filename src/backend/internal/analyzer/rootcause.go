@@ -0,0 +1,195 @@
+package analyzer
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+// Reason codes identify the root cause of an anomaly, the explanation describes it for humans.
+const (
+	ReasonInterfaceDown      = "interface_down"
+	ReasonPassiveInterface   = "passive_interface"
+	ReasonNoNeighbor         = "no_neighbor"
+	ReasonDeniedByAccessList = "denied_by_access_list"
+	ReasonShadowedRoute      = "shadowed_route"
+	ReasonRouteNotSelected   = "route_not_selected"
+	ReasonNoRoute            = "no_route"
+	ReasonIntentViolated     = "intent_violated"
+	ReasonNotAdvertised      = "not_advertised"
+	ReasonNotExpected        = "not_expected"
+	ReasonDuplicateLsa       = "duplicate_lsa"
+	ReasonAttributeMismatch  = "attribute_mismatch"
+)
+
+// adjacencyLinkTypes need a full adjacency on the interface to be advertised
+var adjacencyLinkTypes = []string{"transit network", "point-to-point", "virtual link"}
+
+// ExplainAnomalies attaches a reason code and an explanation to all anomalies and explained
+// deviations of the cycle. Codes set by a check itself are kept.
+func (a *Analyzer) ExplainAnomalies() {
+	for _, source := range a.anomalySources() {
+		if source.detection == nil {
+			continue
+		}
+
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			for _, entry := range entries {
+				a.explainEntry(source.name, anomalyType, entry)
+			}
+		}
+		for _, entry := range source.detection.GetExplainedEntries() {
+			a.explainEntry(source.name, "", entry)
+		}
+	}
+}
+
+func (a *Analyzer) explainEntry(source, anomalyType string, entry *frrProto.Advertisement) {
+	if entry.ReasonCode != "" {
+		if entry.Explanation == "" {
+			entry.Explanation = entry.Reason
+		}
+		return
+	}
+	entry.ReasonCode, entry.Explanation = a.explainAnomaly(source, anomalyType, entry)
+}
+
+func (a *Analyzer) explainAnomaly(source, anomalyType string, entry *frrProto.Advertisement) (string, string) {
+	if source == "IntentAnomaly" {
+		return ReasonIntentViolated, entry.Reason
+	}
+
+	switch entry.RouteSelection {
+	case RouteSelectionShadowed:
+		return ReasonShadowedRoute, entry.Reason
+	case RouteSelectionNotSelected:
+		return ReasonRouteNotSelected, entry.Reason
+	}
+
+	switch anomalyType {
+	case AnomalyTypeUnAdvertised:
+		if code, explanation := a.explainInterface(entry); code != "" {
+			return code, explanation
+		}
+		if entry.RouteSelection == RouteSelectionMissing {
+			return ReasonNoRoute, entry.Reason
+		}
+		return ReasonNotAdvertised, withDefault(entry.Reason, "expected by the configuration, but not advertised")
+	case AnomalyTypeOverAdvertised:
+		if code, explanation := a.explainAccessList(entry); code != "" {
+			return code, explanation
+		}
+		return ReasonNotExpected, withDefault(entry.Reason, "advertised, but not expected by the configuration")
+	case AnomalyTypeDuplicate:
+		return ReasonDuplicateLsa, withDefault(entry.Reason, "advertised by more than one LSA")
+	default:
+		return ReasonAttributeMismatch, withDefault(entry.Reason, "advertised with unexpected attributes")
+	}
+}
+
+// explainInterface finds missing advertisements caused by the state of the interface they belong to.
+func (a *Analyzer) explainInterface(entry *frrProto.Advertisement) (string, string) {
+	iface, interfacePrefix := findConfiguredInterface(a.metrics.GetStaticFrrConfiguration(), entry)
+	if iface == nil {
+		return "", ""
+	}
+
+	if state, exists := a.metrics.GetInterfaces().GetInterfaces()[iface.Name]; exists {
+		if state.AdministrativeStatus != "" && !strings.EqualFold(state.AdministrativeStatus, "up") {
+			return ReasonInterfaceDown, fmt.Sprintf("interface %s is administratively down", iface.Name)
+		}
+		if state.OperationalStatus != "" && !strings.EqualFold(state.OperationalStatus, "up") {
+			return ReasonInterfaceDown, fmt.Sprintf("interface %s is operationally down", iface.Name)
+		}
+	}
+
+	if !slices.Contains(adjacencyLinkTypes, entry.LinkType) {
+		return "", ""
+	}
+	if interfacePrefix.Passive {
+		return ReasonPassiveInterface, fmt.Sprintf("interface %s is passive, no adjacency is formed", iface.Name)
+	}
+	if !a.hasNeighborOn(iface.Name) {
+		return ReasonNoNeighbor, fmt.Sprintf("no OSPF neighbor on interface %s", iface.Name)
+	}
+
+	return "", ""
+}
+
+func (a *Analyzer) hasNeighborOn(ifaceName string) bool {
+	for _, neighbors := range a.metrics.GetOspfNeighbors().GetNeighbors() {
+		for _, neighbor := range neighbors.GetNeighbors() {
+			if strings.Split(neighbor.IfaceName, ":")[0] == ifaceName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findConfiguredInterface returns the interface whose subnet or peer contains the address of the advertisement.
+func findConfiguredInterface(config *frrProto.StaticFRRConfiguration, entry *frrProto.Advertisement) (*frrProto.Interface, *frrProto.InterfaceIPPrefix) {
+	address := entry.GetInterfaceAddress()
+	if address == "" {
+		address = entry.GetLinkStateId()
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, nil
+	}
+
+	for _, iface := range config.GetInterfaces() {
+		for _, interfacePrefix := range iface.InterfaceIpPrefixes {
+			if interfacePrefix.PeerIpPrefix.GetIpAddress() == address {
+				return iface, interfacePrefix
+			}
+			if interfacePrefix.IpPrefix == nil {
+				continue
+			}
+			_, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d", interfacePrefix.IpPrefix.IpAddress, interfacePrefix.IpPrefix.PrefixLength))
+			if err == nil && network.Contains(ip) {
+				return iface, interfacePrefix
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// explainAccessList finds advertised prefixes which are denied by an access-list of a redistribution route-map.
+func (a *Analyzer) explainAccessList(entry *frrProto.Advertisement) (string, string) {
+	config := a.metrics.GetStaticFrrConfiguration()
+	accessLists := GetAccessList(config)
+	address := entry.GetLinkStateId()
+	if address == "" {
+		address = entry.GetInterfaceAddress()
+	}
+
+	for _, redistribution := range config.GetOspfConfig().GetRedistribution() {
+		routeMap, exists := config.GetRouteMap()[redistribution.RouteMap]
+		if !exists {
+			continue
+		}
+		accessList, exists := accessLists[routeMap.AccessList]
+		if !exists {
+			continue
+		}
+
+		if permitted, aclEntry := isPermittedByAccessList(accessList, address, entry.GetPrefixLength()); !permitted && aclEntry != nil {
+			return ReasonDeniedByAccessList, fmt.Sprintf("prefix denied by access-list %s seq %d (route-map %s of redistribute %s)",
+				accessList.AccessList, aclEntry.Sequence, redistribution.RouteMap, redistribution.Type)
+		}
+	}
+
+	return "", ""
+}
+
+func withDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,254 @@
+package analyzer
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+const DefaultAcknowledgementDuration = 24 * time.Hour
+
+// AnomalySeverity rates an anomaly by its type. Prefixes missing from the LSDB, RIB or FIB
+// break reachability and are critical, shadowed routes are expected behaviour and only informational.
+func AnomalySeverity(source, anomalyType string, entry *frrProto.Advertisement) string {
+	if entry.GetRouteSelection() == RouteSelectionShadowed {
+		return SeverityInfo
+	}
+
+	switch anomalyType {
+	case AnomalyTypeUnAdvertised:
+		if source == "Ecmp" {
+			return SeverityWarning
+		}
+		return SeverityCritical
+	case AnomalyTypeDuplicate:
+		return SeverityCritical
+	default:
+		return SeverityWarning
+	}
+}
+
+// ClassifyAnomalies sets severity, suppression and acknowledgement on all published
+// anomalies and their lifecycle entries, and the severity of explained deviations.
+// Expired acknowledgements are removed.
+func (a *Analyzer) ClassifyAnomalies(now time.Time) {
+	if a.AnalysisResult.Acknowledgements == nil {
+		a.AnalysisResult.Acknowledgements = map[string]*frrProto.Acknowledgement{}
+	}
+	for id, ack := range a.AnalysisResult.Acknowledgements {
+		if ack.ExpiresAt <= now.Unix() {
+			a.AnomalyLogger.WithAttrs(map[string]any{
+				"id": id,
+			}).Info("Acknowledgement expired")
+			delete(a.AnalysisResult.Acknowledgements, id)
+		}
+	}
+
+	interfacePrefixes := getInterfacePrefixes(a.metrics.StaticFrrConfiguration)
+	lifecycle := map[string]*frrProto.AnomalyLifecycle{}
+	for _, state := range a.AnalysisResult.Lifecycle {
+		_, acknowledged := a.AnalysisResult.Acknowledgements[state.Id]
+		state.Suppressed = false
+		state.Acknowledged = acknowledged
+		if state.Severity == "" {
+			state.Severity = AnomalySeverity(state.Source, state.AnomalyType, nil)
+		}
+		lifecycle[state.Id] = state
+	}
+
+	for _, source := range a.anomalySources() {
+		if source.detection == nil {
+			continue
+		}
+
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			for _, entry := range entries {
+				id := AnomalyID(source.name, anomalyType, entry)
+
+				entry.Severity = AnomalySeverity(source.name, anomalyType, entry)
+				entry.Suppressed = false
+				entry.Acknowledged = false
+				entry.SuppressionReason = ""
+
+				if rule := matchSuppressionRule(a.Suppressions, source.name, entry, interfacePrefixes); rule != nil {
+					entry.Suppressed = true
+					entry.SuppressionReason = suppressionRuleDescription(rule)
+				}
+
+				if ack, exists := a.AnalysisResult.Acknowledgements[id]; exists {
+					entry.Acknowledged = true
+					if entry.SuppressionReason == "" {
+						entry.SuppressionReason = ack.Comment
+					}
+				}
+
+				if state, exists := lifecycle[id]; exists {
+					state.Severity = entry.Severity
+					state.Suppressed = state.Suppressed || entry.Suppressed
+				}
+			}
+		}
+
+		// explained deviations are no anomalies, they are neither suppressed nor acknowledged
+		for _, entry := range source.detection.GetExplainedEntries() {
+			entry.Severity = AnomalySeverity(source.name, "", entry)
+		}
+	}
+}
+
+// AcknowledgeAnomaly silences the anomaly with the given id until duration has passed.
+// The anomaly must be known to the lifecycle store. The acknowledgement and the published
+// anomalies are written under the data lock, as the analysis cycle and the readers of the result use them.
+func AcknowledgeAnomaly(result *frrProto.AnomalyAnalysis, id string, duration time.Duration, comment string, now time.Time) (*frrProto.Acknowledgement, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid acknowledgement duration %s", duration)
+	}
+
+	datalock.Lock()
+	defer datalock.Unlock()
+
+	var state *frrProto.AnomalyLifecycle
+	for _, entry := range result.GetLifecycle() {
+		if entry.Id == id {
+			state = entry
+			break
+		}
+	}
+	if state == nil {
+		return nil, fmt.Errorf("unknown anomaly id %q", id)
+	}
+
+	ack := &frrProto.Acknowledgement{
+		Id:             id,
+		AcknowledgedAt: now.Unix(),
+		ExpiresAt:      now.Add(duration).Unix(),
+		Comment:        comment,
+	}
+	if result.Acknowledgements == nil {
+		result.Acknowledgements = map[string]*frrProto.Acknowledgement{}
+	}
+	result.Acknowledgements[id] = ack
+	state.Acknowledged = true
+
+	// mark the published anomalies as well, otherwise readers see them unacknowledged until the next cycle
+	for _, source := range resultAnomalySources(result) {
+		if source.detection == nil {
+			continue
+		}
+		for anomalyType, entries := range detectionEntries(source.detection) {
+			for _, entry := range entries {
+				if AnomalyID(source.name, anomalyType, entry) != id {
+					continue
+				}
+				entry.Acknowledged = true
+				if entry.SuppressionReason == "" {
+					entry.SuppressionReason = comment
+				}
+			}
+		}
+	}
+
+	return ack, nil
+}
+
+// matchSuppressionRule returns the first rule whose non-empty fields all match the anomaly.
+// The source matches the exporter name, e.g. RouterAnomaly, or its short form without the Anomaly suffix.
+func matchSuppressionRule(rules []configs.SuppressionRule, source string, entry *frrProto.Advertisement, interfacePrefixes map[string][]*net.IPNet) *configs.SuppressionRule {
+	address := net.ParseIP(entry.GetInterfaceAddress())
+	if address == nil {
+		address = net.ParseIP(entry.GetLinkStateId())
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		if rule.Prefix == "" && rule.Area == "" && rule.Interface == "" && rule.Source == "" {
+			continue
+		}
+
+		if rule.Source != "" && !strings.EqualFold(rule.Source, source) &&
+			!strings.EqualFold(rule.Source+"Anomaly", source) {
+			continue
+		}
+
+		if rule.Area != "" && rule.Area != entry.GetOspfArea() {
+			continue
+		}
+
+		if rule.Prefix != "" {
+			_, network, err := net.ParseCIDR(rule.Prefix)
+			if err != nil || address == nil || !network.Contains(address) {
+				continue
+			}
+			ruleLength, _ := network.Mask.Size()
+			if prefixLength, err := strconv.Atoi(entry.GetPrefixLength()); err == nil && prefixLength < ruleLength {
+				continue
+			}
+		}
+
+		if rule.Interface != "" {
+			matched := false
+			for _, network := range interfacePrefixes[rule.Interface] {
+				if address != nil && network.Contains(address) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		return rule
+	}
+
+	return nil
+}
+
+func suppressionRuleDescription(rule *configs.SuppressionRule) string {
+	if rule.Comment != "" {
+		return rule.Comment
+	}
+
+	var parts []string
+	for _, field := range []struct{ name, value string }{
+		{"prefix", rule.Prefix},
+		{"area", rule.Area},
+		{"interface", rule.Interface},
+		{"source", rule.Source},
+	} {
+		if field.value != "" {
+			parts = append(parts, field.name+" "+field.value)
+		}
+	}
+
+	return "suppressed by rule: " + strings.Join(parts, ", ")
+}
+
+func getInterfacePrefixes(config *frrProto.StaticFRRConfiguration) map[string][]*net.IPNet {
+	result := map[string][]*net.IPNet{}
+
+	for _, iface := range config.GetInterfaces() {
+		for _, ipPrefix := range iface.GetInterfaceIpPrefixes() {
+			prefix := ipPrefix.GetIpPrefix()
+			_, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d", prefix.GetIpAddress(), prefix.GetPrefixLength()))
+			if err != nil {
+				continue
+			}
+			result[iface.Name] = append(result[iface.Name], network)
+		}
+	}
+
+	return result
+}
@@ -2,6 +2,9 @@ package analyzer
 
 import (
 	"fmt"
+	"net"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -64,15 +67,15 @@ func (a *Analyzer) GetStaticFileRouterData(config *frrProto.StaticFRRConfigurati
 		targetArea := iface.Area
 
 		for _, interfaceIpPrefix := range iface.InterfaceIpPrefixes {
-			if interfaceIpPrefix.IpPrefix == nil || !interfaceIpPrefix.Ospf{
+			if interfaceIpPrefix.IpPrefix == nil || !interfaceIpPrefix.Ospf {
 				continue
 			}
 
 			adv := frrProto.Advertisement{
 				InterfaceAddress: interfaceIpPrefix.IpPrefix.IpAddress,
 				PrefixLength:     strconv.Itoa(int(interfaceIpPrefix.IpPrefix.PrefixLength)),
-				Ospf: interfaceIpPrefix.Ospf,
-				OspfArea: interfaceIpPrefix.OspfArea,	
+				Ospf:             interfaceIpPrefix.Ospf,
+				OspfArea:         interfaceIpPrefix.OspfArea,
 			}
 
 			if interfaceIpPrefix.Passive {
@@ -195,6 +198,11 @@ func (a *Analyzer) GetStaticFileExternalData(config *frrProto.StaticFRRConfigura
 		ipAddr := staticRoute.IpPrefix.IpAddress
 		prefixLen := staticRoute.IpPrefix.PrefixLength
 
+		// redistribute never covers the default route, see DefaultRouteAnalysis
+		if isDefaultPrefix(ipAddr, strconv.Itoa(int(prefixLen))) {
+			continue
+		}
+
 		if _, exists := staticRouteMap[ipAddr]; exists {
 			isAllowed := false
 
@@ -280,6 +288,11 @@ func (a *Analyzer) GetStaticFileNssaExternalData(config *frrProto.StaticFRRConfi
 		ipAddr := staticRoute.IpPrefix.IpAddress
 		prefixLen := staticRoute.IpPrefix.PrefixLength
 
+		// redistribute never covers the default route, see DefaultRouteAnalysis
+		if isDefaultPrefix(ipAddr, strconv.Itoa(int(prefixLen))) {
+			continue
+		}
+
 		if _, exists := staticRouteMap[ipAddr]; exists {
 			isAllowed := false
 
@@ -321,6 +334,366 @@ func (a *Analyzer) GetStaticFileNssaExternalData(config *frrProto.StaticFRRConfi
 	return result
 }
 
+// GetStaticFileSummaryData makes LSA type 3 prediction parsing. Only an ABR
+// originates summary-LSAs, so the result stays empty for internal routers.
+func (a *Analyzer) GetStaticFileSummaryData(config *frrProto.StaticFRRConfiguration) *frrProto.InterAreaLsa {
+	if config == nil || config.OspfConfig == nil {
+		a.Logger.Debug("Skipping summary data parsing - nil config or OSPF config")
+		return nil
+	}
+
+	a.Logger.Debug("Parsing static summary configuration")
+	start := time.Now()
+
+	result := &frrProto.InterAreaLsa{
+		Hostname: config.Hostname,
+		RouterId: config.OspfConfig.GetRouterId(),
+		Areas:    []*frrProto.AreaAnalyzer{},
+	}
+
+	attachedAreas := getAttachedAreas(config)
+	backboneArea := getOspfArea(a.metrics.GeneralOspfInformation)
+	if !isAreaBorderRouter(attachedAreas, backboneArea) {
+		a.Logger.Debug("Router is not an ABR, no summary LSAs expected")
+		return result
+	}
+	result.RouterType = "abr"
+
+	areaTypes := getAreaTypes(config, attachedAreas)
+	componentMap := a.getIntraAreaPrefixes(config, attachedAreas)
+
+	for _, targetArea := range attachedAreas {
+		area := &frrProto.AreaAnalyzer{
+			AreaName: targetArea,
+			LsaType:  "summary-LSA",
+			AreaType: areaTypes[targetArea],
+			Links:    []*frrProto.Advertisement{},
+		}
+		result.Areas = append(result.Areas, area)
+
+		if isStubAreaType(area.AreaType) {
+			area.Links = append(area.Links, &frrProto.Advertisement{
+				LinkStateId:  "0.0.0.0",
+				PrefixLength: "0",
+				LinkType:     "inter-area prefix",
+				OspfArea:     targetArea,
+			})
+		}
+		if strings.HasSuffix(area.AreaType, "no-summary") {
+			continue
+		}
+
+		for _, sourceArea := range attachedAreas {
+			if sourceArea == targetArea {
+				continue
+			}
+			for _, link := range summarizeAreaPrefixes(sourceArea, componentMap[sourceArea], config.OspfConfig.AreaRange) {
+				link.OspfArea = targetArea
+				area.Links = append(area.Links, link)
+			}
+		}
+	}
+
+	a.Logger.WithAttrs(map[string]any{
+		"duration":       time.Since(start).String(),
+		"attached_areas": len(attachedAreas),
+		"summary_lsas":   countTotalLinksNSSA(result),
+	}).Debug("Completed static summary configuration parsing")
+
+	return result
+}
+
+// GetStaticFileAsbrSummaryData makes LSA type 4 prediction parsing. An ABR
+// announces every ASBR it learns in one area into all other non-stub areas.
+func (a *Analyzer) GetStaticFileAsbrSummaryData(config *frrProto.StaticFRRConfiguration) *frrProto.InterAreaLsa {
+	if config == nil || config.OspfConfig == nil {
+		a.Logger.Debug("Skipping ASBR summary data parsing - nil config or OSPF config")
+		return nil
+	}
+
+	a.Logger.Debug("Parsing static ASBR summary configuration")
+	start := time.Now()
+
+	result := &frrProto.InterAreaLsa{
+		Hostname: config.Hostname,
+		RouterId: config.OspfConfig.GetRouterId(),
+		Areas:    []*frrProto.AreaAnalyzer{},
+	}
+
+	attachedAreas := getAttachedAreas(config)
+	backboneArea := getOspfArea(a.metrics.GeneralOspfInformation)
+	if !isAreaBorderRouter(attachedAreas, backboneArea) {
+		a.Logger.Debug("Router is not an ABR, no ASBR summary LSAs expected")
+		return result
+	}
+	result.RouterType = "abr"
+
+	areaTypes := getAreaTypes(config, attachedAreas)
+
+	// asbrAreas maps each foreign ASBR router id to the areas it is reachable in
+	asbrAreas := make(map[string]map[string]bool)
+	if a.metrics.OspfRouterDataAll != nil {
+		for areaName, routerArea := range a.metrics.OspfRouterDataAll.RouterStates {
+			for _, lsa := range routerArea.LsaEntries {
+				if !lsa.Asbr || lsa.AdvertisingRouter == result.RouterId {
+					continue
+				}
+				if asbrAreas[lsa.AdvertisingRouter] == nil {
+					asbrAreas[lsa.AdvertisingRouter] = make(map[string]bool)
+				}
+				asbrAreas[lsa.AdvertisingRouter][areaName] = true
+			}
+		}
+	}
+
+	asbrCosts := a.getAsbrCosts(result.RouterId)
+
+	asbrList := make([]string, 0, len(asbrAreas))
+	for asbr := range asbrAreas {
+		asbrList = append(asbrList, asbr)
+	}
+	sort.Strings(asbrList)
+
+	for _, targetArea := range attachedAreas {
+		area := &frrProto.AreaAnalyzer{
+			AreaName: targetArea,
+			LsaType:  "asbr-summary-LSA",
+			AreaType: areaTypes[targetArea],
+			Links:    []*frrProto.Advertisement{},
+		}
+		result.Areas = append(result.Areas, area)
+
+		if areaTypes[targetArea] != "normal" {
+			continue
+		}
+
+		for _, asbr := range asbrList {
+			// an ASBR inside the target area is reachable intra-area
+			if asbrAreas[asbr][targetArea] {
+				continue
+			}
+			area.Links = append(area.Links, &frrProto.Advertisement{
+				LinkStateId:  asbr,
+				PrefixLength: "32",
+				LinkType:     "asbr-summary",
+				OspfArea:     targetArea,
+				Metric:       asbrCosts[asbr],
+			})
+		}
+	}
+
+	a.Logger.WithAttrs(map[string]any{
+		"duration":          time.Since(start).String(),
+		"known_asbrs":       len(asbrList),
+		"asbr_summary_lsas": countTotalLinksNSSA(result),
+	}).Debug("Completed static ASBR summary configuration parsing")
+
+	return result
+}
+
+// getIntraAreaPrefixes collects the intra-area prefixes per attached area from the
+// configured interfaces and the router and network LSAs of that area.
+func (a *Analyzer) getIntraAreaPrefixes(config *frrProto.StaticFRRConfiguration, attachedAreas []string) map[string]map[string]*frrProto.Advertisement {
+	result := make(map[string]map[string]*frrProto.Advertisement)
+	for _, area := range attachedAreas {
+		result[area] = make(map[string]*frrProto.Advertisement)
+	}
+
+	addPrefix := func(area, address string, prefixLength int) {
+		if result[area] == nil || prefixLength < 0 || prefixLength > 32 {
+			return
+		}
+		network := getNetworkAddress(address, int32(prefixLength))
+		key := network + "/" + strconv.Itoa(prefixLength)
+		if _, exists := result[area][key]; exists {
+			return
+		}
+		result[area][key] = &frrProto.Advertisement{
+			LinkStateId:  network,
+			PrefixLength: strconv.Itoa(prefixLength),
+			LinkType:     "inter-area prefix",
+			OspfArea:     area,
+			Metric:       getOspfRouteMetric(a.metrics.RoutingInformationBase, key),
+		}
+	}
+
+	for _, iface := range config.Interfaces {
+		if iface.Area == "" {
+			continue
+		}
+		for _, interfaceIpPrefix := range iface.InterfaceIpPrefixes {
+			if interfaceIpPrefix.IpPrefix == nil || !interfaceIpPrefix.Ospf {
+				continue
+			}
+			addPrefix(iface.Area, interfaceIpPrefix.IpPrefix.IpAddress, int(interfaceIpPrefix.IpPrefix.PrefixLength))
+		}
+	}
+
+	if a.metrics.OspfRouterDataAll != nil {
+		for areaName, routerArea := range a.metrics.OspfRouterDataAll.RouterStates {
+			for _, lsa := range routerArea.LsaEntries {
+				for _, link := range lsa.RouterLinks {
+					if link.LinkType != "Stub Network" {
+						continue
+					}
+					prefixLength, _ := strconv.Atoi(maskToPrefixLength(link.NetworkMask))
+					addPrefix(areaName, link.NetworkAddress, prefixLength)
+				}
+			}
+		}
+	}
+
+	if a.metrics.OspfNetworkDataAll != nil {
+		for areaName, netArea := range a.metrics.OspfNetworkDataAll.NetStates {
+			for _, lsa := range netArea.LsaEntries {
+				addPrefix(areaName, lsa.LinkStateId, int(lsa.NetworkMask))
+			}
+		}
+	}
+
+	return result
+}
+
+// summarizeAreaPrefixes applies the "area X range" configuration of the source area
+// to its intra-area prefixes. Covered prefixes are replaced by their range, which
+// carries the configured cost or the highest component cost; not-advertise ranges
+// suppress their components entirely.
+func summarizeAreaPrefixes(sourceArea string, prefixes map[string]*frrProto.Advertisement, areaRanges []*frrProto.AreaRange) []*frrProto.Advertisement {
+	var result []*frrProto.Advertisement
+	rangeLinks := make(map[*frrProto.AreaRange]*frrProto.Advertisement)
+
+	keys := make([]string, 0, len(prefixes))
+	for key := range prefixes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		prefix := prefixes[key]
+		areaRange := getCoveringAreaRange(sourceArea, prefix, areaRanges)
+		if areaRange == nil {
+			result = append(result, proto.Clone(prefix).(*frrProto.Advertisement))
+			continue
+		}
+		if areaRange.NotAdvertise {
+			continue
+		}
+
+		rangeLink, exists := rangeLinks[areaRange]
+		if !exists {
+			rangeLink = &frrProto.Advertisement{
+				LinkStateId:  areaRange.IpPrefix.GetIpAddress(),
+				PrefixLength: strconv.Itoa(int(areaRange.IpPrefix.GetPrefixLength())),
+				LinkType:     "inter-area prefix",
+				OspfArea:     sourceArea,
+			}
+			if areaRange.HasCost {
+				rangeLink.Metric = int32(areaRange.Cost)
+			}
+			rangeLinks[areaRange] = rangeLink
+			result = append(result, rangeLink)
+		}
+		if !areaRange.HasCost && prefix.Metric > rangeLink.Metric {
+			rangeLink.Metric = prefix.Metric
+		}
+	}
+
+	return result
+}
+
+func getCoveringAreaRange(sourceArea string, prefix *frrProto.Advertisement, areaRanges []*frrProto.AreaRange) *frrProto.AreaRange {
+	for _, areaRange := range areaRanges {
+		if areaRange.Area != sourceArea || areaRange.IpPrefix == nil {
+			continue
+		}
+		if isPrefixCoveredBy(prefix.LinkStateId, prefix.PrefixLength, areaRange.IpPrefix) {
+			return areaRange
+		}
+	}
+	return nil
+}
+
+func isPrefixCoveredBy(address, prefixLength string, covering *frrProto.IPPrefix) bool {
+	length, err := strconv.Atoi(prefixLength)
+	if err != nil || length < int(covering.PrefixLength) {
+		return false
+	}
+	return getNetworkAddress(address, int32(covering.PrefixLength)) ==
+		getNetworkAddress(covering.IpAddress, int32(covering.PrefixLength))
+}
+
+// getAttachedAreas returns the sorted areas with at least one OSPF enabled interface
+func getAttachedAreas(config *frrProto.StaticFRRConfiguration) []string {
+	areaSet := make(map[string]bool)
+	for _, iface := range config.Interfaces {
+		if iface.Area != "" {
+			areaSet[iface.Area] = true
+		}
+	}
+
+	result := make([]string, 0, len(areaSet))
+	for area := range areaSet {
+		result = append(result, area)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func isAreaBorderRouter(attachedAreas []string, backboneArea string) bool {
+	if len(attachedAreas) < 2 {
+		return false
+	}
+	for _, area := range attachedAreas {
+		if area == backboneArea {
+			return true
+		}
+	}
+	return false
+}
+
+// getAreaTypes maps each attached area to "normal", "stub", "nssa",
+// "stub no-summary" or "nssa no-summary"
+func getAreaTypes(config *frrProto.StaticFRRConfiguration, attachedAreas []string) map[string]string {
+	result := make(map[string]string)
+	for _, area := range attachedAreas {
+		result[area] = "normal"
+	}
+
+	for _, area := range config.OspfConfig.Area {
+		if area.Type != "stub" && area.Type != "nssa" {
+			continue
+		}
+		areaType := area.Type
+		if area.NoSummary {
+			areaType += " no-summary"
+		}
+		result[area.Name] = areaType
+	}
+
+	return result
+}
+
+func isStubAreaType(areaType string) bool {
+	return areaType == "stub" || strings.HasSuffix(areaType, "no-summary")
+}
+
+func getOspfRouteMetric(rib *frrProto.RoutingInformationBase, prefix string) int32 {
+	if rib == nil {
+		return 0
+	}
+	routes, exists := rib.Routes[prefix]
+	if !exists {
+		return 0
+	}
+	for _, route := range routes.Routes {
+		if route.Protocol == "ospf" {
+			return route.Metric
+		}
+	}
+	return 0
+}
+
 func zeroLastOctetString(ipAddress string) string {
 	parts := strings.Split(ipAddress, ".")
 
@@ -329,15 +702,505 @@ func zeroLastOctetString(ipAddress string) string {
 	return strings.Join(parts, ".")
 }
 
-
 func getOspfArea(config *frrProto.GeneralOspfInformation) string {
-	for key, area := range config.Areas {
+	for key, area := range config.GetAreas() {
 		if area.Backbone {
 			return key
 		}
 	}
 
 	// Return most likely default area...
-	return "0"
+	return "0.0.0.0"
 }
 
+// FRR defaults for redistributed routes without explicit metric configuration
+const (
+	defaultExternalMetric        int32 = 20
+	defaultExternalMetricType          = "E2"
+	defaultOriginateMetric       int32 = 10
+	defaultOriginateAlwaysMetric int32 = 1
+)
+
+type externalAttributes struct {
+	Source            string
+	MetricType        string
+	Metric            int32
+	Tag               uint32
+	ForwardingAddress string
+}
+
+// getExpectedExternalAttributes predicts metric-type, metric, tag and forwarding
+// address of an external LSA from the redistribute and route-map configuration.
+// Returns nil if the prefix is not redistributed by a configured source.
+func (a *Analyzer) getExpectedExternalAttributes(config *frrProto.StaticFRRConfiguration, accessList map[string]*frrProto.AccessListAnalyzer, address, prefixLength string) *externalAttributes {
+	if config == nil || config.OspfConfig == nil {
+		return nil
+	}
+
+	source, nextHop := a.getRedistributionSource(config, address, prefixLength)
+	if source == "" {
+		return nil
+	}
+
+	var redistribution *frrProto.Redistribution
+	for _, r := range config.OspfConfig.Redistribution {
+		if r.Type == source {
+			redistribution = r
+			break
+		}
+	}
+	if redistribution == nil {
+		return nil
+	}
+
+	expected := &externalAttributes{
+		Source:            source,
+		MetricType:        defaultExternalMetricType,
+		Metric:            defaultExternalMetric,
+		ForwardingAddress: "0.0.0.0",
+	}
+
+	if config.OspfConfig.HasDefaultMetric {
+		expected.Metric = config.OspfConfig.DefaultMetric
+	}
+	if redistribution.HasMetricValue {
+		expected.Metric = redistribution.MetricValue
+	}
+	if redistribution.Metric != "" {
+		expected.MetricType = normalizeMetricType(redistribution.Metric)
+	}
+
+	if redistribution.RouteMap != "" {
+		routeMap, exists := config.RouteMap[redistribution.RouteMap]
+		if !exists || !routeMap.Permit {
+			return nil
+		}
+		if routeMap.AccessList != "" {
+			if permitted, _ := isPermittedByAccessList(accessList[routeMap.AccessList], address, prefixLength); !permitted {
+				return nil
+			}
+		}
+		if routeMap.HasSetMetric {
+			expected.Metric = routeMap.SetMetric
+		}
+		if routeMap.SetMetricType != "" {
+			expected.MetricType = normalizeMetricType(routeMap.SetMetricType)
+		}
+		if routeMap.HasSetTag {
+			expected.Tag = routeMap.SetTag
+		}
+	}
+
+	if isOspfForwardingNextHop(config, nextHop) {
+		expected.ForwardingAddress = nextHop
+	}
+
+	return expected
+}
+
+// getRedistributionSource returns the protocol which installed the prefix and its next hop,
+// preferring the RIB and falling back to the configured static routes
+func (a *Analyzer) getRedistributionSource(config *frrProto.StaticFRRConfiguration, address, prefixLength string) (string, string) {
+	if a.metrics != nil && a.metrics.RoutingInformationBase != nil {
+		if routes, exists := a.metrics.RoutingInformationBase.Routes[address+"/"+prefixLength]; exists {
+			for _, route := range routes.Routes {
+				if route.Protocol == "ospf" || !route.Selected {
+					continue
+				}
+				nextHop := ""
+				for _, hop := range route.Nexthops {
+					if hop.Ip != "" {
+						nextHop = hop.Ip
+						break
+					}
+				}
+				return route.Protocol, nextHop
+			}
+		}
+	}
+
+	for _, staticRoute := range config.StaticRoutes {
+		if staticRoute.IpPrefix.GetIpAddress() == address &&
+			strconv.Itoa(int(staticRoute.IpPrefix.GetPrefixLength())) == prefixLength {
+			return "static", staticRoute.NextHop
+		}
+	}
+
+	return "", ""
+}
+
+// isOspfForwardingNextHop reports whether the next hop lies on a non-passive OSPF
+// enabled network, in which case it is advertised as forwarding address
+func isOspfForwardingNextHop(config *frrProto.StaticFRRConfiguration, nextHop string) bool {
+	nextHopIp := net.ParseIP(nextHop)
+	if nextHopIp == nil {
+		return false
+	}
+
+	for _, iface := range config.Interfaces {
+		for _, interfaceIpPrefix := range iface.InterfaceIpPrefixes {
+			if interfaceIpPrefix.IpPrefix == nil || !interfaceIpPrefix.Ospf || interfaceIpPrefix.Passive {
+				continue
+			}
+			_, network, err := net.ParseCIDR(fmt.Sprintf("%s/%d",
+				interfaceIpPrefix.IpPrefix.IpAddress, interfaceIpPrefix.IpPrefix.PrefixLength))
+			if err == nil && network.Contains(nextHopIp) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isPermittedByAccessList evaluates the access list entries in sequence order, an unmatched prefix is denied.
+// It returns the matching entry, nil if no entry matched.
+func isPermittedByAccessList(accessList *frrProto.AccessListAnalyzer, address, prefixLength string) (bool, *frrProto.ACLEntry) {
+	if accessList == nil {
+		return false, nil
+	}
+
+	entries := slices.Clone(accessList.AclEntry)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Sequence < entries[j].Sequence
+	})
+
+	for _, entry := range entries {
+		if entry.Any {
+			return entry.IsPermit, entry
+		}
+		if entry.IPAddress == address && strconv.Itoa(int(entry.PrefixLength)) == prefixLength {
+			return entry.IsPermit, entry
+		}
+	}
+
+	return false, nil
+}
+
+// normalizeMetricType maps "1", "2", "type-1" and "type-2" to the E1/E2 notation used in the LSDB
+func normalizeMetricType(metricType string) string {
+	switch strings.TrimPrefix(metricType, "type-") {
+	case "1":
+		return "E1"
+	case "2":
+		return "E2"
+	}
+	return strings.ToUpper(metricType)
+}
+
+// getExpectedDefaultRoute predicts the Type-5 default route of "default-information originate".
+// Without "always" the default is only originated if the RIB holds a default route of another protocol.
+// Returns nil if no default route is expected.
+func (a *Analyzer) getExpectedDefaultRoute(config *frrProto.StaticFRRConfiguration) *externalAttributes {
+	defaultInformation := config.GetOspfConfig().GetDefaultInformation()
+	if !defaultInformation.GetOriginate() {
+		return nil
+	}
+	if !defaultInformation.Always && !a.hasRibDefaultRoute() {
+		return nil
+	}
+
+	expected := &externalAttributes{
+		Source:            "default-information originate",
+		MetricType:        defaultExternalMetricType,
+		Metric:            defaultOriginateMetric,
+		ForwardingAddress: "0.0.0.0",
+	}
+
+	if defaultInformation.Always {
+		expected.Source += " always"
+		expected.Metric = defaultOriginateAlwaysMetric
+	}
+	if defaultInformation.HasMetric {
+		expected.Metric = defaultInformation.Metric
+	}
+	if defaultInformation.MetricType != "" {
+		expected.MetricType = normalizeMetricType(defaultInformation.MetricType)
+	}
+
+	if defaultInformation.RouteMap != "" {
+		routeMap, exists := config.RouteMap[defaultInformation.RouteMap]
+		if !exists || !routeMap.Permit {
+			return nil
+		}
+		if routeMap.HasSetMetric {
+			expected.Metric = routeMap.SetMetric
+		}
+		if routeMap.SetMetricType != "" {
+			expected.MetricType = normalizeMetricType(routeMap.SetMetricType)
+		}
+		if routeMap.HasSetTag {
+			expected.Tag = routeMap.SetTag
+		}
+	}
+
+	return expected
+}
+
+// isNssaDefaultRouteExpected reports whether a Type-7 default route is expected in the NSSA area.
+// An NSSA ABR always originates it, an internal ASBR only with a default route in the RIB.
+func (a *Analyzer) isNssaDefaultRouteExpected(config *frrProto.StaticFRRConfiguration, area *frrProto.Area) bool {
+	if area.Type != "nssa" || !area.NssaDefaultOriginate {
+		return false
+	}
+	if isAreaBorderRouter(getAttachedAreas(config), getOspfArea(a.metrics.GeneralOspfInformation)) {
+		return true
+	}
+	return a.hasRibDefaultRoute()
+}
+
+// hasRibDefaultRoute reports whether a selected default route not learned via OSPF exists
+func (a *Analyzer) hasRibDefaultRoute() bool {
+	if a.metrics.RoutingInformationBase == nil {
+		return false
+	}
+	routes, exists := a.metrics.RoutingInformationBase.Routes["0.0.0.0/0"]
+	if !exists {
+		return false
+	}
+	for _, route := range routes.Routes {
+		if route.Selected && route.Protocol != "ospf" {
+			return true
+		}
+	}
+	return false
+}
+
+func isDefaultPrefix(address, prefixLength string) bool {
+	return address == "0.0.0.0" && prefixLength == "0"
+}
+
+type equalCostPath struct {
+	Cost     int32
+	Area     string
+	NextHops map[string]bool
+}
+
+type spfEdge struct {
+	target string
+	cost   int32
+	via    string
+}
+
+// GetLsdbEqualCostPaths runs an intra-area SPF over the router and network LSAs of every
+// area and returns the cost and the distinct first hops of each prefix, keyed by prefix/length.
+// Directly connected prefixes are omitted, as they have no next hop.
+func (a *Analyzer) GetLsdbEqualCostPaths() map[string]*equalCostPath {
+	result := make(map[string]*equalCostPath)
+	if a.metrics.OspfRouterDataAll == nil {
+		return result
+	}
+
+	selfRouterId := a.metrics.StaticFrrConfiguration.GetOspfConfig().GetRouterId()
+	if selfRouterId == "" {
+		selfRouterId = a.metrics.OspfRouterDataAll.RouterId
+	}
+
+	for areaName, routerArea := range a.metrics.OspfRouterDataAll.RouterStates {
+		var networkLsas map[string]*frrProto.NetworkLSA
+		if a.metrics.OspfNetworkDataAll != nil {
+			if netArea, exists := a.metrics.OspfNetworkDataAll.NetStates[areaName]; exists {
+				networkLsas = netArea.LsaEntries
+			}
+		}
+
+		for prefix, path := range calculateAreaPaths(selfRouterId, routerArea.LsaEntries, networkLsas) {
+			path.Area = areaName
+			existing, exists := result[prefix]
+			switch {
+			case !exists || path.Cost < existing.Cost:
+				result[prefix] = path
+			case path.Cost == existing.Cost:
+				for hop := range path.NextHops {
+					existing.NextHops[hop] = true
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// calculateAreaPaths is a Dijkstra over routers ("R:<router id>") and transit networks
+// ("N:<designated router address>"). Next hops are identified by neighbor router id and
+// the link they are reached over, so parallel links to the same neighbor count twice.
+func calculateAreaPaths(selfRouterId string, routerLsas map[string]*frrProto.OSPFRouterLSA, networkLsas map[string]*frrProto.NetworkLSA) map[string]*equalCostPath {
+	root := "R:" + selfRouterId
+	result := make(map[string]*equalCostPath)
+	if _, exists := routerLsas[selfRouterId]; !exists {
+		return result
+	}
+
+	distance, nextHops, directNetworks := runAreaSpf(root, routerLsas, networkLsas)
+
+	addPath := func(prefix string, cost int32, hops map[string]bool) {
+		existing, exists := result[prefix]
+		switch {
+		case !exists || cost < existing.Cost:
+			copied := make(map[string]bool, len(hops))
+			for hop := range hops {
+				copied[hop] = true
+			}
+			result[prefix] = &equalCostPath{Cost: cost, NextHops: copied}
+		case cost == existing.Cost:
+			for hop := range hops {
+				existing.NextHops[hop] = true
+			}
+		}
+	}
+
+	directPrefixes := map[string]bool{}
+	for _, link := range routerLsas[selfRouterId].RouterLinks {
+		if strings.Contains(strings.ToLower(link.LinkType), "stub network") {
+			directPrefixes[link.NetworkAddress+"/"+maskToPrefixLength(link.NetworkMask)] = true
+		}
+	}
+
+	for vertex, cost := range distance {
+		if vertex == root || directNetworks[vertex] || len(nextHops[vertex]) == 0 {
+			if strings.HasPrefix(vertex, "N:") {
+				networkLsa := networkLsas[vertex[2:]]
+				directPrefixes[getNetworkAddress(networkLsa.LinkStateId, networkLsa.NetworkMask)+"/"+strconv.Itoa(int(networkLsa.NetworkMask))] = true
+			}
+			continue
+		}
+
+		if strings.HasPrefix(vertex, "N:") {
+			networkLsa := networkLsas[vertex[2:]]
+			prefix := getNetworkAddress(networkLsa.LinkStateId, networkLsa.NetworkMask) + "/" + strconv.Itoa(int(networkLsa.NetworkMask))
+			addPath(prefix, cost, nextHops[vertex])
+			continue
+		}
+
+		for _, link := range routerLsas[vertex[2:]].RouterLinks {
+			if !strings.Contains(strings.ToLower(link.LinkType), "stub network") {
+				continue
+			}
+			addPath(link.NetworkAddress+"/"+maskToPrefixLength(link.NetworkMask), cost+link.Tos0Metric, nextHops[vertex])
+		}
+	}
+
+	for prefix := range directPrefixes {
+		delete(result, prefix)
+	}
+
+	return result
+}
+
+// runAreaSpf returns the cost from root to every reachable vertex of the area, the first hops
+// of the vertices and the transit networks root is attached to.
+func runAreaSpf(root string, routerLsas map[string]*frrProto.OSPFRouterLSA, networkLsas map[string]*frrProto.NetworkLSA) (map[string]int32, map[string]map[string]bool, map[string]bool) {
+	getEdges := func(vertex string) []spfEdge {
+		var edges []spfEdge
+		id := vertex[2:]
+		if strings.HasPrefix(vertex, "N:") {
+			for _, attached := range networkLsas[id].GetAttachedRouters() {
+				if _, exists := routerLsas[attached.AttachedRouterId]; exists {
+					edges = append(edges, spfEdge{target: "R:" + attached.AttachedRouterId, via: id})
+				}
+			}
+			return edges
+		}
+
+		for _, link := range routerLsas[id].RouterLinks {
+			linkType := strings.ToLower(link.LinkType)
+			switch {
+			case strings.Contains(linkType, "point-to-point"):
+				if _, exists := routerLsas[link.NeighborRouterId]; exists {
+					edges = append(edges, spfEdge{target: "R:" + link.NeighborRouterId, cost: link.Tos0Metric, via: link.RouterInterfaceAddress})
+				}
+			case strings.Contains(linkType, "transit network"):
+				if _, exists := networkLsas[link.DesignatedRouterAddress]; exists {
+					edges = append(edges, spfEdge{target: "N:" + link.DesignatedRouterAddress, cost: link.Tos0Metric})
+				}
+			}
+		}
+		return edges
+	}
+
+	distance := map[string]int32{root: 0}
+	nextHops := map[string]map[string]bool{root: {}}
+	directNetworks := map[string]bool{}
+	visited := map[string]bool{}
+
+	for {
+		current := ""
+		for vertex, cost := range distance {
+			if !visited[vertex] && (current == "" || cost < distance[current] || (cost == distance[current] && vertex < current)) {
+				current = vertex
+			}
+		}
+		if current == "" {
+			break
+		}
+		visited[current] = true
+
+		for _, edge := range getEdges(current) {
+			if visited[edge.target] {
+				continue
+			}
+
+			hops := map[string]bool{}
+			direct := false
+			switch {
+			case current == root && strings.HasPrefix(edge.target, "N:"):
+				direct = true
+			case current == root, directNetworks[current]:
+				hops[edge.target[2:]+"@"+edge.via] = true
+			default:
+				for hop := range nextHops[current] {
+					hops[hop] = true
+				}
+			}
+
+			cost := distance[current] + edge.cost
+			existing, exists := distance[edge.target]
+			switch {
+			case !exists || cost < existing:
+				distance[edge.target] = cost
+				nextHops[edge.target] = hops
+				directNetworks[edge.target] = direct
+			case cost == existing:
+				for hop := range hops {
+					nextHops[edge.target][hop] = true
+				}
+			}
+		}
+	}
+
+	return distance, nextHops, directNetworks
+}
+
+// getAsbrCosts returns the lowest intra-area cost from the router to each ASBR of its areas,
+// which is the metric of the ASBR-summary-LSAs (type 4) it originates for them.
+func (a *Analyzer) getAsbrCosts(selfRouterId string) map[string]int32 {
+	result := make(map[string]int32)
+	if a.metrics.OspfRouterDataAll == nil {
+		return result
+	}
+
+	for areaName, routerArea := range a.metrics.OspfRouterDataAll.RouterStates {
+		if _, exists := routerArea.LsaEntries[selfRouterId]; !exists {
+			continue
+		}
+		var networkLsas map[string]*frrProto.NetworkLSA
+		if a.metrics.OspfNetworkDataAll != nil {
+			if netArea, exists := a.metrics.OspfNetworkDataAll.NetStates[areaName]; exists {
+				networkLsas = netArea.LsaEntries
+			}
+		}
+
+		distance, _, _ := runAreaSpf("R:"+selfRouterId, routerArea.LsaEntries, networkLsas)
+		for routerId, lsa := range routerArea.LsaEntries {
+			cost, reachable := distance["R:"+routerId]
+			if !lsa.Asbr || !reachable || routerId == selfRouterId {
+				continue
+			}
+			if existing, exists := result[routerId]; !exists || cost < existing {
+				result[routerId] = cost
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,210 @@
+package analyzer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
+)
+
+// ExpectedLsa is a single link or prefix of the should-state derived from a configuration.
+type ExpectedLsa struct {
+	LsaType  string
+	Area     string
+	Prefix   string
+	LinkType string
+}
+
+// PredictedAnomaly is an anomaly the candidate configuration introduces or resolves.
+type PredictedAnomaly struct {
+	ID          string
+	Source      string
+	AnomalyType string
+	Severity    string
+	Reason      string
+}
+
+// ChangePrediction compares the should-state and the resulting anomalies of the running
+// and a candidate configuration against the same live LSDB.
+type ChangePrediction struct {
+	AddedLsas           []ExpectedLsa
+	RemovedLsas         []ExpectedLsa
+	IntroducedAnomalies []PredictedAnomaly
+	ResolvedAnomalies   []PredictedAnomaly
+}
+
+// PredictChange runs the analysis once with the running and once with the candidate
+// configuration. The live state of the analyzer is not modified.
+func (a *Analyzer) PredictChange(candidate *frrProto.StaticFRRConfiguration) *ChangePrediction {
+	running := a.predictionAnalyzer(a.metrics.GetStaticFrrConfiguration())
+	changed := a.predictionAnalyzer(candidate)
+
+	runningLsas := running.expectedLsas()
+	candidateLsas := changed.expectedLsas()
+	runningAnomalies := running.predictedAnomalies()
+	candidateAnomalies := changed.predictedAnomalies()
+
+	prediction := &ChangePrediction{}
+	for key, lsa := range candidateLsas {
+		if _, exists := runningLsas[key]; !exists {
+			prediction.AddedLsas = append(prediction.AddedLsas, lsa)
+		}
+	}
+	for key, lsa := range runningLsas {
+		if _, exists := candidateLsas[key]; !exists {
+			prediction.RemovedLsas = append(prediction.RemovedLsas, lsa)
+		}
+	}
+	for id, anomaly := range candidateAnomalies {
+		if _, exists := runningAnomalies[id]; !exists {
+			prediction.IntroducedAnomalies = append(prediction.IntroducedAnomalies, anomaly)
+		}
+	}
+	for id, anomaly := range runningAnomalies {
+		if _, exists := candidateAnomalies[id]; !exists {
+			prediction.ResolvedAnomalies = append(prediction.ResolvedAnomalies, anomaly)
+		}
+	}
+
+	sortExpectedLsas(prediction.AddedLsas)
+	sortExpectedLsas(prediction.RemovedLsas)
+	sortPredictedAnomalies(prediction.IntroducedAnomalies)
+	sortPredictedAnomalies(prediction.ResolvedAnomalies)
+
+	a.Logger.WithAttrs(map[string]any{
+		"added_lsas":           len(prediction.AddedLsas),
+		"removed_lsas":         len(prediction.RemovedLsas),
+		"introduced_anomalies": len(prediction.IntroducedAnomalies),
+		"resolved_anomalies":   len(prediction.ResolvedAnomalies),
+	}).Info("Completed change prediction")
+
+	return prediction
+}
+
+// Write prints the prediction as a diff, + for added and - for removed entries.
+func (p *ChangePrediction) Write(w io.Writer) error {
+	fmt.Fprintln(w, "Expected LSAs (running -> candidate)")
+	if len(p.AddedLsas) == 0 && len(p.RemovedLsas) == 0 {
+		fmt.Fprintln(w, "  no change")
+	}
+	for _, lsa := range p.AddedLsas {
+		fmt.Fprintf(w, "  + %s\n", lsa)
+	}
+	for _, lsa := range p.RemovedLsas {
+		fmt.Fprintf(w, "  - %s\n", lsa)
+	}
+
+	fmt.Fprintln(w, "\nAnomalies introduced by the candidate")
+	if len(p.IntroducedAnomalies) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, anomaly := range p.IntroducedAnomalies {
+		fmt.Fprintf(w, "  + %s\n", anomaly)
+	}
+
+	fmt.Fprintln(w, "\nAnomalies resolved by the candidate")
+	if len(p.ResolvedAnomalies) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, anomaly := range p.ResolvedAnomalies {
+		fmt.Fprintf(w, "  - %s\n", anomaly)
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d LSAs added, %d removed, %d anomalies introduced, %d resolved\n",
+		len(p.AddedLsas), len(p.RemovedLsas), len(p.IntroducedAnomalies), len(p.ResolvedAnomalies))
+	return err
+}
+
+func (l ExpectedLsa) String() string {
+	result := fmt.Sprintf("%-14s %s", l.LsaType, l.Prefix)
+	if l.Area != "" {
+		result += " area " + l.Area
+	}
+	if l.LinkType != "" {
+		result += " (" + l.LinkType + ")"
+	}
+	return result
+}
+
+func (p PredictedAnomaly) String() string {
+	result := fmt.Sprintf("[%s] %s", p.Severity, p.ID)
+	if p.Reason != "" {
+		result += ": " + p.Reason
+	}
+	return result
+}
+
+// predictionAnalyzer returns an analyzer on a copy of the live data with the given configuration,
+// after a single analysis cycle.
+func (a *Analyzer) predictionAnalyzer(config *frrProto.StaticFRRConfiguration) *Analyzer {
+	metrics := proto.Clone(a.metrics).(*frrProto.FullFRRData)
+	metrics.StaticFrrConfiguration = proto.Clone(config).(*frrProto.StaticFRRConfiguration)
+
+	// predicted anomalies must not show up in the anomaly log of the live analysis
+	prediction := InitAnalyzer(metrics, a.Logger, a.Logger)
+	prediction.Suppressions = a.Suppressions
+	prediction.Intent = a.Intent
+	prediction.Checks = a.Checks
+	prediction.AnomalyAnalysis()
+
+	return prediction
+}
+
+// expectedLsas flattens the router, external and NSSA external should-state of the last cycle.
+func (a *Analyzer) expectedLsas() map[string]ExpectedLsa {
+	result := map[string]ExpectedLsa{}
+	add := func(lsaType string, areas []*frrProto.AreaAnalyzer) {
+		for _, area := range areas {
+			for _, link := range area.Links {
+				lsa := ExpectedLsa{
+					LsaType:  lsaType,
+					Area:     area.AreaName,
+					Prefix:   advertisementPrefix(link),
+					LinkType: link.LinkType,
+				}
+				result[lsaType+":"+lsa.Area+":"+lsa.Prefix+":"+lsa.LinkType] = lsa
+			}
+		}
+	}
+
+	add("router", a.AnalyserStateParserResults.GetShouldRouterLsdb().GetAreas())
+	add("external", a.AnalyserStateParserResults.GetShouldExternalLsdb().GetAreas())
+	add("nssa-external", a.AnalyserStateParserResults.GetShouldNssaExternalLsdb().GetAreas())
+
+	return result
+}
+
+func (a *Analyzer) predictedAnomalies() map[string]PredictedAnomaly {
+	result := map[string]PredictedAnomaly{}
+	for _, entry := range a.reportEntries() {
+		id := AnomalyID(entry.source, entry.anomalyType, entry.entry)
+		result[id] = PredictedAnomaly{
+			ID:          id,
+			Source:      entry.source,
+			AnomalyType: entry.anomalyType,
+			Severity:    entry.entry.Severity,
+			Reason:      entry.entry.Reason,
+		}
+	}
+	return result
+}
+
+func sortExpectedLsas(lsas []ExpectedLsa) {
+	sort.Slice(lsas, func(i, j int) bool {
+		if lsas[i].LsaType != lsas[j].LsaType {
+			return lsas[i].LsaType < lsas[j].LsaType
+		}
+		if lsas[i].Area != lsas[j].Area {
+			return lsas[i].Area < lsas[j].Area
+		}
+		return lsas[i].Prefix < lsas[j].Prefix
+	})
+}
+
+func sortPredictedAnomalies(anomalies []PredictedAnomaly) {
+	sort.Slice(anomalies, func(i, j int) bool {
+		return anomalies[i].ID < anomalies[j].ID
+	})
+}
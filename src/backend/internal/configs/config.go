@@ -1,9 +1,12 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -17,10 +20,29 @@ type DefaultConfig struct {
 	DebugLevel string `mapstructure:"debuglevel"`
 }
 
+// SocketConfig configures the unix socket server. Zero values select the defaults of the socket package.
 type SocketConfig struct {
-	UnixSocketLocation string `mapstructure:"unixsocketlocation"`
-	UnixSocketName     string `mapstructure:"unixsocketname"`
-	SocketType         string `mapstructure:"sockettype"`
+	UnixSocketLocation string       `mapstructure:"unixsocketlocation"`
+	UnixSocketName     string       `mapstructure:"unixsocketname"`
+	SocketType         string       `mapstructure:"sockettype"`
+	MaxConnections     int          `mapstructure:"maxconnections"` // concurrently served clients
+	MaxSubscribers     int          `mapstructure:"maxsubscribers"` // concurrently subscribed clients, not counted as served clients
+	ReadTimeout        int          `mapstructure:"readtimeout"`    // seconds a connection may idle between requests
+	WriteTimeout       int          `mapstructure:"writetimeout"`   // seconds to write a response
+	MaxMessageSize     int          `mapstructure:"maxmessagesize"` // bytes of a single request
+	DrainTimeout       int          `mapstructure:"draintimeout"`   // seconds in-flight requests may take on shutdown
+	GrpcSocketName     string       `mapstructure:"grpcsocketname"` // unix socket of the gRPC API in UnixSocketLocation
+	GrpcAddress        string       `mapstructure:"grpcaddress"`    // optional TCP address of the gRPC API, e.g. 127.0.0.1:9092
+	Access             AccessConfig `mapstructure:"access"`
+}
+
+// AccessConfig maps the peers of the socket to the roles readonly, operator and admin. Users and
+// groups are given by name or numeric ID. User rules precede group rules, peers without a rule get
+// the default role, except root and the user of the daemon, which are admins.
+type AccessConfig struct {
+	DefaultRole string            `mapstructure:"defaultrole"` // default: readonly
+	Users       map[string]string `mapstructure:"users"`
+	Groups      map[string]string `mapstructure:"groups"`
 }
 
 type AggregatorConfig struct {
@@ -29,6 +51,29 @@ type AggregatorConfig struct {
 	SocketPath    string `mapstructure:"socketpath"`
 }
 
+type AnalyzerConfig struct {
+	HoldDownCycles int                    `mapstructure:"holddowncycles"`
+	Suppressions   []SuppressionRule      `mapstructure:"suppressions"`
+	IntentFile     string                 `mapstructure:"intentfile"`
+	Checks         map[string]CheckConfig `mapstructure:"checks"`
+}
+
+// CheckConfig enables or disables a registered analysis check and passes its parameters.
+// Checks without configuration are enabled.
+type CheckConfig struct {
+	Enabled *bool             `mapstructure:"enabled"`
+	Params  map[string]string `mapstructure:"params"`
+}
+
+// SuppressionRule silences anomalies matching all of its non-empty fields.
+type SuppressionRule struct {
+	Prefix    string `mapstructure:"prefix"`
+	Area      string `mapstructure:"area"`
+	Interface string `mapstructure:"interface"`
+	Source    string `mapstructure:"source"`
+	Comment   string `mapstructure:"comment"`
+}
+
 type ExporterConfig struct {
 	Port                 int  `mapstructure:"Port"`
 	OSPFRouterData       bool `mapstructure:"OSPFRouterData"`
@@ -41,12 +86,14 @@ type ExporterConfig struct {
 	OSPFNeighbors        bool `mapstructure:"OSPFNeighbors"`
 	InterfaceList        bool `mapstructure:"InterfaceList"`
 	RouteList            bool `mapstructure:"RouteList"`
+	RestAPI              bool `mapstructure:"RestAPI"` // serves the unauthenticated REST API on Port, default: false
 }
 
 type Config struct {
 	Default    DefaultConfig    `mapstructure:"default"`
 	Socket     SocketConfig     `mapstructure:"socket"`
 	Aggregator AggregatorConfig `mapstructure:"aggregator"`
+	Analyzer   AnalyzerConfig   `mapstructure:"analyzer"`
 	Exporter   ExporterConfig   `mapstructure:"exporter"`
 }
 
@@ -93,3 +140,52 @@ func LoadYAMLConfig(yamlPath string) (*Config, error) {
 
 	return config, nil
 }
+
+var (
+	debugLevels = []string{"", "debug", "info", "warning", "error", "none"}
+	accessRoles = []string{"readonly", "operator", "admin"}
+)
+
+// Validate reports the settings a running daemon cannot apply. Missing optional settings are
+// valid, they select the defaults.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if !slices.Contains(debugLevels, c.Default.DebugLevel) {
+		errs = append(errs, fmt.Errorf("default.debuglevel: unknown level %q", c.Default.DebugLevel))
+	}
+	if c.Aggregator.PollInterval <= 0 {
+		errs = append(errs, fmt.Errorf("aggregator.pollinterval: must be at least 1 second, got %d", c.Aggregator.PollInterval))
+	}
+	if c.Exporter.Port < 0 || c.Exporter.Port > 65535 {
+		errs = append(errs, fmt.Errorf("exporter.Port: %d is out of bounds (must be between 1 and 65535)", c.Exporter.Port))
+	}
+
+	for i, rule := range c.Analyzer.Suppressions {
+		if rule.Prefix == "" && rule.Area == "" && rule.Interface == "" && rule.Source == "" {
+			errs = append(errs, fmt.Errorf("analyzer.suppressions[%d]: rule matches nothing, set prefix, area, interface or source", i))
+		}
+		if rule.Prefix != "" {
+			if _, _, err := net.ParseCIDR(rule.Prefix); err != nil {
+				errs = append(errs, fmt.Errorf("analyzer.suppressions[%d]: invalid prefix %q", i, rule.Prefix))
+			}
+		}
+	}
+
+	access := c.Socket.Access
+	if access.DefaultRole != "" && !slices.Contains(accessRoles, access.DefaultRole) {
+		errs = append(errs, fmt.Errorf("socket.access.defaultrole: unknown role %q", access.DefaultRole))
+	}
+	for name, role := range access.Users {
+		if !slices.Contains(accessRoles, role) {
+			errs = append(errs, fmt.Errorf("socket.access.users.%s: unknown role %q", name, role))
+		}
+	}
+	for name, role := range access.Groups {
+		if !slices.Contains(accessRoles, role) {
+			errs = append(errs, fmt.Errorf("socket.access.groups.%s: unknown role %q", name, role))
+		}
+	}
+
+	return errors.Join(errs...)
+}
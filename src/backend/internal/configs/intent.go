@@ -0,0 +1,125 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Intent describes the routing state an operator expects, independent of the running FRR configuration.
+type Intent struct {
+	Prefixes  IntentPrefixes   `mapstructure:"prefixes"`
+	Neighbors []IntentNeighbor `mapstructure:"neighbors"`
+	Areas     []IntentArea     `mapstructure:"areas"`
+	LsaLimits []IntentLsaLimit `mapstructure:"lsalimits"`
+}
+
+type IntentPrefixes struct {
+	MustHave    []IntentPrefix `mapstructure:"musthave"`
+	MustNotHave []IntentPrefix `mapstructure:"mustnothave"`
+}
+
+// IntentPrefix asserts a prefix in the RIB (Protocol) and/or the LSDB (Area, LsaType).
+// LsaType is one of router, network, summary, external or nssa-external.
+type IntentPrefix struct {
+	Prefix   string `mapstructure:"prefix"`
+	Area     string `mapstructure:"area"`
+	Protocol string `mapstructure:"protocol"`
+	LsaType  string `mapstructure:"lsatype"`
+}
+
+// IntentNeighbor expects an OSPF adjacency, by default in state Full.
+type IntentNeighbor struct {
+	RouterId  string `mapstructure:"routerid"`
+	Interface string `mapstructure:"interface"`
+	State     string `mapstructure:"state"`
+}
+
+type IntentArea struct {
+	Interface string `mapstructure:"interface"`
+	Area      string `mapstructure:"area"`
+}
+
+// IntentLsaLimit caps the number of LSAs of a type, per area or, without area, in total.
+// LsaType is one of router, network, summary, asbr-summary, nssa-external, external or total.
+type IntentLsaLimit struct {
+	LsaType string `mapstructure:"lsatype"`
+	Area    string `mapstructure:"area"`
+	Max     int    `mapstructure:"max"`
+}
+
+func LoadIntentFile(intentPath string) (*Intent, error) {
+	v := viper.New()
+	v.SetConfigFile(intentPath)
+	v.SetConfigType("yaml")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("error reading intent file: %w", err)
+	}
+
+	intent := &Intent{}
+	if err := v.Unmarshal(intent); err != nil {
+		return nil, fmt.Errorf("error unmarshaling intent file: %w", err)
+	}
+	if err := intent.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid intent file: %w", err)
+	}
+
+	return intent, nil
+}
+
+var (
+	intentPrefixLsaTypes = []string{"", "router", "network", "summary", "external", "nssa-external"}
+	intentLimitLsaTypes  = []string{"router", "network", "summary", "asbr-summary", "nssa-external", "external", "total"}
+)
+
+// Validate reports assertions the intent analysis cannot evaluate. An assertion with an unknown
+// lsa type, prefix or area would never match and silently pass or fail on every cycle.
+func (i *Intent) Validate() error {
+	var errs []error
+
+	validatePrefixes := func(field string, prefixes []IntentPrefix) {
+		for n, prefix := range prefixes {
+			if _, _, err := net.ParseCIDR(prefix.Prefix); err != nil {
+				errs = append(errs, fmt.Errorf("%s[%d]: invalid prefix %q", field, n, prefix.Prefix))
+			}
+			if !slices.Contains(intentPrefixLsaTypes, prefix.LsaType) {
+				errs = append(errs, fmt.Errorf("%s[%d]: unknown lsatype %q", field, n, prefix.LsaType))
+			}
+			if prefix.Area != "" && !isAreaID(prefix.Area) {
+				errs = append(errs, fmt.Errorf("%s[%d]: invalid area %q", field, n, prefix.Area))
+			}
+		}
+	}
+	validatePrefixes("prefixes.musthave", i.Prefixes.MustHave)
+	validatePrefixes("prefixes.mustnothave", i.Prefixes.MustNotHave)
+
+	for n, area := range i.Areas {
+		if !isAreaID(area.Area) {
+			errs = append(errs, fmt.Errorf("areas[%d]: invalid area %q", n, area.Area))
+		}
+	}
+
+	for n, limit := range i.LsaLimits {
+		if !slices.Contains(intentLimitLsaTypes, limit.LsaType) {
+			errs = append(errs, fmt.Errorf("lsalimits[%d]: unknown lsatype %q", n, limit.LsaType))
+		}
+		if limit.Area != "" && !isAreaID(limit.Area) {
+			errs = append(errs, fmt.Errorf("lsalimits[%d]: invalid area %q", n, limit.Area))
+		}
+		if limit.Max <= 0 {
+			errs = append(errs, fmt.Errorf("lsalimits[%d]: max must be at least 1, got %d", n, limit.Max))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// isAreaID reports whether area is an OSPF area id in dotted decimal notation, as FRR reports them.
+func isAreaID(area string) bool {
+	return !strings.Contains(area, ":") && net.ParseIP(area).To4() != nil
+}
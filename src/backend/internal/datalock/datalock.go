@@ -0,0 +1,26 @@
+// Package datalock guards the collected FRR data and the anomaly analysis result. The collector
+// and the analyzer update both in place, the socket, the gRPC server and the exporters read them.
+package datalock
+
+import "sync"
+
+var mutex sync.RWMutex
+
+// Lock is held while the data is updated, e.g. by a collection or an analysis cycle.
+func Lock() {
+	mutex.Lock()
+}
+
+func Unlock() {
+	mutex.Unlock()
+}
+
+// RLock is held while the data is read. A response built from the data must be copied before
+// RUnlock, as it would still share the updated messages.
+func RLock() {
+	mutex.RLock()
+}
+
+func RUnlock() {
+	mutex.RUnlock()
+}
@@ -1,22 +1,32 @@
 package exporter
 
 import (
+	"slices"
+	"strings"
 	"sync"
 
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 type AnomalyExporter struct {
-	anomalies      *frrProto.AnomalyAnalysis
-	activeAlerts   map[string]bool
-	anomalyDetails *prometheus.GaugeVec
-	anomalyFlags   *prometheus.GaugeVec
-	alertCounters  map[string]prometheus.Gauge
-	logger         *logger.Logger
-	mutex          sync.Mutex
-	knownLabelSets map[string]prometheus.Labels
+	anomalies       *frrProto.AnomalyAnalysis
+	activeAlerts    map[string]bool
+	anomalyDetails  *prometheus.GaugeVec
+	anomalyFlags    *prometheus.GaugeVec
+	anomalySeverity *prometheus.GaugeVec
+	anomalyArea     *prometheus.GaugeVec
+	anomalyAge      *prometheus.GaugeVec
+	anomalyFlaps    *prometheus.GaugeVec
+	checkDuration   *prometheus.GaugeVec
+	checkEnabled    *prometheus.GaugeVec
+	checkFailed     *prometheus.GaugeVec
+	alertCounters   map[string]prometheus.Gauge
+	logger          *logger.Logger
+	mutex           sync.Mutex
+	knownLabelSets  map[string]prometheus.Labels
 }
 
 func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus.Registerer, logger *logger.Logger) *AnomalyExporter {
@@ -34,21 +44,33 @@ func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus
 	a.anomalyDetails = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "frr_mad_anomaly_details",
-			Help: "Detailed information about anomalies (1=present, 0=absent)",
-		},
-		[]string{
-			"anomaly_type", // overadvertised, unadvertised, duplicate, etc.
-			"source",       // RouterAnomaly, ExternalAnomaly, NssaExternalAnomaly, RibToFib, LsdbToRib
-			"interface_address",
-			"link_state_id",
-			"prefix_length",
-			"link_type",
-			"p_bit",
-			"options",
+			Help: "Detailed information about present anomalies, the series is removed once an anomaly is resolved",
 		},
+		anomalyDetailLabels,
 	)
 	registry.MustRegister(a.anomalyDetails)
 
+	a.anomalySeverity = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_anomalies_by_severity",
+			Help: "Number of anomalies per severity which are neither suppressed nor acknowledged",
+		},
+		[]string{"severity"},
+	)
+	registry.MustRegister(a.anomalySeverity)
+	for _, severity := range severities {
+		a.anomalySeverity.WithLabelValues(severity).Set(0)
+	}
+
+	a.anomalyArea = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_anomalies_by_area",
+			Help: "Number of anomalies per OSPF area and type which are neither suppressed nor acknowledged",
+		},
+		[]string{"area", "anomaly_type"},
+	)
+	registry.MustRegister(a.anomalyArea)
+
 	a.anomalyFlags = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "frr_mad_anomaly_flags",
@@ -61,11 +83,56 @@ func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus
 	)
 	registry.MustRegister(a.anomalyFlags)
 
-	// Initialize flag metrics for all sources and flag types to ensure they exist
-	for _, source := range []string{"RouterAnomaly", "ExternalAnomaly", "NssaExternalAnomaly", "RibToFib", "LsdbToRib"} {
-		for _, flag := range []string{"overadvertised", "unadvertised", "duplicate", "misconfigured"} {
-			a.anomalyFlags.WithLabelValues(source, flag).Set(0)
-		}
+	// Initialize lifecycle metrics of anomalies that passed the hold-down
+	a.anomalyAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_anomaly_duration_seconds",
+			Help: "Duration of currently active anomalies in seconds",
+		},
+		[]string{"source", "anomaly_type", "prefix", "area"},
+	)
+	registry.MustRegister(a.anomalyAge)
+
+	a.anomalyFlaps = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_anomaly_flap_count",
+			Help: "Number of times an anomaly reappeared after it was resolved",
+		},
+		[]string{"source", "anomaly_type", "prefix", "area"},
+	)
+	registry.MustRegister(a.anomalyFlaps)
+
+	// Initialize metrics of the registered analysis checks
+	a.checkDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_check_duration_seconds",
+			Help: "Duration of the last run of an analysis check in seconds",
+		},
+		[]string{"check"},
+	)
+	registry.MustRegister(a.checkDuration)
+
+	a.checkEnabled = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_check_enabled",
+			Help: "Whether an analysis check is enabled (1=enabled, 0=disabled)",
+		},
+		[]string{"check"},
+	)
+	registry.MustRegister(a.checkEnabled)
+
+	a.checkFailed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "frr_mad_check_failed",
+			Help: "Whether the last run of an analysis check failed (1=failed, 0=succeeded)",
+		},
+		[]string{"check"},
+	)
+	registry.MustRegister(a.checkFailed)
+
+	// Initialize flag metrics for all checks and flag types to ensure they exist
+	for _, check := range anomalies.GetCheckResults() {
+		a.setAnomalyFlags(check.GetName(), nil)
 	}
 
 	// Create a default detail metric to ensure it exists even when no anomalies are present
@@ -78,9 +145,13 @@ func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus
 		"link_type":         "none",
 		"p_bit":             "false",
 		"options":           "none",
+		"area":              "none",
+		"severity":          "none",
+		"suppressed":        "false",
+		"acknowledged":      "false",
+		"reason_code":       "none",
 	}
 	a.anomalyDetails.With(defaultLabels).Set(0)
-	a.knownLabelSets["default"] = defaultLabels
 
 	counterTypes := []struct {
 		name string
@@ -92,6 +163,8 @@ func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus
 		{"frr_mad_ospf_misconfigured_routes_total", "Total misconfigured routes detected in OSPF"},
 		{"frr_mad_rib_to_fib_anomalies_total", "Total RIB to FIB anomalies detected"},
 		{"frr_mad_lsdb_to_rib_anomalies_total", "Total LSDB to RIB anomalies detected"},
+		{"frr_mad_ecmp_anomalies_total", "Total ECMP next-hop anomalies detected"},
+		{"frr_mad_intent_violations_total", "Total violations of the intent file detected"},
 	}
 
 	for _, ct := range counterTypes {
@@ -113,23 +186,30 @@ func NewAnomalyExporter(anomalies *frrProto.AnomalyAnalysis, registry prometheus
 func (a *AnomalyExporter) Update() {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
+	datalock.RLock()
+	defer datalock.RUnlock()
 
-	// Reset all existing metrics to zero
-	for _, labels := range a.knownLabelSets {
-		a.anomalyDetails.With(labels).Set(0)
-	}
+	// detail series of anomalies which disappeared are deleted once all sources are processed
+	previousLabelSets := a.knownLabelSets
 	a.knownLabelSets = make(map[string]prometheus.Labels)
+	defer a.deleteStaleDetails(previousLabelSets)
 
 	for _, counter := range a.alertCounters {
 		counter.Set(0)
 	}
 
-	for _, source := range []string{"RouterAnomaly", "ExternalAnomaly", "NssaExternalAnomaly", "RibToFib", "LsdbToRib"} {
-		for _, flag := range []string{"overadvertised", "unadvertised", "duplicate", "misconfigured"} {
-			a.anomalyFlags.WithLabelValues(source, flag).Set(0)
-		}
+	a.anomalyAge.Reset()
+	a.anomalyFlaps.Reset()
+	a.anomalyArea.Reset()
+	a.checkDuration.Reset()
+	a.checkEnabled.Reset()
+	a.checkFailed.Reset()
+	for _, severity := range severities {
+		a.anomalySeverity.WithLabelValues(severity).Set(0)
 	}
 
+	a.anomalyFlags.Reset()
+
 	if a.anomalies == nil {
 		a.logger.Debug("Skipping anomaly update - no anomaly data available")
 		return
@@ -137,129 +217,116 @@ func (a *AnomalyExporter) Update() {
 
 	a.logger.Debug("Updating anomaly metrics")
 
-	// OSPF anomalies
-	a.processOspfSources()
+	// Findings of the analysis checks, published under the check name
+	counts := make(map[string]int)
+	for _, check := range a.anomalies.GetCheckResults() {
+		a.checkDuration.WithLabelValues(check.GetName()).Set(float64(check.GetDurationMicroseconds()) / 1e6)
+		a.checkEnabled.WithLabelValues(check.GetName()).Set(boolToFloat(check.GetEnabled()))
+		a.checkFailed.WithLabelValues(check.GetName()).Set(boolToFloat(check.GetError() != ""))
 
-	// RIB to FIB anomalies
-	if ribToFib := a.anomalies.RibToFibAnomaly; ribToFib != nil {
-		a.alertCounters["frr_mad_rib_to_fib_anomalies_total"].Set(float64(
-			len(ribToFib.GetSuperfluousEntries()) + len(ribToFib.GetMissingEntries()) + len(ribToFib.GetDuplicateEntries()),
-		))
-
-		a.anomalyFlags.WithLabelValues("RibToFib", "overadvertised").Set(boolToFloat(ribToFib.GetHasOverAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues("RibToFib", "unadvertised").Set(boolToFloat(ribToFib.GetHasUnAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues("RibToFib", "duplicate").Set(boolToFloat(ribToFib.GetHasDuplicatePrefixes()))
-		a.anomalyFlags.WithLabelValues("RibToFib", "misconfigured").Set(boolToFloat(ribToFib.GetHasMisconfiguredPrefixes()))
-
-		for _, entry := range ribToFib.GetSuperfluousEntries() {
-			a.setAnomalyDetail("overadvertised", "RibToFib", entry)
-		}
-		for _, entry := range ribToFib.GetMissingEntries() {
-			a.setAnomalyDetail("unadvertised", "RibToFib", entry)
-		}
-		for _, entry := range ribToFib.GetDuplicateEntries() {
-			a.setAnomalyDetail("duplicate", "RibToFib", entry)
-		}
+		a.processCheck(check, counts)
 	}
 
-	// LSDB to RIB anomalies
-	if lsdbToRib := a.anomalies.LsdbToRibAnomaly; lsdbToRib != nil {
-		a.alertCounters["frr_mad_lsdb_to_rib_anomalies_total"].Set(float64(
-			len(lsdbToRib.GetSuperfluousEntries()) + len(lsdbToRib.GetMissingEntries()) + len(lsdbToRib.GetDuplicateEntries()),
-		))
-
-		a.anomalyFlags.WithLabelValues("LsdbToRib", "overadvertised").Set(boolToFloat(lsdbToRib.GetHasOverAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues("LsdbToRib", "unadvertised").Set(boolToFloat(lsdbToRib.GetHasUnAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues("LsdbToRib", "duplicate").Set(boolToFloat(lsdbToRib.GetHasDuplicatePrefixes()))
-		a.anomalyFlags.WithLabelValues("LsdbToRib", "misconfigured").Set(boolToFloat(lsdbToRib.GetHasMisconfiguredPrefixes()))
+	for name, counter := range a.alertCounters {
+		counter.Set(float64(counts[name]))
+	}
 
-		for _, entry := range lsdbToRib.GetSuperfluousEntries() {
-			a.setAnomalyDetail("overadvertised", "LsdbToRib", entry)
+	// Anomaly lifecycle
+	for _, lifecycle := range a.anomalies.GetLifecycle() {
+		if !lifecycle.GetConfirmed() {
+			continue
 		}
-		for _, entry := range lsdbToRib.GetMissingEntries() {
-			a.setAnomalyDetail("unadvertised", "LsdbToRib", entry)
+
+		labels := prometheus.Labels{
+			"source":       lifecycle.GetSource(),
+			"anomaly_type": lifecycle.GetAnomalyType(),
+			"prefix":       lifecycle.GetPrefix(),
+			"area":         lifecycle.GetArea(),
 		}
-		for _, entry := range lsdbToRib.GetDuplicateEntries() {
-			a.setAnomalyDetail("duplicate", "LsdbToRib", entry)
+
+		a.anomalyFlaps.With(labels).Set(float64(lifecycle.GetFlapCount()))
+		if lifecycle.GetActive() {
+			a.anomalyAge.With(labels).Set(float64(lifecycle.GetDurationSeconds()))
 		}
 	}
 }
 
-func (a *AnomalyExporter) processOspfSources() {
-	var (
-		totalOver      int
-		totalUnder     int
-		totalDup       int
-		totalMisconfig int
-	)
+// processCheck exports the findings of an enabled check and adds them to the counters of the
+// check in counts.
+func (a *AnomalyExporter) processCheck(check *frrProto.CheckResult, counts map[string]int) {
+	source := check.GetName()
+	detection := check.GetFindings()
+	if !check.GetEnabled() {
+		detection = nil
+	}
 
-	processSource := func(source string, detection *frrProto.AnomalyDetection) {
-		if detection == nil {
-			a.logger.WithAttrs(map[string]interface{}{
-				"source": source,
-			}).Debug("Skipping nil detection for source")
-			return
-		}
+	a.logger.WithAttrs(map[string]interface{}{
+		"source":             source,
+		"has_overadvertised": detection.GetHasOverAdvertisedPrefixes(),
+		"has_unadvertised":   detection.GetHasUnAdvertisedPrefixes(),
+		"has_duplicate":      detection.GetHasDuplicatePrefixes(),
+		"has_misconfigured":  detection.GetHasMisconfiguredPrefixes(),
+	}).Debug("Processing check anomalies")
 
-		a.logger.WithAttrs(map[string]interface{}{
-			"source":             source,
-			"has_overadvertised": detection.GetHasOverAdvertisedPrefixes(),
-			"has_unadvertised":   detection.GetHasUnAdvertisedPrefixes(),
-			"has_duplicate":      detection.GetHasDuplicatePrefixes(),
-			"has_misconfigured":  detection.GetHasMisconfiguredPrefixes(),
-		}).Debug("Processing OSPF source anomalies")
-
-		a.anomalyFlags.WithLabelValues(source, "overadvertised").Set(boolToFloat(detection.GetHasOverAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues(source, "unadvertised").Set(boolToFloat(detection.GetHasUnAdvertisedPrefixes()))
-		a.anomalyFlags.WithLabelValues(source, "duplicate").Set(boolToFloat(detection.GetHasDuplicatePrefixes()))
-		a.anomalyFlags.WithLabelValues(source, "misconfigured").Set(boolToFloat(detection.GetHasMisconfiguredPrefixes()))
-
-		over := detection.GetSuperfluousEntries()
-		under := detection.GetMissingEntries()
-		dup := detection.GetDuplicateEntries()
+	a.setAnomalyFlags(source, detection)
 
-		a.logger.WithAttrs(map[string]interface{}{
-			"source":               source,
-			"overadvertised_count": len(over),
-			"unadvertised_count":   len(under),
-			"duplicate_count":      len(dup),
-		}).Debug("Counted anomalies for source")
-
-		totalOver += len(over)
-		totalUnder += len(under)
-		totalDup += len(dup)
-
-		for _, ad := range over {
-			a.setAnomalyDetail("overadvertised", source, ad)
-		}
-		for _, ad := range under {
-			a.setAnomalyDetail("unadvertised", source, ad)
-		}
-		for _, ad := range dup {
-			a.setAnomalyDetail("duplicate", source, ad)
-		}
+	over := detection.GetSuperfluousEntries()
+	under := detection.GetMissingEntries()
+	dup := detection.GetDuplicateEntries()
+	misconfig := detection.GetMisconfiguredEntries()
+
+	a.logger.WithAttrs(map[string]interface{}{
+		"source":               source,
+		"overadvertised_count": len(over),
+		"unadvertised_count":   len(under),
+		"duplicate_count":      len(dup),
+		"misconfigured_count":  len(misconfig),
+	}).Debug("Counted anomalies for source")
+
+	for _, ad := range over {
+		a.setAnomalyDetail("overadvertised", source, ad)
+	}
+	for _, ad := range under {
+		a.setAnomalyDetail("unadvertised", source, ad)
+	}
+	for _, ad := range dup {
+		a.setAnomalyDetail("duplicate", source, ad)
+	}
+	for _, ad := range misconfig {
+		a.setAnomalyDetail("misconfigured", source, ad)
+	}
 
-		if detection.GetHasMisconfiguredPrefixes() {
-			totalMisconfig++
+	if slices.Contains(ospfChecks, source) {
+		counts["frr_mad_ospf_overadvertised_routes_total"] += len(over)
+		counts["frr_mad_ospf_unadvertised_routes_total"] += len(under)
+		counts["frr_mad_ospf_duplicate_routes_total"] += len(dup)
+		if len(misconfig) > 0 {
+			counts["frr_mad_ospf_misconfigured_routes_total"] += len(misconfig)
+		} else if detection.GetHasMisconfiguredPrefixes() {
+			counts["frr_mad_ospf_misconfigured_routes_total"]++
 		}
 	}
+	if counter, ok := checkCounters[source]; ok {
+		counts[counter] += len(over) + len(under) + len(dup) + len(misconfig)
+	}
+}
 
-	a.logger.Debug("Starting processing of OSPF sources")
-	processSource("RouterAnomaly", a.anomalies.RouterAnomaly)
-	processSource("ExternalAnomaly", a.anomalies.ExternalAnomaly)
-	processSource("NssaExternalAnomaly", a.anomalies.NssaExternalAnomaly)
+func (a *AnomalyExporter) setAnomalyFlags(source string, detection *frrProto.AnomalyDetection) {
+	a.anomalyFlags.WithLabelValues(source, "overadvertised").Set(boolToFloat(detection.GetHasOverAdvertisedPrefixes()))
+	a.anomalyFlags.WithLabelValues(source, "unadvertised").Set(boolToFloat(detection.GetHasUnAdvertisedPrefixes()))
+	a.anomalyFlags.WithLabelValues(source, "duplicate").Set(boolToFloat(detection.GetHasDuplicatePrefixes()))
+	a.anomalyFlags.WithLabelValues(source, "misconfigured").Set(boolToFloat(detection.GetHasMisconfiguredPrefixes()))
+}
 
-	a.logger.WithAttrs(map[string]interface{}{
-		"total_overadvertised": totalOver,
-		"total_unadvertised":   totalUnder,
-		"total_duplicate":      totalDup,
-		"total_misconfigured":  totalMisconfig,
-	}).Debug("Finished processing OSPF sources")
-
-	a.alertCounters["frr_mad_ospf_overadvertised_routes_total"].Set(float64(totalOver))
-	a.alertCounters["frr_mad_ospf_unadvertised_routes_total"].Set(float64(totalUnder))
-	a.alertCounters["frr_mad_ospf_duplicate_routes_total"].Set(float64(totalDup))
-	a.alertCounters["frr_mad_ospf_misconfigured_routes_total"].Set(float64(totalMisconfig))
+// ospfChecks are the built-in checks counted by the frr_mad_ospf_*_routes_total counters.
+var ospfChecks = []string{"RouterAnomaly", "ExternalAnomaly", "NssaExternalAnomaly", "SummaryAnomaly", "AsbrSummaryAnomaly"}
+
+// checkCounters are the counters of all findings of a built-in check. No check compares the RIB
+// with the FIB yet, frr_mad_rib_to_fib_anomalies_total stays 0.
+var checkCounters = map[string]string{
+	"LsdbToRib":     "frr_mad_lsdb_to_rib_anomalies_total",
+	"Ecmp":          "frr_mad_ecmp_anomalies_total",
+	"IntentAnomaly": "frr_mad_intent_violations_total",
 }
 
 func (a *AnomalyExporter) setAnomalyDetail(anomalyType, source string, ad *frrProto.Advertisement) {
@@ -280,12 +347,24 @@ func (a *AnomalyExporter) setAnomalyDetail(anomalyType, source string, ad *frrPr
 		"link_type":         ad.GetLinkType(),
 		"p_bit":             boolToString(ad.GetPBit()),
 		"options":           ad.GetOptions(),
+		"area":              areaLabel(ad),
+		"severity":          ad.GetSeverity(),
+		"suppressed":        boolToString(ad.GetSuppressed()),
+		"acknowledged":      boolToString(ad.GetAcknowledged()),
+		"reason_code":       ad.GetReasonCode(),
 	}
 
-	key := anomalyType + ":" + source + ":" + ad.GetInterfaceAddress() + ":" + ad.GetLinkStateId()
+	key := detailKey(labels)
 	a.knownLabelSets[key] = labels
 	a.anomalyDetails.With(labels).Set(1)
 
+	if ad.GetSeverity() != "" && !ad.GetSuppressed() && !ad.GetAcknowledged() {
+		a.anomalySeverity.WithLabelValues(ad.GetSeverity()).Inc()
+	}
+	if !ad.GetSuppressed() && !ad.GetAcknowledged() {
+		a.anomalyArea.WithLabelValues(areaLabel(ad), anomalyType).Inc()
+	}
+
 	a.logger.WithAttrs(map[string]interface{}{
 		"key":            key,
 		"anomaly_type":   anomalyType,
@@ -296,6 +375,53 @@ func (a *AnomalyExporter) setAnomalyDetail(anomalyType, source string, ad *frrPr
 	}).Debug("Set anomaly detail metric")
 }
 
+// deleteStaleDetails removes the detail series of the previous update which were not set again.
+// The series are deleted instead of set to 0, as the labels of an anomaly change over its lifetime.
+func (a *AnomalyExporter) deleteStaleDetails(previous map[string]prometheus.Labels) {
+	for key, labels := range previous {
+		if _, exists := a.knownLabelSets[key]; !exists {
+			a.anomalyDetails.Delete(labels)
+		}
+	}
+}
+
+// detailKey identifies a detail series by all of its label values.
+func detailKey(labels prometheus.Labels) string {
+	values := make([]string, 0, len(anomalyDetailLabels))
+	for _, name := range anomalyDetailLabels {
+		values = append(values, labels[name])
+	}
+	return strings.Join(values, "|")
+}
+
+// anomalyDetailLabels are the labels of frr_mad_anomaly_details. Free text like the explanation
+// of the root cause is left out, every distinct value would create a new series.
+var anomalyDetailLabels = []string{
+	"anomaly_type", // overadvertised, unadvertised, duplicate, etc.
+	"source",       // name of the check, e.g. RouterAnomaly, LsdbToRib or Ecmp
+	"interface_address",
+	"link_state_id",
+	"prefix_length",
+	"link_type",
+	"p_bit",
+	"options",
+	"area",         // OSPF area of the anomaly, none for AS external LSAs or the FIB
+	"severity",     // info, warning or critical
+	"suppressed",   // matched by a suppression rule
+	"acknowledged", // acknowledged through the socket
+	"reason_code",  // root cause, e.g. interface_down or denied_by_access_list
+}
+
+var severities = []string{"info", "warning", "critical"}
+
+// areaLabel groups anomalies without an area like the analyzer does.
+func areaLabel(ad *frrProto.Advertisement) string {
+	if ad.GetOspfArea() == "" {
+		return "none"
+	}
+	return ad.GetOspfArea()
+}
+
 func boolToFloat(b bool) float64 {
 	if b {
 		return 1
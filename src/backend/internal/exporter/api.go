@@ -0,0 +1,130 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// CommandProcessor answers requests of the socket protocol, it is implemented by the socket.
+type CommandProcessor interface {
+	ProcessCommand(message *frrProto.Message) *frrProto.Response
+}
+
+// apiEndpoints maps the REST endpoints to the socket requests they return.
+var apiEndpoints = map[string]*frrProto.Message{
+	"/api/v1/hello":               {Service: "system", Command: "hello"},
+	"/api/v1/anomalies":           {Service: "analysis", Command: "areas"},
+	"/api/v1/anomalies/lifecycle": {Service: "analysis", Command: "lifecycle"},
+	"/api/v1/checks":              {Service: "analysis", Command: "checks"},
+	"/api/v1/neighbors":           {Service: "ospf", Command: "neighbors"},
+	"/api/v1/interfaces":          {Service: "ospf", Command: "interfaces"},
+	"/api/v1/lsdb":                {Service: "ospf", Command: "database"},
+	"/api/v1/rib":                 {Service: "frr", Command: "rib"},
+	"/api/v1/config":              {Service: "ospf", Command: "staticConfig"},
+	"/api/v1/should":              {Service: "analysis", Command: "shouldParsedLsdb"},
+}
+
+// API serves the data of the socket as JSON below /api/v1/. The URL query params are passed
+// as message params, so the ospf and frr endpoints support the filters of the socket.
+type API struct {
+	processor  CommandProcessor
+	mux        *http.ServeMux
+	generation atomic.Uint64
+}
+
+func NewAPI(processor CommandProcessor) *API {
+	api := &API{
+		processor: processor,
+		mux:       http.NewServeMux(),
+	}
+	for path, message := range apiEndpoints {
+		api.mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+			api.serve(w, r, message)
+		})
+	}
+	return api
+}
+
+// NextGeneration marks the data as changed, which invalidates the ETags of all responses.
+// The collector and the analyzer call it after each cycle, the socket after an acknowledgement.
+func (api *API) NextGeneration() {
+	api.generation.Add(1)
+}
+
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mux.ServeHTTP(w, r)
+}
+
+func (api *API) serve(w http.ResponseWriter, r *http.Request, endpoint *frrProto.Message) {
+	// the body only changes with the data and the filters of the request
+	hash := fnv.New32a()
+	hash.Write([]byte(r.URL.RawQuery))
+	etag := fmt.Sprintf(`"%d-%08x"`, api.generation.Load(), hash.Sum32())
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	params := map[string]*frrProto.ResponseValue{}
+	for name, values := range r.URL.Query() {
+		params[name] = &frrProto.ResponseValue{
+			Kind: &frrProto.ResponseValue_StringValue{StringValue: values[len(values)-1]},
+		}
+	}
+	if len(params) > 0 && endpoint.Service != "ospf" && endpoint.Service != "frr" {
+		writeAPIError(w, http.StatusBadRequest, "Query parameters are not supported by this endpoint")
+		return
+	}
+
+	response := api.processor.ProcessCommand(&frrProto.Message{
+		Service: endpoint.Service,
+		Command: endpoint.Command,
+		Params:  params,
+	})
+	if response.Status != "success" {
+		writeAPIError(w, http.StatusBadRequest, response.Message)
+		return
+	}
+
+	body, err := marshalResponseData(response.Data)
+	if err != nil {
+		writeAPIError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if response.Page != nil {
+		w.Header().Set("X-Page-Total", strconv.Itoa(int(response.Page.Total)))
+		w.Header().Set("X-Page-Next-Offset", strconv.Itoa(int(response.Page.NextOffset)))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// marshalResponseData returns the protojson of the message set in the response value,
+// without the oneof wrapper of the socket protocol.
+func marshalResponseData(data *frrProto.ResponseValue) ([]byte, error) {
+	message := data.ProtoReflect()
+	field := message.WhichOneof(message.Descriptor().Oneofs().ByName("kind"))
+	if field == nil {
+		return []byte("{}"), nil
+	}
+	if field.Message() == nil {
+		return json.Marshal(message.Get(field).Interface())
+	}
+	return protojson.Marshal(message.Get(field).Message().Interface())
+}
+
+func writeAPIError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -1,6 +1,7 @@
 package exporter
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,12 +13,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+// shutdownTimeout bounds how long in-flight requests are awaited on shutdown
+const shutdownTimeout = 5 * time.Second
+
 type Exporter struct {
 	interval        time.Duration
+	pollIntervals   chan time.Duration
 	anomalyExporter *AnomalyExporter
 	metricExporter  *MetricExporter
-	server          *http.Server
-	stopChan        chan struct{}
+	address         string
+	mux             *http.ServeMux
 	logger          *logger.Logger
 }
 
@@ -60,6 +65,7 @@ func NewExporter(
 				<body>
 					<h1>FRR MAD Exporter</h1>
 					<p><a href="/metrics">Metrics</a></p>
+					<p><a href="/api/v1/anomalies">API</a></p>
 				</body>
 			</html>
 			`)
@@ -67,48 +73,35 @@ func NewExporter(
 
 	e := &Exporter{
 		interval:        pollInterval,
+		pollIntervals:   make(chan time.Duration, 1),
 		anomalyExporter: NewAnomalyExporter(anomalies, registry, logger),
 		metricExporter:  NewMetricExporter(frrData, registry, logger, config),
-		stopChan:        make(chan struct{}),
 		logger:          logger,
-		server: &http.Server{
-			Addr:    fmt.Sprintf(":%d", port),
-			Handler: mux,
-		},
+		address:         fmt.Sprintf(":%d", port),
+		mux:             mux,
 	}
 
 	return e
 }
 
-func (e *Exporter) Start() {
+// Run serves the metrics and exports the data every poll interval until the context is
+// canceled, then the server finishes its in-flight requests. A failing server ends Run.
+func (e *Exporter) Run(ctx context.Context) error {
+	server := &http.Server{
+		Addr:    e.address,
+		Handler: e.mux,
+	}
+
+	serverErr := make(chan error, 1)
 	go func() {
-		if err := e.server.ListenAndServe(); err != nil {
-			e.logger.WithAttrs(map[string]interface{}{
-				"error": err.Error(),
-			}).Error("Metrics server failed")
-		}
+		serverErr <- server.ListenAndServe()
 	}()
 
-	//e.runExportLoop()
-	go e.runExportLoop()
 	e.logger.WithAttrs(map[string]interface{}{
-		"address":  e.server.Addr,
+		"address":  e.address,
 		"interval": e.interval.String(),
 	}).Info("Exporter successfully started")
-}
 
-func (e *Exporter) Stop() {
-	e.logger.Info("Shutting down exporter")
-	close(e.stopChan)
-	if err := e.server.Close(); err != nil {
-		e.logger.WithAttrs(map[string]interface{}{
-			"error": err.Error(),
-		}).Error("Error while shutting down server")
-	}
-	e.logger.Info("Exporter shutdown complete")
-}
-
-func (e *Exporter) runExportLoop() {
 	e.logger.Info("Starting export loop")
 	defer e.logger.Info("Export loop stopped")
 
@@ -117,18 +110,59 @@ func (e *Exporter) runExportLoop() {
 
 	for {
 		select {
+		case interval := <-e.pollIntervals:
+			e.interval = interval
+			ticker.Reset(interval)
+			e.logger.WithAttrs(map[string]interface{}{
+				"interval": interval.String(),
+			}).Info("Changed export interval")
 		case <-ticker.C:
 			start := time.Now()
 			e.exportData()
 			e.logger.WithAttrs(map[string]interface{}{
 				"duration": time.Since(start).String(),
 			}).Debug("Completed export cycle")
-		case <-e.stopChan:
-			return
+		case err := <-serverErr:
+			return fmt.Errorf("metrics server failed: %w", err)
+		case <-ctx.Done():
+			e.logger.Info("Shutting down exporter")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			if err := server.Shutdown(shutdownCtx); err != nil {
+				e.logger.WithAttrs(map[string]interface{}{
+					"error": err.Error(),
+				}).Error("Error while shutting down server")
+			}
+			e.logger.Info("Exporter shutdown complete")
+			return nil
 		}
 	}
 }
 
+// SetPollInterval changes the export interval of a running exporter.
+func (e *Exporter) SetPollInterval(interval time.Duration) {
+	select {
+	case <-e.pollIntervals:
+	default:
+	}
+	select {
+	case e.pollIntervals <- interval:
+	default:
+	}
+}
+
+// Reconfigure applies changed metric toggles, the port is only read by NewExporter.
+func (e *Exporter) Reconfigure(config configs.ExporterConfig) {
+	e.metricExporter.Reconfigure(config)
+}
+
+// MountAPI serves the REST API below /api/v1/ next to the metrics. The API has no access
+// control, every client of the exporter port may read all data of the socket.
+func (e *Exporter) MountAPI(api *API) {
+	e.mux.Handle("/api/v1/", api)
+	e.logger.Warning("REST API mounted at /api/v1/, it is served without access control")
+}
+
 func (e *Exporter) exportData() {
 	e.logger.Debug("Starting data export")
 
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
 	"github.com/prometheus/client_golang/prometheus"
@@ -15,6 +16,7 @@ type MetricExporter struct {
 	data           *frrProto.FullFRRData
 	metrics        map[string]prometheus.Collector
 	enabledMetrics map[string]bool
+	registry       prometheus.Registerer
 	logger         *logger.Logger
 	mutex          sync.RWMutex
 	config         configs.ExporterConfig
@@ -32,6 +34,7 @@ func NewMetricExporter(
 		data:           data,
 		metrics:        make(map[string]prometheus.Collector),
 		enabledMetrics: make(map[string]bool),
+		registry:       registry,
 		logger:         logger,
 		config:         config,
 	}
@@ -56,6 +59,48 @@ func NewMetricExporter(
 	return m
 }
 
+// Reconfigure applies changed metric toggles. Metrics of disabled groups are unregistered,
+// metrics of enabled groups are registered and filled by the next update.
+func (m *MetricExporter) Reconfigure(config configs.ExporterConfig) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	previous := m.metrics
+	m.config = config
+	m.metrics = make(map[string]prometheus.Collector)
+	m.enabledMetrics = make(map[string]bool)
+	m.initializeMetrics()
+
+	for name, metric := range previous {
+		if _, kept := m.metrics[name]; kept {
+			m.metrics[name] = metric
+			continue
+		}
+		m.registry.Unregister(metric)
+	}
+	for name, metric := range m.metrics {
+		if _, kept := previous[name]; kept {
+			continue
+		}
+		if err := m.registry.Register(metric); err != nil {
+			m.logger.WithAttrs(map[string]interface{}{
+				"metric": name,
+				"error":  err.Error(),
+			}).Error("Failed to register metric")
+			delete(m.metrics, name)
+		}
+	}
+
+	enabled := []string{}
+	for k := range m.enabledMetrics {
+		enabled = append(enabled, k)
+	}
+	m.logger.WithAttrs(map[string]interface{}{
+		"enabled_metrics": enabled,
+		"total_metrics":   len(m.metrics),
+	}).Info("Metric exporter reconfigured")
+}
+
 func (m *MetricExporter) initializeMetrics() {
 	// Use a struct to define metric configurations
 	type metricConfig struct {
@@ -223,6 +268,8 @@ func (m *MetricExporter) initializeMetrics() {
 func (m *MetricExporter) Update() {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+	datalock.RLock()
+	defer datalock.RUnlock()
 
 	if m.data == nil {
 		m.logger.Warning("Skipping metric update - no data available")
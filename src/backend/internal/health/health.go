@@ -0,0 +1,237 @@
+package health
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+const (
+	StateOK       = "ok"
+	StateDegraded = "degraded"
+	StateFailed   = "failed"
+
+	ComponentRunning    = "running"
+	ComponentRestarting = "restarting"
+	ComponentStopped    = "stopped"
+)
+
+// staleCycles is the number of poll intervals without a collection or an analysis after
+// which the data of the daemon counts as stale.
+const staleCycles = 3
+
+// Health records the state of the daemon. The supervisor, the collector, the analyzer and
+// the socket report to it, system/status returns it. All methods may be called on a nil
+// Health, which records nothing.
+type Health struct {
+	mutex              sync.Mutex
+	staleAfter         time.Duration
+	startedAt          time.Time
+	components         map[string]*frrProto.ComponentHealth
+	componentOrder     []string
+	commands           map[string]*frrProto.CommandHealth
+	commandOrder       []string
+	lastCollection     time.Time
+	collectionDuration time.Duration
+	lastAnalysis       time.Time
+	analysisDuration   time.Duration
+	parseErrors        uint64
+}
+
+func NewHealth(pollInterval time.Duration) *Health {
+	return &Health{
+		staleAfter: staleCycles * pollInterval,
+		startedAt:  time.Now(),
+		components: map[string]*frrProto.ComponentHealth{},
+		commands:   map[string]*frrProto.CommandHealth{},
+	}
+}
+
+// SetPollInterval adjusts the time without a collection or analysis after which the data is stale.
+func (h *Health) SetPollInterval(pollInterval time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.staleAfter = staleCycles * pollInterval
+}
+
+// ComponentStarted marks a component as running, ComponentFailed as restarting and
+// ComponentStopped as stopped.
+func (h *Health) ComponentStarted(name string) {
+	h.setComponentState(name, ComponentRunning, nil)
+}
+
+func (h *Health) ComponentFailed(name string, err error) {
+	h.setComponentState(name, ComponentRestarting, err)
+}
+
+func (h *Health) ComponentStopped(name string) {
+	h.setComponentState(name, ComponentStopped, nil)
+}
+
+func (h *Health) setComponentState(name, state string, err error) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	component, exists := h.components[name]
+	if !exists {
+		component = &frrProto.ComponentHealth{Name: name}
+		h.components[name] = component
+		h.componentOrder = append(h.componentOrder, name)
+	}
+	if err != nil {
+		component.Restarts++
+		component.LastError = err.Error()
+	}
+	if component.State != state {
+		component.State = state
+		component.Since = time.Now().Unix()
+	}
+}
+
+// CommandSucceeded records a successful run of an FRR command of the collection cycle.
+func (h *Health) CommandSucceeded(name string, duration time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	command := h.command(name)
+	command.LastSuccess = time.Now().Unix()
+	command.DurationMicroseconds = duration.Microseconds()
+	command.LastError = ""
+}
+
+// CommandFailed records a failed run of an FRR command, parseError marks output of FRR which
+// could not be parsed.
+func (h *Health) CommandFailed(name string, err error, parseError bool) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	command := h.command(name)
+	command.Failures++
+	command.LastError = err.Error()
+	if parseError {
+		command.ParseErrors++
+		h.parseErrors++
+	}
+}
+
+func (h *Health) command(name string) *frrProto.CommandHealth {
+	command, exists := h.commands[name]
+	if !exists {
+		command = &frrProto.CommandHealth{Name: name}
+		h.commands[name] = command
+		h.commandOrder = append(h.commandOrder, name)
+	}
+	return command
+}
+
+func (h *Health) CollectionCompleted(duration time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.lastCollection = time.Now()
+	h.collectionDuration = duration
+}
+
+func (h *Health) AnalysisCompleted(duration time.Duration) {
+	if h == nil {
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.lastAnalysis = time.Now()
+	h.analysisDuration = duration
+}
+
+// Status returns a copy of the recorded health. The daemon has failed if a component is
+// restarting or no collection completed for several poll intervals, it is degraded if an
+// FRR command failed in its last run or the analysis is stale. Nil is returned on a nil Health.
+func (h *Health) Status() *frrProto.HealthStatus {
+	if h == nil {
+		return nil
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	status := &frrProto.HealthStatus{
+		StartedAt:                      h.startedAt.Unix(),
+		LastCollection:                 unixOrZero(h.lastCollection),
+		CollectionDurationMicroseconds: h.collectionDuration.Microseconds(),
+		LastAnalysis:                   unixOrZero(h.lastAnalysis),
+		AnalysisDurationMicroseconds:   h.analysisDuration.Microseconds(),
+		ParseErrors:                    h.parseErrors,
+	}
+
+	var failed, degraded []string
+	for _, name := range h.componentOrder {
+		component := h.components[name]
+		status.Components = append(status.Components, &frrProto.ComponentHealth{
+			Name:      component.Name,
+			State:     component.State,
+			Since:     component.Since,
+			Restarts:  component.Restarts,
+			LastError: component.LastError,
+		})
+		if component.State == ComponentRestarting {
+			failed = append(failed, fmt.Sprintf("component %s is restarting: %s", name, component.LastError))
+		}
+	}
+	for _, name := range h.commandOrder {
+		command := h.commands[name]
+		status.Commands = append(status.Commands, &frrProto.CommandHealth{
+			Name:                 command.Name,
+			LastSuccess:          command.LastSuccess,
+			DurationMicroseconds: command.DurationMicroseconds,
+			Failures:             command.Failures,
+			ParseErrors:          command.ParseErrors,
+			LastError:            command.LastError,
+		})
+		if command.LastError != "" {
+			degraded = append(degraded, fmt.Sprintf("command %s failed: %s", name, command.LastError))
+		}
+	}
+
+	// the first cycles of a freshly started daemon are not stale yet
+	if time.Since(h.startedAt) > h.staleAfter {
+		if time.Since(h.lastCollection) > h.staleAfter {
+			failed = append(failed, fmt.Sprintf("no collection completed within %s", h.staleAfter))
+		}
+		if time.Since(h.lastAnalysis) > h.staleAfter {
+			degraded = append(degraded, fmt.Sprintf("no analysis completed within %s", h.staleAfter))
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		status.State = StateFailed
+	case len(degraded) > 0:
+		status.State = StateDegraded
+	default:
+		status.State = StateOK
+	}
+	status.Problems = append(failed, degraded...)
+
+	return status
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,329 @@
+package linter
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sort"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+const backboneArea = "0.0.0.0"
+
+// Rule checks the static FRR configuration for a single class of mistakes.
+type Rule struct {
+	ID          string
+	Name        string
+	Severity    string
+	Description string
+	check       func(config *frrProto.StaticFRRConfiguration) []finding
+}
+
+type finding struct {
+	object  string
+	message string
+}
+
+// Rules lists all lint rules in the order they are evaluated.
+var Rules = []Rule{
+	{
+		ID:          "L001",
+		Name:        "route-map-undefined-list",
+		Severity:    SeverityCritical,
+		Description: "A route-map matches an access-list or prefix-list which is not defined",
+		check:       checkRouteMapUndefinedList,
+	},
+	{
+		ID:          "L002",
+		Name:        "unused-list",
+		Severity:    SeverityInfo,
+		Description: "An access-list or prefix-list is not referenced by any route-map",
+		check:       checkUnusedList,
+	},
+	{
+		ID:          "L003",
+		Name:        "overlapping-interface-subnets",
+		Severity:    SeverityWarning,
+		Description: "The subnets of two interfaces overlap",
+		check:       checkOverlappingInterfaceSubnets,
+	},
+	{
+		ID:          "L004",
+		Name:        "undeclared-area-type",
+		Severity:    SeverityInfo,
+		Description: "An interface is in a non-backbone area without an area statement in router ospf",
+		check:       checkUndeclaredAreaType,
+	},
+	{
+		ID:          "L005",
+		Name:        "redistribute-without-route-map",
+		Severity:    SeverityWarning,
+		Description: "Routes are redistributed into OSPF without a route-map",
+		check:       checkRedistributeWithoutRouteMap,
+	},
+	{
+		ID:          "L006",
+		Name:        "ospf-area-without-address",
+		Severity:    SeverityWarning,
+		Description: "ip ospf area is configured on an interface without an ip address",
+		check:       checkOspfAreaWithoutAddress,
+	},
+	{
+		ID:          "L007",
+		Name:        "passive-only-area",
+		Severity:    SeverityWarning,
+		Description: "All interfaces of an area are passive, no adjacency can be formed in the area",
+		check:       checkPassiveOnlyArea,
+	},
+}
+
+// Lint evaluates all rules against the static FRR configuration.
+func Lint(config *frrProto.StaticFRRConfiguration) *frrProto.LintResult {
+	result := &frrProto.LintResult{Findings: []*frrProto.LintFinding{}}
+	if config == nil {
+		return result
+	}
+
+	for _, rule := range Rules {
+		for _, f := range rule.check(config) {
+			result.Findings = append(result.Findings, &frrProto.LintFinding{
+				RuleId:   rule.ID,
+				RuleName: rule.Name,
+				Severity: rule.Severity,
+				Object:   f.object,
+				Message:  f.message,
+			})
+		}
+	}
+
+	return result
+}
+
+func checkRouteMapUndefinedList(config *frrProto.StaticFRRConfiguration) []finding {
+	var result []finding
+	for _, name := range sortedKeys(config.RouteMap) {
+		routeMap := config.RouteMap[name]
+		if routeMap.AccessList != "" && config.AccessList[routeMap.AccessList] == nil {
+			result = append(result, finding{
+				object:  "route-map " + name,
+				message: fmt.Sprintf("matches access-list %s, which is not defined", routeMap.AccessList),
+			})
+		}
+		if routeMap.PrefixList != "" && config.PrefixList[routeMap.PrefixList] == nil {
+			result = append(result, finding{
+				object:  "route-map " + name,
+				message: fmt.Sprintf("matches prefix-list %s, which is not defined", routeMap.PrefixList),
+			})
+		}
+	}
+	return result
+}
+
+func checkUnusedList(config *frrProto.StaticFRRConfiguration) []finding {
+	usedAccessLists := map[string]bool{}
+	usedPrefixLists := map[string]bool{}
+	for _, routeMap := range config.RouteMap {
+		usedAccessLists[routeMap.AccessList] = true
+		usedPrefixLists[routeMap.PrefixList] = true
+	}
+
+	var result []finding
+	for _, name := range sortedKeys(config.AccessList) {
+		if !usedAccessLists[name] {
+			result = append(result, finding{
+				object:  "access-list " + name,
+				message: "is not referenced by any route-map",
+			})
+		}
+	}
+	for _, name := range sortedKeys(config.PrefixList) {
+		if !usedPrefixLists[name] {
+			result = append(result, finding{
+				object:  "prefix-list " + name,
+				message: "is not referenced by any route-map",
+			})
+		}
+	}
+	return result
+}
+
+func checkOverlappingInterfaceSubnets(config *frrProto.StaticFRRConfiguration) []finding {
+	type subnet struct {
+		iface   string
+		prefix  string
+		network *net.IPNet
+	}
+
+	var subnets []subnet
+	for _, iface := range config.Interfaces {
+		for _, interfacePrefix := range iface.InterfaceIpPrefixes {
+			if interfacePrefix.HasPeer || interfacePrefix.IpPrefix == nil {
+				continue
+			}
+			prefix := fmt.Sprintf("%s/%d", interfacePrefix.IpPrefix.IpAddress, interfacePrefix.IpPrefix.PrefixLength)
+			_, network, err := net.ParseCIDR(prefix)
+			if err != nil {
+				continue
+			}
+			subnets = append(subnets, subnet{iface: iface.Name, prefix: prefix, network: network})
+		}
+	}
+
+	var result []finding
+	for i := range subnets {
+		for j := i + 1; j < len(subnets); j++ {
+			if subnets[i].iface == subnets[j].iface {
+				continue
+			}
+			if subnets[i].network.Contains(subnets[j].network.IP) || subnets[j].network.Contains(subnets[i].network.IP) {
+				result = append(result, finding{
+					object: "interface " + subnets[i].iface,
+					message: fmt.Sprintf("%s overlaps with %s on interface %s",
+						subnets[i].prefix, subnets[j].prefix, subnets[j].iface),
+				})
+			}
+		}
+	}
+	return result
+}
+
+func checkUndeclaredAreaType(config *frrProto.StaticFRRConfiguration) []finding {
+	declared := map[string]bool{}
+	for _, area := range config.GetOspfConfig().GetArea() {
+		declared[area.Name] = true
+	}
+
+	var result []finding
+	for _, iface := range config.Interfaces {
+		if iface.Area == "" || iface.Area == backboneArea || declared[iface.Area] {
+			continue
+		}
+		result = append(result, finding{
+			object:  "interface " + iface.Name,
+			message: fmt.Sprintf("is in area %s, whose type is not declared in router ospf and defaults to a normal area", iface.Area),
+		})
+	}
+	return result
+}
+
+func checkRedistributeWithoutRouteMap(config *frrProto.StaticFRRConfiguration) []finding {
+	var result []finding
+	for _, redistribution := range config.GetOspfConfig().GetRedistribution() {
+		if redistribution.RouteMap != "" {
+			continue
+		}
+		result = append(result, finding{
+			object:  "router ospf",
+			message: fmt.Sprintf("redistribute %s without a route-map redistributes all %s routes", redistribution.Type, redistribution.Type),
+		})
+	}
+	return result
+}
+
+func checkOspfAreaWithoutAddress(config *frrProto.StaticFRRConfiguration) []finding {
+	var result []finding
+	for _, iface := range config.Interfaces {
+		if iface.Area == "" || len(iface.InterfaceIpPrefixes) > 0 {
+			continue
+		}
+		result = append(result, finding{
+			object:  "interface " + iface.Name,
+			message: fmt.Sprintf("has ip ospf area %s but no ip address", iface.Area),
+		})
+	}
+	return result
+}
+
+func checkPassiveOnlyArea(config *frrProto.StaticFRRConfiguration) []finding {
+	members := map[string][]string{}
+	active := map[string]bool{}
+	for _, iface := range config.Interfaces {
+		for _, interfacePrefix := range iface.InterfaceIpPrefixes {
+			if !interfacePrefix.Ospf {
+				continue
+			}
+			members[interfacePrefix.OspfArea] = append(members[interfacePrefix.OspfArea], iface.Name)
+			if !interfacePrefix.Passive {
+				active[interfacePrefix.OspfArea] = true
+			}
+		}
+	}
+
+	var result []finding
+	for _, area := range sortedKeys(members) {
+		if active[area] {
+			continue
+		}
+		result = append(result, finding{
+			object:  "area " + area,
+			message: fmt.Sprintf("has only passive members (%s)", joinUnique(members[area])),
+		})
+	}
+	return result
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinUnique(values []string) string {
+	seen := map[string]bool{}
+	result := ""
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		if result != "" {
+			result += ", "
+		}
+		result += value
+	}
+	return result
+}
+
+// WriteText prints one line per finding, e.g. "L005 warning router ospf: redistribute bgp ...".
+func WriteText(w io.Writer, result *frrProto.LintResult) error {
+	for _, f := range result.GetFindings() {
+		if _, err := fmt.Fprintf(w, "%s %-8s %s: %s [%s]\n", f.RuleId, f.Severity, f.Object, f.Message, f.RuleName); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d findings\n", len(result.GetFindings()))
+	return err
+}
+
+// ExceedsSeverity reports whether a finding is at least as severe as the given severity.
+func ExceedsSeverity(result *frrProto.LintResult, severity string) bool {
+	for _, f := range result.GetFindings() {
+		if severityRank(f.Severity) >= severityRank(severity) {
+			return true
+		}
+	}
+	return false
+}
+
+func severityRank(severity string) int {
+	switch severity {
+	case SeverityInfo:
+		return 1
+	case SeverityWarning:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,227 @@
+package socket
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/logger"
+)
+
+type role int
+
+const (
+	roleReadOnly role = iota
+	roleOperator
+	roleAdmin
+)
+
+var roleNames = map[string]role{
+	"readonly": roleReadOnly,
+	"operator": roleOperator,
+	"admin":    roleAdmin,
+}
+
+func (r role) String() string {
+	for name, value := range roleNames {
+		if value == r {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// commandRoles lists the commands which change the state of the daemon, all other commands
+// only need the readonly role.
+var commandRoles = map[string]role{
+	"system/exit":          roleAdmin,
+	"system/reload":        roleAdmin,
+	"analysis/acknowledge": roleOperator,
+}
+
+// peer is the process on the other end of a connection, as reported by SO_PEERCRED.
+// Peers without credentials, e.g. TCP clients, are unknown.
+type peer struct {
+	known bool
+	uid   uint32
+	gid   uint32
+	pid   int32
+}
+
+func (p peer) attrs() map[string]interface{} {
+	if !p.known {
+		return map[string]interface{}{"peer": "unknown"}
+	}
+	return map[string]interface{}{
+		"uid": p.uid,
+		"gid": p.gid,
+		"pid": p.pid,
+	}
+}
+
+type accessPolicy struct {
+	defaultRole role
+	users       map[uint32]role
+	groups      map[uint32]role
+}
+
+// newAccessPolicy resolves the users and groups of the config. Invalid rules are logged and
+// skipped, an invalid default role falls back to readonly.
+func newAccessPolicy(config configs.AccessConfig, logger *logger.Logger) *accessPolicy {
+	policy := &accessPolicy{
+		defaultRole: roleReadOnly,
+		users:       map[uint32]role{},
+		groups:      map[uint32]role{},
+	}
+
+	if config.DefaultRole != "" {
+		defaultRole, exists := roleNames[config.DefaultRole]
+		if !exists {
+			logger.Error(fmt.Sprintf("Unknown default role %q, falling back to readonly", config.DefaultRole))
+			defaultRole = roleReadOnly
+		}
+		policy.defaultRole = defaultRole
+	}
+
+	for name, roleName := range config.Users {
+		if err := addAccessRule(policy.users, name, roleName, lookupUserID); err != nil {
+			logger.Error(fmt.Sprintf("Skipping access rule of user %s: %s", name, err.Error()))
+		}
+	}
+	for name, roleName := range config.Groups {
+		if err := addAccessRule(policy.groups, name, roleName, lookupGroupID); err != nil {
+			logger.Error(fmt.Sprintf("Skipping access rule of group %s: %s", name, err.Error()))
+		}
+	}
+
+	return policy
+}
+
+func addAccessRule(rules map[uint32]role, name, roleName string, lookup func(string) (string, error)) error {
+	r, exists := roleNames[roleName]
+	if !exists {
+		return fmt.Errorf("unknown role %q", roleName)
+	}
+
+	id, err := strconv.ParseUint(name, 10, 32)
+	if err != nil {
+		resolved, err := lookup(name)
+		if err != nil {
+			return err
+		}
+		id, err = strconv.ParseUint(resolved, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid ID %q of %s: %w", resolved, name, err)
+		}
+	}
+
+	rules[uint32(id)] = r
+	return nil
+}
+
+func lookupUserID(name string) (string, error) {
+	u, err := user.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return u.Uid, nil
+}
+
+func lookupGroupID(name string) (string, error) {
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		return "", err
+	}
+	return g.Gid, nil
+}
+
+// roleOf returns the role of the peer. A rule of the user takes precedence, otherwise root and
+// the user of the daemon are admins. All others get the highest role of their groups with a rule,
+// so a group rule may also lower the default role, and the default role without one.
+func (p *accessPolicy) roleOf(remote peer) role {
+	if !remote.known {
+		return roleReadOnly
+	}
+	if r, exists := p.users[remote.uid]; exists {
+		return r
+	}
+	if remote.uid == 0 || remote.uid == uint32(os.Getuid()) {
+		return roleAdmin
+	}
+
+	result, matched := roleReadOnly, false
+	for _, gid := range peerGroups(remote) {
+		if r, exists := p.groups[gid]; exists && (!matched || r > result) {
+			result, matched = r, true
+		}
+	}
+	if !matched {
+		return p.defaultRole
+	}
+	return result
+}
+
+// peerGroups returns the primary and the supplementary groups of the peer.
+func peerGroups(remote peer) []uint32 {
+	groups := []uint32{remote.gid}
+
+	u, err := user.LookupId(strconv.FormatUint(uint64(remote.uid), 10))
+	if err != nil {
+		return groups
+	}
+	ids, err := u.GroupIds()
+	if err != nil {
+		return groups
+	}
+	for _, id := range ids {
+		if gid, err := strconv.ParseUint(id, 10, 32); err == nil {
+			groups = append(groups, uint32(gid))
+		}
+	}
+	return groups
+}
+
+// authorize checks that the peer may run the command. Denied and granted commands which need
+// more than the readonly role are written to the audit log.
+func (s *Socket) authorize(remote peer, service, command string) error {
+	required, elevated := commandRoles[service+"/"+command]
+	if !elevated {
+		return nil
+	}
+
+	s.mutex.Lock()
+	access := s.access
+	s.mutex.Unlock()
+
+	granted := access.roleOf(remote)
+	attrs := remote.attrs()
+	attrs["service"] = service
+	attrs["command"] = command
+	attrs["role"] = granted.String()
+	attrs["required_role"] = required.String()
+
+	if granted < required {
+		s.auditLogger.WithAttrs(attrs).Warning("Command denied")
+		return fmt.Errorf("Permission denied: %s %s requires the role %s", service, command, required)
+	}
+
+	s.auditLogger.WithAttrs(attrs).Info("Command granted")
+	return nil
+}
+
+// SetAccess replaces the access rules, connected clients are checked against them from their
+// next command on.
+func (s *Socket) SetAccess(config configs.AccessConfig) {
+	access := newAccessPolicy(config, s.logger)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.access = access
+}
+
+// SetAuditLogger sets the logger of denied and granted elevated commands, by default they
+// are written to the application log.
+func (s *Socket) SetAuditLogger(auditLogger *logger.Logger) {
+	s.auditLogger = auditLogger
+}
@@ -1,6 +1,12 @@
 package socket
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/linter"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 )
 
@@ -74,6 +80,88 @@ func (s *Socket) getRibToFibAnomaly() *frrProto.Response {
 	}
 }
 
+func (s *Socket) getAreaAnomalies() *frrProto.Response {
+	value := &frrProto.ResponseValue{
+		Kind: &frrProto.ResponseValue_AreaAnomalies{
+			AreaAnomalies: &frrProto.AreaAnomaliesList{
+				Areas: s.Anomalies.GetAreaAnomalies(),
+			},
+		},
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning Anomalies by Area",
+		Data:    value,
+	}
+}
+
+func (s *Socket) getAnomalyLifecycle() *frrProto.Response {
+	value := &frrProto.ResponseValue{
+		Kind: &frrProto.ResponseValue_AnomalyLifecycle{
+			AnomalyLifecycle: &frrProto.AnomalyLifecycleList{
+				Anomalies: s.Anomalies.Lifecycle,
+			},
+		},
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning Anomaly Lifecycle",
+		Data:    value,
+	}
+}
+
+// acknowledgeAnomaly expects the anomaly id in params["id"], optionally a duration
+// like "2h" in params["duration"] and a comment in params["comment"].
+func (s *Socket) acknowledgeAnomaly(params map[string]*frrProto.ResponseValue) *frrProto.Response {
+	id := params["id"].GetStringValue()
+	ack, err := s.acknowledge(id, params["duration"].GetStringValue(), params["comment"].GetStringValue())
+	if err != nil {
+		return &frrProto.Response{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Acknowledged %s until %s", id, time.Unix(ack.ExpiresAt, 0).Format(time.RFC3339)),
+	}
+}
+
+// acknowledge acknowledges the anomaly for the duration, the default duration if it is empty.
+func (s *Socket) acknowledge(id, duration, comment string) (*frrProto.Acknowledgement, error) {
+	if id == "" {
+		return nil, fmt.Errorf("Missing parameter: id")
+	}
+
+	ackDuration := analyzer.DefaultAcknowledgementDuration
+	if duration != "" {
+		parsed, err := time.ParseDuration(duration)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid duration %q: %s", duration, err.Error())
+		}
+		ackDuration = parsed
+	}
+
+	ack, err := analyzer.AcknowledgeAnomaly(s.Anomalies, id, ackDuration, comment, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithAttrs(map[string]interface{}{
+		"id":         id,
+		"expires_at": time.Unix(ack.ExpiresAt, 0).String(),
+	}).Info("Anomaly acknowledged")
+
+	if s.acknowledgeHandler != nil {
+		s.acknowledgeHandler()
+	}
+
+	return ack, nil
+}
+
 func (s *Socket) getShouldParsedLsdb() *frrProto.Response {
 	value := &frrProto.ResponseValue{
 		Kind: &frrProto.ResponseValue_ParsedAnalyzerData{
@@ -87,3 +175,66 @@ func (s *Socket) getShouldParsedLsdb() *frrProto.Response {
 		Data:    value,
 	}
 }
+
+func (s *Socket) getLintResult() *frrProto.Response {
+	value := &frrProto.ResponseValue{
+		Kind: &frrProto.ResponseValue_LintResult{
+			LintResult: linter.Lint(s.Metrics.GetStaticFrrConfiguration()),
+		},
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning Static Configuration Lint Result",
+		Data:    value,
+	}
+}
+
+func (s *Socket) getCheckResults() *frrProto.Response {
+	value := &frrProto.ResponseValue{
+		Kind: &frrProto.ResponseValue_CheckResults{
+			CheckResults: &frrProto.CheckResultList{
+				CheckResults: s.Anomalies.GetCheckResults(),
+			},
+		},
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning Check Results",
+		Data:    value,
+	}
+}
+
+func (s *Socket) getCheckFindings(params map[string]*frrProto.ResponseValue) *frrProto.Response {
+	name := params["name"].GetStringValue()
+	if name == "" {
+		return &frrProto.Response{
+			Status:  "error",
+			Message: "Missing parameter: name",
+		}
+	}
+
+	for _, result := range s.Anomalies.GetCheckResults() {
+		if !strings.EqualFold(result.Name, name) {
+			continue
+		}
+
+		value := &frrProto.ResponseValue{
+			Kind: &frrProto.ResponseValue_Anomaly{
+				Anomaly: result.Findings,
+			},
+		}
+
+		return &frrProto.Response{
+			Status:  "success",
+			Message: fmt.Sprintf("Returning Findings of Check %s", result.Name),
+			Data:    value,
+		}
+	}
+
+	return &frrProto.Response{
+		Status:  "error",
+		Message: fmt.Sprintf("Unknown check: %s", name),
+	}
+}
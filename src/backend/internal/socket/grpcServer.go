@@ -0,0 +1,436 @@
+package socket
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	grpcPeer "google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const defaultGrpcSocketName = "analyzer-grpc.sock"
+
+// anomalySourceChecks maps the sources of GetAnomalies to the names of the built-in checks.
+var anomalySourceChecks = map[frrProto.AnomalySource]string{
+	frrProto.AnomalySource_ANOMALY_SOURCE_ROUTER:        "RouterAnomaly",
+	frrProto.AnomalySource_ANOMALY_SOURCE_EXTERNAL:      "ExternalAnomaly",
+	frrProto.AnomalySource_ANOMALY_SOURCE_NSSA_EXTERNAL: "NssaExternalAnomaly",
+	frrProto.AnomalySource_ANOMALY_SOURCE_LSDB_TO_RIB:   "LsdbToRib",
+	frrProto.AnomalySource_ANOMALY_SOURCE_SUMMARY:       "SummaryAnomaly",
+	frrProto.AnomalySource_ANOMALY_SOURCE_ASBR_SUMMARY:  "AsbrSummaryAnomaly",
+	frrProto.AnomalySource_ANOMALY_SOURCE_ECMP:          "Ecmp",
+	frrProto.AnomalySource_ANOMALY_SOURCE_INTENT:        "IntentAnomaly",
+}
+
+// grpcMethodCommands maps the RPCs which change the state of the daemon to the socket command
+// whose role they require.
+var grpcMethodCommands = map[string][2]string{
+	frrProto.FrrMad_AcknowledgeAnomaly_FullMethodName: {"analysis", "acknowledge"},
+}
+
+// grpcServer implements the gRPC API on top of ProcessCommand, so both APIs return the
+// same data and apply the same query params.
+type grpcServer struct {
+	frrProto.UnimplementedFrrMadServer
+	socket *Socket
+}
+
+// StartGrpc serves the gRPC API on its unix socket and, if an address is configured, on TCP.
+// It blocks until Close stops the server.
+func (s *Socket) StartGrpc() error {
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(s.maxMessageSize)),
+		grpc.Creds(peerCredentials{}),
+		grpc.UnaryInterceptor(s.authorizeGrpc),
+	)
+	frrProto.RegisterFrrMadServer(server, &grpcServer{socket: s})
+
+	os.Remove(s.grpcSocketPath)
+	unixListener, err := net.Listen("unix", s.grpcSocketPath)
+	if err != nil {
+		return fmt.Errorf("error listening on grpc socket: %w", err)
+	}
+	listeners := []net.Listener{unixListener}
+
+	if s.grpcAddress != "" {
+		tcpListener, err := net.Listen("tcp", s.grpcAddress)
+		if err != nil {
+			unixListener.Close()
+			return fmt.Errorf("error listening on grpc address: %w", err)
+		}
+		listeners = append(listeners, tcpListener)
+	}
+
+	// Close may already have been called while listening
+	s.mutex.Lock()
+	if s.closing {
+		s.mutex.Unlock()
+		for _, listener := range listeners {
+			listener.Close()
+		}
+		os.Remove(s.grpcSocketPath)
+		return nil
+	}
+	s.grpcServer = server
+	s.mutex.Unlock()
+
+	errs := make(chan error, len(listeners))
+	for _, listener := range listeners {
+		s.logger.Info(fmt.Sprintf("gRPC listening on %s", listener.Addr().String()))
+		go func() {
+			errs <- server.Serve(listener)
+		}()
+	}
+
+	// Serve returns nil once the server is stopped
+	for range listeners {
+		if err := <-errs; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			server.Stop()
+			return fmt.Errorf("error serving grpc: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// authorizeGrpc applies the access policy of the socket to the RPCs of grpcMethodCommands.
+func (s *Socket) authorizeGrpc(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if command, exists := grpcMethodCommands[info.FullMethod]; exists {
+		remote := peer{}
+		if p, ok := grpcPeer.FromContext(ctx); ok {
+			if authInfo, ok := p.AuthInfo.(peerAuthInfo); ok {
+				remote = authInfo.peer
+			}
+		}
+		if err := s.authorize(remote, command[0], command[1]); err != nil {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return handler(ctx, request)
+}
+
+// peerCredentials passes the connection through unencrypted and attaches the SO_PEERCRED
+// credentials of unix socket clients as auth info.
+type peerCredentials struct{}
+
+type peerAuthInfo struct {
+	credentials.CommonAuthInfo
+	peer peer
+}
+
+func (peerAuthInfo) AuthType() string {
+	return "peercred"
+}
+
+func (peerCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return conn, peerAuthInfo{
+		CommonAuthInfo: credentials.CommonAuthInfo{SecurityLevel: credentials.NoSecurity},
+		peer:           peerOf(conn),
+	}, nil
+}
+
+func (peerCredentials) ClientHandshake(_ context.Context, _ string, _ net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("peer credentials are only read by the server")
+}
+
+func (peerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: "peercred"}
+}
+
+func (c peerCredentials) Clone() credentials.TransportCredentials {
+	return c
+}
+
+func (peerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// call processes the command like a socket request and sends the page of paginated
+// responses as header metadata.
+func (g *grpcServer) call(ctx context.Context, service, command string, params map[string]*frrProto.ResponseValue) (*frrProto.ResponseValue, error) {
+	response := g.socket.ProcessCommand(&frrProto.Message{
+		Service: service,
+		Command: command,
+		Params:  params,
+	})
+	if response.Status != "success" {
+		return nil, status.Error(codes.InvalidArgument, response.Message)
+	}
+
+	if response.Page != nil {
+		header := metadata.Pairs(
+			"page-total", strconv.Itoa(int(response.Page.Total)),
+			"page-next-offset", strconv.Itoa(int(response.Page.NextOffset)),
+		)
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			return nil, err
+		}
+	}
+
+	return response.Data, nil
+}
+
+// watch registers the stream as subscriber of the topic and sends the current state and
+// afterwards every change. Slow clients skip intermediate states and receive the latest one.
+func (g *grpcServer) watch(ctx context.Context, topic string, params map[string]*frrProto.ResponseValue, send func(*frrProto.ResponseValue) error) error {
+	frames := make(chan []byte, 1)
+	sub := &subscriber{
+		topics: []string{topic},
+		push: func(_ string, frame []byte) error {
+			// frames are pushed under the subscription mutex, so there is a single producer
+			select {
+			case <-frames:
+			default:
+			}
+			frames <- frame
+			return nil
+		},
+		close: func() {},
+	}
+
+	if err := g.socket.addSubscriber(sub); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer g.socket.removeSubscriber(sub)
+
+	g.socket.mutex.Lock()
+	done := g.socket.done
+	g.socket.mutex.Unlock()
+
+	var last []byte
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-done:
+			// the socket is closing, ending the stream lets the server stop gracefully
+			return status.Error(codes.Unavailable, "Server is shutting down")
+		case frame := <-frames:
+			response := &frrProto.Response{}
+			if err := proto.Unmarshal(frame, response); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			response = applyQuery(response, params)
+			if response.Status != "success" {
+				return status.Error(codes.InvalidArgument, response.Message)
+			}
+
+			// changes outside of the queried entries do not reach the client
+			data, err := proto.MarshalOptions{Deterministic: true}.Marshal(response.Data)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if last != nil && bytes.Equal(data, last) {
+				continue
+			}
+			last = data
+
+			if err := send(response.Data); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// grpcQueryParams converts a Query to the string params of the socket protocol.
+func grpcQueryParams(query *frrProto.Query) map[string]*frrProto.ResponseValue {
+	params := map[string]*frrProto.ResponseValue{}
+	set := func(name, value string) {
+		if value != "" {
+			params[name] = &frrProto.ResponseValue{Kind: &frrProto.ResponseValue_StringValue{StringValue: value}}
+		}
+	}
+
+	set(paramArea, query.GetArea())
+	set(paramPrefix, query.GetPrefix())
+	set(paramProtocol, query.GetProtocol())
+	set(paramLsaType, query.GetLsaType())
+	set(paramAdvertisingRouter, query.GetAdvertisingRouter())
+	if query.GetOffset() != 0 {
+		set(paramOffset, strconv.Itoa(int(query.GetOffset())))
+	}
+	if query.GetLimit() != 0 {
+		set(paramLimit, strconv.Itoa(int(query.GetLimit())))
+	}
+	set(paramFields, strings.Join(query.GetFields(), ","))
+
+	return params
+}
+
+func (g *grpcServer) GetHello(ctx context.Context, _ *emptypb.Empty) (*frrProto.Hello, error) {
+	data, err := g.call(ctx, "system", "hello", nil)
+	return data.GetHello(), err
+}
+
+func (g *grpcServer) GetStatus(ctx context.Context, _ *emptypb.Empty) (*frrProto.HealthStatus, error) {
+	data, err := g.call(ctx, "system", "status", nil)
+	return data.GetHealthStatus(), err
+}
+
+func (g *grpcServer) GetSystemMetrics(ctx context.Context, _ *emptypb.Empty) (*frrProto.SystemMetrics, error) {
+	data, err := g.call(ctx, "system", "allResources", nil)
+	return data.GetSystemMetrics(), err
+}
+
+func (g *grpcServer) GetRouterData(ctx context.Context, _ *emptypb.Empty) (*frrProto.FRRRouterData, error) {
+	data, err := g.call(ctx, "frr", "routerData", nil)
+	return data.GetFrrRouterData(), err
+}
+
+func (g *grpcServer) GetRib(ctx context.Context, query *frrProto.Query) (*frrProto.RoutingInformationBase, error) {
+	data, err := g.call(ctx, "frr", "rib", grpcQueryParams(query))
+	return data.GetRoutingInformationBase(), err
+}
+
+func (g *grpcServer) GetRibFibSummary(ctx context.Context, query *frrProto.Query) (*frrProto.RibFibSummaryRoutes, error) {
+	data, err := g.call(ctx, "frr", "ribfibSummary", grpcQueryParams(query))
+	return data.GetRibFibSummaryRoutes(), err
+}
+
+func (g *grpcServer) GetStaticConfiguration(ctx context.Context, query *frrProto.Query) (*frrProto.StaticFRRConfiguration, error) {
+	data, err := g.call(ctx, "ospf", "staticConfig", grpcQueryParams(query))
+	return data.GetStaticFrrConfiguration(), err
+}
+
+func (g *grpcServer) GetOspfDatabase(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFDatabase, error) {
+	data, err := g.call(ctx, "ospf", "database", grpcQueryParams(query))
+	return data.GetOspfDatabase(), err
+}
+
+func (g *grpcServer) GetGeneralOspfInformation(ctx context.Context, query *frrProto.Query) (*frrProto.GeneralOspfInformation, error) {
+	data, err := g.call(ctx, "ospf", "generalInfo", grpcQueryParams(query))
+	return data.GetGeneralOspfInformation(), err
+}
+
+func (g *grpcServer) GetOspfRouterData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFRouterData, error) {
+	data, err := g.call(ctx, "ospf", "router", grpcQueryParams(query))
+	return data.GetOspfRouterData(), err
+}
+
+func (g *grpcServer) GetOspfNetworkData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFNetworkData, error) {
+	data, err := g.call(ctx, "ospf", "network", grpcQueryParams(query))
+	return data.GetOspfNetworkData(), err
+}
+
+func (g *grpcServer) GetOspfSummaryData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFSummaryData, error) {
+	data, err := g.call(ctx, "ospf", "summary", grpcQueryParams(query))
+	return data.GetOspfSummaryData(), err
+}
+
+func (g *grpcServer) GetOspfAsbrSummaryData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFAsbrSummaryData, error) {
+	data, err := g.call(ctx, "ospf", "asbrSummary", grpcQueryParams(query))
+	return data.GetOspfAsbrSummaryData(), err
+}
+
+func (g *grpcServer) GetOspfExternalData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFExternalData, error) {
+	data, err := g.call(ctx, "ospf", "externalData", grpcQueryParams(query))
+	return data.GetOspfExternalData(), err
+}
+
+func (g *grpcServer) GetOspfNssaExternalData(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFNssaExternalData, error) {
+	data, err := g.call(ctx, "ospf", "nssaExternalData", grpcQueryParams(query))
+	return data.GetOspfNssaExternalData(), err
+}
+
+func (g *grpcServer) GetOspfNeighbors(ctx context.Context, query *frrProto.Query) (*frrProto.OSPFNeighbors, error) {
+	data, err := g.call(ctx, "ospf", "neighbors", grpcQueryParams(query))
+	return data.GetOspfNeighbors(), err
+}
+
+func (g *grpcServer) GetInterfaces(ctx context.Context, query *frrProto.Query) (*frrProto.InterfaceList, error) {
+	data, err := g.call(ctx, "ospf", "interfaces", grpcQueryParams(query))
+	return data.GetInterfaces(), err
+}
+
+func (g *grpcServer) GetAnomalies(ctx context.Context, request *frrProto.AnomaliesRequest) (*frrProto.AnomalyDetection, error) {
+	check := request.GetCheck()
+	if check == "" {
+		name, exists := anomalySourceChecks[request.GetSource()]
+		if !exists {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown anomaly source: %s", request.GetSource())
+		}
+		check = name
+	}
+	data, err := g.call(ctx, "analysis", "check", map[string]*frrProto.ResponseValue{
+		"name": {Kind: &frrProto.ResponseValue_StringValue{StringValue: check}},
+	})
+	return data.GetAnomaly(), err
+}
+
+func (g *grpcServer) GetAreaAnomalies(ctx context.Context, _ *emptypb.Empty) (*frrProto.AreaAnomaliesList, error) {
+	data, err := g.call(ctx, "analysis", "areas", nil)
+	return data.GetAreaAnomalies(), err
+}
+
+func (g *grpcServer) GetAnomalyLifecycle(ctx context.Context, _ *emptypb.Empty) (*frrProto.AnomalyLifecycleList, error) {
+	data, err := g.call(ctx, "analysis", "lifecycle", nil)
+	return data.GetAnomalyLifecycle(), err
+}
+
+func (g *grpcServer) AcknowledgeAnomaly(_ context.Context, request *frrProto.AcknowledgeRequest) (*frrProto.Acknowledgement, error) {
+	ack, err := g.socket.acknowledge(request.GetId(), request.GetDuration(), request.GetComment())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return ack, nil
+}
+
+func (g *grpcServer) GetCheckResults(ctx context.Context, _ *emptypb.Empty) (*frrProto.CheckResultList, error) {
+	data, err := g.call(ctx, "analysis", "checks", nil)
+	return data.GetCheckResults(), err
+}
+
+func (g *grpcServer) GetLintResult(ctx context.Context, _ *emptypb.Empty) (*frrProto.LintResult, error) {
+	data, err := g.call(ctx, "analysis", "lint", nil)
+	return data.GetLintResult(), err
+}
+
+func (g *grpcServer) WatchAnomalies(_ *emptypb.Empty, stream grpc.ServerStreamingServer[frrProto.AreaAnomaliesList]) error {
+	return g.watch(stream.Context(), "anomalies", nil, func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetAreaAnomalies())
+	})
+}
+
+func (g *grpcServer) WatchAnomalyLifecycle(_ *emptypb.Empty, stream grpc.ServerStreamingServer[frrProto.AnomalyLifecycleList]) error {
+	return g.watch(stream.Context(), "lifecycle", nil, func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetAnomalyLifecycle())
+	})
+}
+
+func (g *grpcServer) WatchOspfNeighbors(query *frrProto.Query, stream grpc.ServerStreamingServer[frrProto.OSPFNeighbors]) error {
+	return g.watch(stream.Context(), "neighbors", grpcQueryParams(query), func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetOspfNeighbors())
+	})
+}
+
+func (g *grpcServer) WatchInterfaces(query *frrProto.Query, stream grpc.ServerStreamingServer[frrProto.InterfaceList]) error {
+	return g.watch(stream.Context(), "interfaces", grpcQueryParams(query), func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetInterfaces())
+	})
+}
+
+func (g *grpcServer) WatchOspfDatabase(query *frrProto.Query, stream grpc.ServerStreamingServer[frrProto.OSPFDatabase]) error {
+	return g.watch(stream.Context(), "lsdb", grpcQueryParams(query), func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetOspfDatabase())
+	})
+}
+
+func (g *grpcServer) WatchRib(query *frrProto.Query, stream grpc.ServerStreamingServer[frrProto.RoutingInformationBase]) error {
+	return g.watch(stream.Context(), "rib", grpcQueryParams(query), func(data *frrProto.ResponseValue) error {
+		return stream.Send(data.GetRoutingInformationBase())
+	})
+}
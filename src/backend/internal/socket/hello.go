@@ -0,0 +1,52 @@
+package socket
+
+import (
+	"slices"
+	"sort"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+// socketFeatures are provided by every socket, further features depend on the daemon configuration
+var socketFeatures = []string{"query", "subscribe"}
+
+// SetDaemonInfo sets the version and the enabled features of the daemon returned on system/hello.
+func (s *Socket) SetDaemonInfo(version string, features []string) {
+	s.daemonVersion = version
+	s.features = features
+}
+
+func (s *Socket) getHello() *frrProto.Response {
+	services := make([]string, 0, len(serviceCommands))
+	for service := range serviceCommands {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	hello := &frrProto.Hello{
+		ProtocolVersion: int32(frrProto.ProtocolVersion_PROTOCOL_VERSION_CURRENT),
+		DaemonVersion:   s.daemonVersion,
+		Features:        slices.Concat(socketFeatures, s.features),
+	}
+	for _, service := range services {
+		hello.Services = append(hello.Services, &frrProto.ServiceCommands{
+			Service:  service,
+			Commands: serviceCommands[service],
+		})
+	}
+	hello.Services = append(hello.Services, &frrProto.ServiceCommands{
+		Service:  "subscribe",
+		Commands: SubscriptionTopics(),
+	})
+
+	value := &frrProto.ResponseValue{
+		Kind: &frrProto.ResponseValue_Hello{
+			Hello: hello,
+		},
+	}
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning protocol version and capabilities of the daemon",
+		Data:    value,
+	}
+}
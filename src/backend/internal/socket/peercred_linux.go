@@ -0,0 +1,29 @@
+package socket
+
+import (
+	"net"
+	"syscall"
+)
+
+// peerOf reads the credentials of the process connected to a unix socket.
+func peerOf(conn net.Conn) peer {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		return peer{}
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return peer{}
+	}
+
+	var cred *syscall.Ucred
+	var credErr error
+	err = rawConn.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil || credErr != nil {
+		return peer{}
+	}
+
+	return peer{known: true, uid: cred.Uid, gid: cred.Gid, pid: cred.Pid}
+}
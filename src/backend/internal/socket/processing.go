@@ -2,36 +2,60 @@ package socket
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/frr-mad/frr-mad/src/backend/internal/datalock"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
 )
 
+// serviceCommands lists the commands of every service, it is returned to clients on system/hello
+var serviceCommands = map[string][]string{
+	"frr": {"routerData", "rib", "ribfibSummary"},
+	"ospf": {"database", "generalInfo", "router", "network", "networkAll", "summary", "asbrSummary", "externalData",
+		"nssaExternalData", "duplicates", "neighbors", "interfaces", "staticConfig", "peerMap"},
+	"analysis": {"router", "external", "nssaExternal", "lsdbToRib", "ribToFib", "lifecycle", "acknowledge",
+		"lint", "areas", "checks", "check", "shouldParsedLsdb"},
+	"system": {"allResources", "hello", "status", "reload", "exit"},
+}
+
 func (s *Socket) ProcessCommand(message *frrProto.Message) *frrProto.Response {
 	var response frrProto.Response
 
 	switch message.Service {
 	case "frr":
-		return s.frrProcessing(message.Command)
+		return readData(func() *frrProto.Response {
+			return applyQuery(s.frrProcessing(message.Command), message.Params)
+		})
 	case "ospf":
-		return s.ospfProcessing(message.Command)
+		return readData(func() *frrProto.Response {
+			return applyQuery(s.ospfProcessing(message.Command), message.Params)
+		})
 	case "analysis":
-		return s.analysisProcessing(message.Command)
+		// acknowledging writes the analysis result and takes the data lock itself
+		if message.Command == "acknowledge" {
+			return s.acknowledgeAnomaly(message.Params)
+		}
+		return readData(func() *frrProto.Response {
+			return s.analysisProcessing(message.Command, message.Params)
+		})
 	case "system":
 		switch message.Command {
 		case "allResources":
 			return s.getSystemResources()
+		case "hello":
+			return s.getHello()
+		case "status":
+			return s.getStatus()
+		case "reload":
+			return s.requestReload()
 		case "exit":
 			response.Status = "success"
 			response.Message = "Shutting system down"
-			go func() {
-				time.Sleep(100 * time.Millisecond)
-				s.exitSocketServer()
-			}()
+			s.requestShutdown()
 			return &response
 		default:
 			response.Status = "error"
-			response.Message = "There was an error getting system resources"
+			response.Message = fmt.Sprintf("Unknown command: %s", message.Command)
 			return &response
 		}
 	default:
@@ -41,6 +65,14 @@ func (s *Socket) ProcessCommand(message *frrProto.Message) *frrProto.Response {
 	}
 }
 
+// readData builds a response from the collected data or the analysis result. The collector and
+// the analyzer update both in place, so the response is copied while they are locked out.
+func readData(build func() *frrProto.Response) *frrProto.Response {
+	datalock.RLock()
+	defer datalock.RUnlock()
+	return proto.Clone(build()).(*frrProto.Response)
+}
+
 func (s *Socket) frrProcessing(command string) *frrProto.Response {
 	var response frrProto.Response
 	switch command {
@@ -96,7 +128,7 @@ func (s *Socket) ospfProcessing(command string) *frrProto.Response {
 
 }
 
-func (s *Socket) analysisProcessing(command string) *frrProto.Response {
+func (s *Socket) analysisProcessing(command string, params map[string]*frrProto.ResponseValue) *frrProto.Response {
 	var response frrProto.Response
 	switch command {
 	case "router":
@@ -109,6 +141,16 @@ func (s *Socket) analysisProcessing(command string) *frrProto.Response {
 		return s.getLsdbToRibAnomaly()
 	case "ribToFib":
 		return s.getRibToFibAnomaly()
+	case "lifecycle":
+		return s.getAnomalyLifecycle()
+	case "lint":
+		return s.getLintResult()
+	case "areas":
+		return s.getAreaAnomalies()
+	case "checks":
+		return s.getCheckResults()
+	case "check":
+		return s.getCheckFindings(params)
 
 	case "shouldParsedLsdb":
 		return s.getShouldParsedLsdb()
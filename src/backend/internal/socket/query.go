@@ -0,0 +1,317 @@
+package socket
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Params of the ospf and frr commands to filter entries, select their fields and paginate
+const (
+	paramArea              = "area"
+	paramPrefix            = "prefix"
+	paramProtocol          = "protocol"
+	paramLsaType           = "lsaType"
+	paramAdvertisingRouter = "advertisingRouter"
+	paramOffset            = "offset"
+	paramLimit             = "limit"
+	paramFields            = "fields"
+)
+
+var queryParams = []string{paramArea, paramPrefix, paramProtocol, paramLsaType, paramAdvertisingRouter, paramOffset, paramLimit, paramFields}
+
+// lsaTypes are the values of the lsaType param, named like the ospf commands
+var lsaTypes = []string{"router", "network", "summary", "asbrSummary", "external", "nssaExternal"}
+
+// queryEntry is a single row of a response, e.g. an LSA, a route or a neighbor, with the
+// attributes the filters match on.
+type queryEntry struct {
+	key               string
+	area              string
+	lsaType           string
+	advertisingRouter string
+	protocol          string
+	prefixes          []netip.Prefix
+	message           proto.Message
+}
+
+// querySource enumerates the entries of a response. visit calls keep for every entry and
+// removes the entries keep returns false for from the response.
+type querySource struct {
+	filters    []string
+	entryTypes []proto.Message
+	visit      func(keep func(queryEntry) bool)
+}
+
+type query struct {
+	area              string
+	prefix            netip.Prefix
+	protocol          string
+	lsaType           string
+	advertisingRouter string
+	offset            int
+	limit             int
+	fields            [][]string
+}
+
+// applyQuery filters, paginates and masks the entries of a response by the params of the
+// request. Responses of requests without params are returned unchanged.
+func applyQuery(response *frrProto.Response, params map[string]*frrProto.ResponseValue) *frrProto.Response {
+	if len(params) == 0 || response.Status != "success" || response.Data == nil {
+		return response
+	}
+
+	// the response data is shared with the collector, entries are removed from a copy
+	data := proto.Clone(response.Data).(*frrProto.ResponseValue)
+	source := newQuerySource(data)
+
+	q, err := parseQuery(params, source)
+	if err != nil {
+		return &frrProto.Response{
+			Status:  "error",
+			Message: err.Error(),
+		}
+	}
+
+	var matched []queryEntry
+	source.visit(func(entry queryEntry) bool {
+		if q.matches(entry) {
+			matched = append(matched, entry)
+		}
+		return true
+	})
+	sort.Slice(matched, func(i, j int) bool {
+		return lessQueryEntry(matched[i], matched[j])
+	})
+
+	page := &frrProto.Page{
+		Total:  int32(len(matched)),
+		Offset: int32(q.offset),
+		Limit:  int32(q.limit),
+	}
+	entries := matched[min(q.offset, len(matched)):]
+	if q.limit > 0 && len(entries) > q.limit {
+		entries = entries[:q.limit]
+		page.NextOffset = int32(q.offset + q.limit)
+	}
+
+	kept := map[string]bool{}
+	for _, entry := range entries {
+		kept[entry.key] = true
+	}
+	source.visit(func(entry queryEntry) bool {
+		return kept[entry.key]
+	})
+
+	if len(q.fields) > 0 {
+		for _, entry := range entries {
+			applyFieldMask(entry.message.ProtoReflect(), q.fields)
+		}
+	}
+
+	return &frrProto.Response{
+		Status:  response.Status,
+		Message: response.Message,
+		Data:    data,
+		Page:    page,
+	}
+}
+
+func parseQuery(params map[string]*frrProto.ResponseValue, source querySource) (*query, error) {
+	q := &query{}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !slices.Contains(queryParams, name) {
+			return nil, fmt.Errorf("Unknown parameter: %s, expected one of %s", name, strings.Join(queryParams, ", "))
+		}
+		if !slices.Contains(source.filters, name) && (name != paramFields || len(source.entryTypes) == 0) {
+			return nil, fmt.Errorf("Parameter %s is not supported by this command", name)
+		}
+
+		value := strings.TrimSpace(params[name].GetStringValue())
+		if value == "" {
+			return nil, fmt.Errorf("Parameter %s must be a non-empty string", name)
+		}
+
+		switch name {
+		case paramArea:
+			q.area = normalizeArea(value)
+		case paramPrefix:
+			prefix, err := parsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid prefix %q: %s", value, err.Error())
+			}
+			q.prefix = prefix
+		case paramProtocol:
+			q.protocol = value
+		case paramLsaType:
+			if !slices.Contains(lsaTypes, value) {
+				return nil, fmt.Errorf("Unknown lsa type: %s, expected one of %s", value, strings.Join(lsaTypes, ", "))
+			}
+			q.lsaType = value
+		case paramAdvertisingRouter:
+			q.advertisingRouter = value
+		case paramOffset, paramLimit:
+			number, err := strconv.Atoi(value)
+			if err != nil || number < 0 {
+				return nil, fmt.Errorf("Parameter %s must be a non-negative number, got %q", name, value)
+			}
+			if name == paramOffset {
+				q.offset = number
+			} else {
+				q.limit = number
+			}
+		case paramFields:
+			for _, field := range strings.Split(value, ",") {
+				path := strings.Split(strings.TrimSpace(field), ".")
+				if !isValidFieldPath(source.entryTypes, path) {
+					return nil, fmt.Errorf("Unknown field: %s", strings.TrimSpace(field))
+				}
+				q.fields = append(q.fields, path)
+			}
+		}
+	}
+
+	return q, nil
+}
+
+func (q *query) matches(entry queryEntry) bool {
+	if q.area != "" && entry.area != q.area {
+		return false
+	}
+	if q.lsaType != "" && entry.lsaType != q.lsaType {
+		return false
+	}
+	if q.advertisingRouter != "" && entry.advertisingRouter != q.advertisingRouter {
+		return false
+	}
+	if q.protocol != "" && !strings.EqualFold(entry.protocol, q.protocol) {
+		return false
+	}
+	if q.prefix.IsValid() {
+		return slices.ContainsFunc(entry.prefixes, func(prefix netip.Prefix) bool {
+			return q.prefix.Bits() <= prefix.Bits() && q.prefix.Contains(prefix.Addr())
+		})
+	}
+	return true
+}
+
+// lessQueryEntry orders the entries of a page by area, prefix and key, so pages are stable
+// between requests.
+func lessQueryEntry(a, b queryEntry) bool {
+	if a.area != b.area {
+		return a.area < b.area
+	}
+	if len(a.prefixes) > 0 && len(b.prefixes) > 0 && a.prefixes[0] != b.prefixes[0] {
+		if a.prefixes[0].Addr() != b.prefixes[0].Addr() {
+			return a.prefixes[0].Addr().Less(b.prefixes[0].Addr())
+		}
+		return a.prefixes[0].Bits() < b.prefixes[0].Bits()
+	}
+	return a.key < b.key
+}
+
+// normalizeArea converts decimal area IDs to the dotted notation FRR uses, e.g. 1 to 0.0.0.1
+func normalizeArea(area string) string {
+	number, err := strconv.ParseUint(area, 10, 32)
+	if err != nil {
+		return area
+	}
+	return netip.AddrFrom4([4]byte{byte(number >> 24), byte(number >> 16), byte(number >> 8), byte(number)}).String()
+}
+
+// parsePrefix parses a prefix in CIDR notation or a single address as host prefix.
+func parsePrefix(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+// isValidFieldPath checks that the dotted path, e.g. base.ls_id, names a field of at least
+// one entry type. Fields are named like in the proto definition or by their JSON name.
+func isValidFieldPath(entryTypes []proto.Message, path []string) bool {
+	for _, entryType := range entryTypes {
+		descriptor := entryType.ProtoReflect().Descriptor()
+		for i, name := range path {
+			field := findField(descriptor, name)
+			if field == nil {
+				break
+			}
+			if i == len(path)-1 {
+				return true
+			}
+			if field.Message() == nil || field.IsMap() {
+				break
+			}
+			descriptor = field.Message()
+		}
+	}
+	return false
+}
+
+func findField(descriptor protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := descriptor.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return descriptor.Fields().ByJSONName(name)
+}
+
+// applyFieldMask clears all fields of the message not selected by one of the paths.
+func applyFieldMask(message protoreflect.Message, paths [][]string) {
+	var cleared []protoreflect.FieldDescriptor
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		selected := false
+		var nested [][]string
+		for _, path := range paths {
+			if path[0] != string(field.Name()) && path[0] != field.JSONName() {
+				continue
+			}
+			if len(path) == 1 {
+				selected = true
+			} else {
+				nested = append(nested, path[1:])
+			}
+		}
+
+		switch {
+		case selected:
+		case len(nested) == 0:
+			cleared = append(cleared, field)
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				applyFieldMask(list.Get(i).Message(), nested)
+			}
+		case field.Message() != nil && !field.IsMap():
+			applyFieldMask(value.Message(), nested)
+		}
+		return true
+	})
+
+	for _, field := range cleared {
+		message.Clear(field)
+	}
+}
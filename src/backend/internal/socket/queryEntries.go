@@ -0,0 +1,370 @@
+package socket
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	lsaFilters      = []string{paramArea, paramPrefix, paramLsaType, paramAdvertisingRouter, paramOffset, paramLimit}
+	externalFilters = []string{paramPrefix, paramLsaType, paramAdvertisingRouter, paramOffset, paramLimit}
+)
+
+// newQuerySource returns the entries of the response data. Data without a list of entries,
+// like the static configuration, is a single entry only supporting field selection.
+func newQuerySource(data *frrProto.ResponseValue) querySource {
+	switch kind := data.Kind.(type) {
+	case *frrProto.ResponseValue_OspfDatabase:
+		return querySource{
+			filters: lsaFilters,
+			entryTypes: []proto.Message{&frrProto.RouterDataLSA{}, &frrProto.NetworkDataLSA{}, &frrProto.SummaryDataLSA{},
+				&frrProto.ASBRSummaryLSA{}, &frrProto.NSSAExternalLSAData{}, &frrProto.ASExternalLSA{}},
+			visit: func(keep func(queryEntry) bool) { visitOspfDatabase(kind.OspfDatabase, keep) },
+		}
+	case *frrProto.ResponseValue_GeneralOspfInformation:
+		return querySource{
+			filters:    []string{paramArea, paramOffset, paramLimit},
+			entryTypes: []proto.Message{&frrProto.GeneralInfoOspfArea{}},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.GeneralOspfInformation.GetAreas(), func(areaName string, area *frrProto.GeneralInfoOspfArea) bool {
+					return keep(queryEntry{key: areaName, area: areaName, message: area})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_OspfRouterData:
+		return querySource{
+			filters:    lsaFilters,
+			entryTypes: []proto.Message{&frrProto.OSPFRouterLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				visitRouterStates(kind.OspfRouterData.GetRouterStates(), keep)
+			},
+		}
+	case *frrProto.ResponseValue_OspfNetworkData:
+		return querySource{
+			filters:    lsaFilters,
+			entryTypes: []proto.Message{&frrProto.NetworkLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				visitNetStates(kind.OspfNetworkData.GetNetStates(), keep)
+			},
+		}
+	case *frrProto.ResponseValue_OspfSummaryData:
+		return querySource{
+			filters:    lsaFilters,
+			entryTypes: []proto.Message{&frrProto.NetworkLSA{}, &frrProto.SummaryLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				visitNetStates(kind.OspfSummaryData.GetNetStates(), keep)
+				visitSummaryStates("summary", kind.OspfSummaryData.GetSummaryStates(), keep)
+			},
+		}
+	case *frrProto.ResponseValue_OspfAsbrSummaryData:
+		return querySource{
+			filters:    lsaFilters,
+			entryTypes: []proto.Message{&frrProto.SummaryLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				visitSummaryStates("asbrSummary", kind.OspfAsbrSummaryData.GetAsbrSummaryStates(), keep)
+			},
+		}
+	case *frrProto.ResponseValue_OspfExternalData:
+		return querySource{
+			filters:    externalFilters,
+			entryTypes: []proto.Message{&frrProto.ExternalLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.OspfExternalData.GetAsExternalLinkStates(), func(linkStateId string, lsa *frrProto.ExternalLSA) bool {
+					return keep(queryEntry{
+						key:               linkStateId,
+						lsaType:           "external",
+						advertisingRouter: lsa.AdvertisingRouter,
+						prefixes:          lengthPrefixes(lsa.LinkStateId, lsa.NetworkMask),
+						message:           lsa,
+					})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_OspfNssaExternalData:
+		return querySource{
+			filters:    lsaFilters,
+			entryTypes: []proto.Message{&frrProto.NssaExternalLSA{}},
+			visit: func(keep func(queryEntry) bool) {
+				visitNssaExternalStates(kind.OspfNssaExternalData.GetNssaExternalLinkStates(), keep)
+			},
+		}
+	case *frrProto.ResponseValue_OspfExternalAll:
+		return querySource{
+			filters:    externalFilters,
+			entryTypes: []proto.Message{&frrProto.ASExternalLinkState{}},
+			visit: func(keep func(queryEntry) bool) {
+				externalAll := kind.OspfExternalAll
+				externalAll.AsExternalLinkStates = keepEntries(externalAll.AsExternalLinkStates, func(i int, lsa *frrProto.ASExternalLinkState) bool {
+					return keep(queryEntry{
+						key:               fmt.Sprintf("%s|%d", lsa.LinkStateId, i),
+						lsaType:           "external",
+						advertisingRouter: lsa.AdvertisingRouter,
+						prefixes:          lengthPrefixes(lsa.LinkStateId, lsa.NetworkMask),
+						message:           lsa,
+					})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_OspfNeighbors:
+		return querySource{
+			filters:    []string{paramPrefix, paramOffset, paramLimit},
+			entryTypes: []proto.Message{&frrProto.Neighbor{}},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.OspfNeighbors.GetNeighbors(), func(neighborId string, list *frrProto.NeighborList) bool {
+					list.Neighbors = keepEntries(list.Neighbors, func(i int, neighbor *frrProto.Neighbor) bool {
+						return keep(queryEntry{
+							key:      fmt.Sprintf("%s|%d", neighborId, i),
+							prefixes: addressPrefixes(neighbor.Address),
+							message:  neighbor,
+						})
+					})
+					return len(list.Neighbors) > 0
+				})
+			},
+		}
+	case *frrProto.ResponseValue_Interfaces:
+		return querySource{
+			filters:    []string{paramPrefix, paramOffset, paramLimit},
+			entryTypes: []proto.Message{&frrProto.SingleInterface{}},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.Interfaces.GetInterfaces(), func(name string, iface *frrProto.SingleInterface) bool {
+					var prefixes []netip.Prefix
+					for _, address := range iface.IpAddresses {
+						if prefix, err := parsePrefix(address.Address); err == nil {
+							prefixes = append(prefixes, prefix)
+						}
+					}
+					return keep(queryEntry{key: name, prefixes: prefixes, message: iface})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_PeerInterfaceToAddress:
+		return querySource{
+			filters: []string{paramPrefix, paramOffset, paramLimit},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.PeerInterfaceToAddress.GetPeerInterfaceToAddress(), func(peerAddress string, _ string) bool {
+					return keep(queryEntry{key: peerAddress, prefixes: addressPrefixes(peerAddress)})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_RoutingInformationBase:
+		return querySource{
+			filters:    []string{paramPrefix, paramProtocol, paramOffset, paramLimit},
+			entryTypes: []proto.Message{&frrProto.Route{}},
+			visit: func(keep func(queryEntry) bool) {
+				keepMapEntries(kind.RoutingInformationBase.GetRoutes(), func(prefix string, entry *frrProto.RouteEntry) bool {
+					entry.Routes = keepEntries(entry.Routes, func(i int, route *frrProto.Route) bool {
+						return keep(queryEntry{
+							key:      fmt.Sprintf("%s|%d", prefix, i),
+							protocol: route.Protocol,
+							prefixes: routePrefixes(prefix),
+							message:  route,
+						})
+					})
+					return len(entry.Routes) > 0
+				})
+			},
+		}
+	case *frrProto.ResponseValue_RibFibSummaryRoutes:
+		return querySource{
+			filters:    []string{paramProtocol, paramOffset, paramLimit},
+			entryTypes: []proto.Message{&frrProto.RouteSummary{}},
+			visit: func(keep func(queryEntry) bool) {
+				summary := kind.RibFibSummaryRoutes
+				summary.RouteSummaries = keepEntries(summary.RouteSummaries, func(i int, routeSummary *frrProto.RouteSummary) bool {
+					return keep(queryEntry{key: fmt.Sprintf("%s|%d", routeSummary.Type, i), protocol: routeSummary.Type, message: routeSummary})
+				})
+			},
+		}
+	case *frrProto.ResponseValue_StaticFrrConfiguration:
+		return singleEntrySource(kind.StaticFrrConfiguration)
+	case *frrProto.ResponseValue_FrrRouterData:
+		return singleEntrySource(kind.FrrRouterData)
+	default:
+		return querySource{visit: func(func(queryEntry) bool) {}}
+	}
+}
+
+func singleEntrySource(message proto.Message) querySource {
+	return querySource{
+		entryTypes: []proto.Message{message},
+		visit: func(keep func(queryEntry) bool) {
+			keep(queryEntry{message: message})
+		},
+	}
+}
+
+func visitOspfDatabase(database *frrProto.OSPFDatabase, keep func(queryEntry) bool) {
+	lsaEntry := func(areaName, lsaType string, i int, base *frrProto.BaseLSA, prefixes []netip.Prefix, message proto.Message) queryEntry {
+		return queryEntry{
+			key:               fmt.Sprintf("%s|%s|%d", areaName, lsaType, i),
+			area:              areaName,
+			lsaType:           lsaType,
+			advertisingRouter: base.GetAdvertisedRouter(),
+			prefixes:          prefixes,
+			message:           message,
+		}
+	}
+
+	keepMapEntries(database.GetAreas(), func(areaName string, area *frrProto.OSPFDatabaseArea) bool {
+		area.RouterLinkStates = keepEntries(area.RouterLinkStates, func(i int, lsa *frrProto.RouterDataLSA) bool {
+			return keep(lsaEntry(areaName, "router", i, lsa.Base, addressPrefixes(lsa.GetBase().GetLsId()), lsa))
+		})
+		area.NetworkLinkStates = keepEntries(area.NetworkLinkStates, func(i int, lsa *frrProto.NetworkDataLSA) bool {
+			return keep(lsaEntry(areaName, "network", i, lsa.Base, addressPrefixes(lsa.GetBase().GetLsId()), lsa))
+		})
+		area.SummaryLinkStates = keepEntries(area.SummaryLinkStates, func(i int, lsa *frrProto.SummaryDataLSA) bool {
+			prefixes := routePrefixes(lsa.SummaryAddress)
+			if len(prefixes) == 0 {
+				prefixes = addressPrefixes(lsa.GetBase().GetLsId())
+			}
+			return keep(lsaEntry(areaName, "summary", i, lsa.Base, prefixes, lsa))
+		})
+		area.AsbrSummaryLinkStates = keepEntries(area.AsbrSummaryLinkStates, func(i int, lsa *frrProto.ASBRSummaryLSA) bool {
+			return keep(lsaEntry(areaName, "asbrSummary", i, lsa.Base, addressPrefixes(lsa.GetBase().GetLsId()), lsa))
+		})
+		area.NssaExternalLinkStates = keepEntries(area.NssaExternalLinkStates, func(i int, lsa *frrProto.NSSAExternalLSAData) bool {
+			return keep(lsaEntry(areaName, "nssaExternal", i, lsa.Base, routePrefixes(lsa.Route), lsa))
+		})
+
+		return len(area.RouterLinkStates)+len(area.NetworkLinkStates)+len(area.SummaryLinkStates)+
+			len(area.AsbrSummaryLinkStates)+len(area.NssaExternalLinkStates) > 0
+	})
+
+	// external LSAs are flooded in the whole AS and belong to no area
+	database.AsExternalLinkStates = keepEntries(database.AsExternalLinkStates, func(i int, lsa *frrProto.ASExternalLSA) bool {
+		return keep(lsaEntry("", "external", i, lsa.Base, routePrefixes(lsa.Route), lsa))
+	})
+}
+
+func visitRouterStates(states map[string]*frrProto.OSPFRouterArea, keep func(queryEntry) bool) {
+	keepMapEntries(states, func(areaName string, area *frrProto.OSPFRouterArea) bool {
+		keepMapEntries(area.GetLsaEntries(), func(linkStateId string, lsa *frrProto.OSPFRouterLSA) bool {
+			prefixes := addressPrefixes(lsa.LinkStateId)
+			for _, link := range lsa.RouterLinks {
+				prefixes = append(prefixes, maskPrefixes(link.NetworkAddress, link.NetworkMask)...)
+			}
+			return keep(queryEntry{
+				key:               areaName + "|" + linkStateId,
+				area:              areaName,
+				lsaType:           "router",
+				advertisingRouter: lsa.AdvertisingRouter,
+				prefixes:          prefixes,
+				message:           lsa,
+			})
+		})
+		return len(area.GetLsaEntries()) > 0
+	})
+}
+
+func visitNetStates(states map[string]*frrProto.NetAreaState, keep func(queryEntry) bool) {
+	keepMapEntries(states, func(areaName string, area *frrProto.NetAreaState) bool {
+		keepMapEntries(area.GetLsaEntries(), func(linkStateId string, lsa *frrProto.NetworkLSA) bool {
+			return keep(queryEntry{
+				key:               areaName + "|network|" + linkStateId,
+				area:              areaName,
+				lsaType:           "network",
+				advertisingRouter: lsa.AdvertisingRouter,
+				prefixes:          lengthPrefixes(lsa.LinkStateId, lsa.NetworkMask),
+				message:           lsa,
+			})
+		})
+		return len(area.GetLsaEntries()) > 0
+	})
+}
+
+func visitSummaryStates(lsaType string, states map[string]*frrProto.SummaryAreaState, keep func(queryEntry) bool) {
+	keepMapEntries(states, func(areaName string, area *frrProto.SummaryAreaState) bool {
+		keepMapEntries(area.GetLsaEntries(), func(linkStateId string, lsa *frrProto.SummaryLSA) bool {
+			return keep(queryEntry{
+				key:               areaName + "|" + lsaType + "|" + linkStateId,
+				area:              areaName,
+				lsaType:           lsaType,
+				advertisingRouter: lsa.AdvertisingRouter,
+				prefixes:          lengthPrefixes(lsa.LinkStateId, lsa.NetworkMask),
+				message:           lsa,
+			})
+		})
+		return len(area.GetLsaEntries()) > 0
+	})
+}
+
+func visitNssaExternalStates(states map[string]*frrProto.NssaExternalArea, keep func(queryEntry) bool) {
+	keepMapEntries(states, func(areaName string, area *frrProto.NssaExternalArea) bool {
+		keepMapEntries(area.GetData(), func(linkStateId string, lsa *frrProto.NssaExternalLSA) bool {
+			return keep(queryEntry{
+				key:               areaName + "|" + linkStateId,
+				area:              areaName,
+				lsaType:           "nssaExternal",
+				advertisingRouter: lsa.AdvertisingRouter,
+				prefixes:          lengthPrefixes(lsa.LinkStateId, lsa.NetworkMask),
+				message:           lsa,
+			})
+		})
+		return len(area.GetData()) > 0
+	})
+}
+
+// keepMapEntries removes the entries keep returns false for.
+func keepMapEntries[V any](entries map[string]V, keep func(string, V) bool) {
+	for key, value := range entries {
+		if !keep(key, value) {
+			delete(entries, key)
+		}
+	}
+}
+
+// keepEntries returns the entries keep returns true for, keep gets the original index.
+func keepEntries[T any](entries []T, keep func(int, T) bool) []T {
+	kept := entries[:0]
+	for i, entry := range entries {
+		if keep(i, entry) {
+			kept = append(kept, entry)
+		}
+	}
+	return kept
+}
+
+func addressPrefixes(address string) []netip.Prefix {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return nil
+	}
+	return []netip.Prefix{netip.PrefixFrom(addr, addr.BitLen())}
+}
+
+func routePrefixes(route string) []netip.Prefix {
+	prefix, err := parsePrefix(route)
+	if err != nil {
+		return nil
+	}
+	return []netip.Prefix{prefix}
+}
+
+func lengthPrefixes(address string, prefixLength int32) []netip.Prefix {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return nil
+	}
+	prefix, err := addr.Prefix(int(prefixLength))
+	if err != nil {
+		return nil
+	}
+	return []netip.Prefix{prefix}
+}
+
+func maskPrefixes(address, mask string) []netip.Prefix {
+	ip := net.ParseIP(mask).To4()
+	if address == "" || ip == nil {
+		return nil
+	}
+	ones, bits := net.IPMask(ip).Size()
+	if bits == 0 {
+		return nil
+	}
+	return lengthPrefixes(address, int32(ones))
+}
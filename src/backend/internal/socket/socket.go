@@ -1,27 +1,64 @@
 package socket
 
 import (
+	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 )
 
-var execMutex sync.Mutex
-
-var isRunning bool = true
+const (
+	defaultMaxConnections = 16
+	defaultMaxSubscribers = 16
+	defaultReadTimeout    = 5 * time.Minute
+	defaultWriteTimeout   = 10 * time.Second
+	defaultMaxMessageSize = 10 * 1024 * 1024 // 10 MB
+	defaultDrainTimeout   = 5 * time.Second
+)
 
 type Socket struct {
 	socketPath         string
 	listener           net.Listener
+	grpcSocketPath     string
+	grpcAddress        string
+	grpcServer         *grpc.Server
 	mutex              sync.Mutex
+	connections        map[net.Conn]struct{}
+	workers            chan struct{}
+	subscriptionSlots  chan struct{}
+	readTimeout        time.Duration
+	writeTimeout       time.Duration
+	maxMessageSize     uint32
+	drainTimeout       time.Duration
+	closing            bool
+	done               chan struct{}
+	requests           sync.WaitGroup
+	shutdownHandler    func()
+	reloadHandler      func() (string, error)
+	acknowledgeHandler func()
+	subscriptionMutex  sync.Mutex
+	subscribers        map[*subscriber]struct{}
+	published          map[string][]byte
+	daemonVersion      string
+	features           []string
+	access             *accessPolicy
+	auditLogger        *logger.Logger
+	health             *health.Health
+	accepted           atomic.Uint64
+	rejected           atomic.Uint64
 	Metrics            *frrProto.FullFRRData
 	Anomalies          *frrProto.AnomalyAnalysis
 	p2pMap             *frrProto.PeerInterfaceMap
@@ -30,17 +67,96 @@ type Socket struct {
 }
 
 func NewSocket(config configs.SocketConfig, metrics *frrProto.FullFRRData, analysisResult *frrProto.AnomalyAnalysis, logger *logger.Logger, parsedAnalyzerData *frrProto.ParsedAnalyzerData) *Socket {
-	fmt.Println(config)
+	maxConnections := defaultMaxConnections
+	if config.MaxConnections > 0 {
+		maxConnections = config.MaxConnections
+	}
+	maxSubscribers := defaultMaxSubscribers
+	if config.MaxSubscribers > 0 {
+		maxSubscribers = config.MaxSubscribers
+	}
+	readTimeout := defaultReadTimeout
+	if config.ReadTimeout > 0 {
+		readTimeout = time.Duration(config.ReadTimeout) * time.Second
+	}
+	writeTimeout := defaultWriteTimeout
+	if config.WriteTimeout > 0 {
+		writeTimeout = time.Duration(config.WriteTimeout) * time.Second
+	}
+	grpcSocketName := defaultGrpcSocketName
+	if config.GrpcSocketName != "" {
+		grpcSocketName = config.GrpcSocketName
+	}
+	drainTimeout := defaultDrainTimeout
+	if config.DrainTimeout > 0 {
+		drainTimeout = time.Duration(config.DrainTimeout) * time.Second
+	}
+	maxMessageSize := uint32(defaultMaxMessageSize)
+	if config.MaxMessageSize > 0 {
+		maxMessageSize = uint32(config.MaxMessageSize)
+	}
+
 	return &Socket{
 		socketPath:         fmt.Sprintf("%s/%s", config.UnixSocketLocation, config.UnixSocketName),
+		grpcSocketPath:     fmt.Sprintf("%s/%s", config.UnixSocketLocation, grpcSocketName),
+		grpcAddress:        config.GrpcAddress,
 		mutex:              sync.Mutex{},
+		connections:        map[net.Conn]struct{}{},
+		workers:            make(chan struct{}, maxConnections),
+		subscriptionSlots:  make(chan struct{}, maxSubscribers),
+		readTimeout:        readTimeout,
+		writeTimeout:       writeTimeout,
+		maxMessageSize:     maxMessageSize,
+		drainTimeout:       drainTimeout,
+		done:               make(chan struct{}),
+		subscribers:        map[*subscriber]struct{}{},
+		published:          map[string][]byte{},
 		Metrics:            metrics,
 		Anomalies:          analysisResult,
 		ParsedAnalyzerData: parsedAnalyzerData,
+		access:             newAccessPolicy(config.Access, logger),
+		auditLogger:        logger,
 		logger:             logger,
 	}
 }
 
+// Run serves the socket and the gRPC API until the context is canceled or one of them fails,
+// then it closes the socket and drains the in-flight requests. It may be called again after
+// it returned.
+func (s *Socket) Run(ctx context.Context) error {
+	s.mutex.Lock()
+	if s.closing {
+		s.closing = false
+		s.done = make(chan struct{})
+	}
+	s.mutex.Unlock()
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- s.Start()
+	}()
+	go func() {
+		errs <- s.StartGrpc()
+	}()
+
+	var err error
+	pending := 2
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+		pending--
+		if err == nil {
+			err = errors.New("socket server stopped unexpectedly")
+		}
+	}
+
+	s.Close()
+	for ; pending > 0; pending-- {
+		<-errs
+	}
+	return err
+}
+
 func (s *Socket) Start() error {
 	os.Remove(s.socketPath)
 
@@ -49,16 +165,23 @@ func (s *Socket) Start() error {
 		return fmt.Errorf("error listening on socket: %w", err)
 	}
 
+	// Close may already have been called while listening
+	s.mutex.Lock()
+	if s.closing {
+		s.mutex.Unlock()
+		l.Close()
+		os.Remove(s.socketPath)
+		return nil
+	}
 	s.listener = l
-
-	socketListener = s.listener
+	s.mutex.Unlock()
 
 	s.logger.Info(fmt.Sprintf("Listening on %s", s.socketPath))
 
-	for isRunning {
+	for {
 		conn, err := l.Accept()
 		if err != nil {
-			if !isRunning {
+			if errors.Is(err, net.ErrClosed) {
 				s.logger.Info("Socket server shutting down...")
 				break
 			}
@@ -68,47 +191,131 @@ func (s *Socket) Start() error {
 
 		s.logger.Info(fmt.Sprintf("New client connected: %s", conn.RemoteAddr().String()))
 
-		s.handleConnection(conn)
+		// every client is served by its own goroutine, the pool bounds how many run at once
+		select {
+		case s.workers <- struct{}{}:
+		default:
+			s.logger.WithAttrs(map[string]interface{}{
+				"max_connections": cap(s.workers),
+			}).Warning("Rejecting client, too many connections")
+			s.rejected.Add(1)
+			s.rejectConnection(conn, fmt.Sprintf("Too many connections, at most %d clients are served at once", cap(s.workers)))
+			continue
+		}
+
+		s.accepted.Add(1)
+		go func() {
+			// a subscription gives its worker back early, it is capped separately
+			var released sync.Once
+			releaseWorker := func() {
+				released.Do(func() { <-s.workers })
+			}
+			defer releaseWorker()
+			s.handleConnection(conn, releaseWorker)
+		}()
 	}
 
 	return nil
 }
 
-func (s *Socket) handleConnection(conn net.Conn) {
+// handleConnection serves request frames of a client until it disconnects, idles longer
+// than the read timeout or violates the protocol. releaseWorker frees the slot of the
+// worker pool once the connection turned into a subscription.
+func (s *Socket) handleConnection(conn net.Conn, releaseWorker func()) {
+	s.trackConnection(conn, true)
+	defer s.trackConnection(conn, false)
 	defer conn.Close()
 
+	remote := peerOf(conn)
+
+	for {
+		protoMessage, err := s.readMessage(conn)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				s.logger.Error(err.Error())
+			}
+			return
+		}
+
+		if protoMessage.Service == "subscribe" {
+			if s.serveSubscription(conn, protoMessage, releaseWorker) {
+				return
+			}
+			continue
+		}
+
+		if err := s.authorize(remote, protoMessage.Service, protoMessage.Command); err != nil {
+			if err := s.writeResponse(conn, &frrProto.Response{Status: "error", Message: err.Error()}); err != nil {
+				s.logger.Error(err.Error())
+				return
+			}
+			continue
+		}
+
+		if !s.beginRequest() {
+			return
+		}
+		err = s.writeResponse(conn, s.ProcessCommand(protoMessage))
+		s.requests.Done()
+		if err != nil {
+			s.logger.Error(err.Error())
+			return
+		}
+	}
+}
+
+// readMessage reads a single length-prefixed request. io.EOF marks a client which disconnected
+// or idled past the read timeout between requests.
+func (s *Socket) readMessage(conn net.Conn) (*frrProto.Message, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(s.readTimeout)); err != nil {
+		return nil, fmt.Errorf("Error setting read deadline: %w", err)
+	}
+
 	sizeBuf := make([]byte, 4)
-	_, err := io.ReadFull(conn, sizeBuf)
+	n, err := io.ReadFull(conn, sizeBuf)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error reading message size :%s\n", err.Error()))
-		return
+		if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+			return nil, io.EOF
+		}
+		var netErr net.Error
+		if n == 0 && errors.As(err, &netErr) && netErr.Timeout() {
+			s.logger.Debug("Closing connection idle for longer than the read timeout")
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("Error reading message size: %w", err)
 	}
 
 	messageSize := binary.LittleEndian.Uint32(sizeBuf)
+	if messageSize > s.maxMessageSize {
+		// the oversized payload is not read, so the stream cannot be resynchronized
+		message := fmt.Sprintf("Message of %d bytes exceeds the maximum of %d bytes", messageSize, s.maxMessageSize)
+		_ = s.writeResponse(conn, &frrProto.Response{Status: "error", Message: message})
+		return nil, errors.New(message)
+	}
 
 	messageBuf := make([]byte, messageSize)
 	_, err = io.ReadFull(conn, messageBuf)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error reading message: %s\n", err.Error()))
-		return
+		return nil, fmt.Errorf("Error reading message: %w", err)
 	}
 
 	protoMessage := &frrProto.Message{}
 	err = proto.Unmarshal(messageBuf, protoMessage)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error unmarshaling message: %s\n", err.Error()))
-		return
+		return nil, fmt.Errorf("Error unmarshaling message: %w", err)
 	}
 
-	execMutex.Lock()
-	defer execMutex.Unlock()
-
-	protoResponse := s.ProcessCommand(protoMessage)
+	return protoMessage, nil
+}
 
+func (s *Socket) writeResponse(conn net.Conn, protoResponse *frrProto.Response) error {
 	responseData, err := proto.Marshal(protoResponse)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error marshaling response: %s\n", err.Error()))
-		return
+		return fmt.Errorf("Error marshaling response: %w", err)
+	}
+
+	if err := conn.SetWriteDeadline(time.Now().Add(s.writeTimeout)); err != nil {
+		return fmt.Errorf("Error setting write deadline: %w", err)
 	}
 
 	responseSizeBuf := make([]byte, 4)
@@ -116,34 +323,150 @@ func (s *Socket) handleConnection(conn net.Conn) {
 
 	_, err = conn.Write(responseSizeBuf)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error sending response size: %s\n", err.Error()))
-		return
+		return fmt.Errorf("Error sending response size: %w", err)
 	}
 
 	_, err = conn.Write(responseData)
 	if err != nil {
-		s.logger.Error(fmt.Sprintf("Error sending response: %s\n", err.Error()))
-		return
+		return fmt.Errorf("Error sending response: %w", err)
 	}
+
+	return nil
+}
+
+// rejectConnection answers the first request of the client with the error and closes the
+// connection. The request is read first, as a client which is still writing it would otherwise
+// fail with a broken pipe instead of receiving the error. The read is bounded by the write
+// timeout and runs outside of the accept loop.
+func (s *Socket) rejectConnection(conn net.Conn, message string) {
+	go func() {
+		defer conn.Close()
+
+		if err := conn.SetReadDeadline(time.Now().Add(s.writeTimeout)); err == nil {
+			sizeBuf := make([]byte, 4)
+			if _, err := io.ReadFull(conn, sizeBuf); err == nil {
+				size := min(binary.LittleEndian.Uint32(sizeBuf), s.maxMessageSize)
+				_, _ = io.CopyN(io.Discard, conn, int64(size))
+			}
+		}
+
+		if err := s.writeResponse(conn, &frrProto.Response{Status: "error", Message: message}); err != nil {
+			s.logger.Error(err.Error())
+		}
+	}()
 }
 
+func (s *Socket) trackConnection(conn net.Conn, active bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if active {
+		s.connections[conn] = struct{}{}
+	} else {
+		delete(s.connections, conn)
+	}
+}
+
+// beginRequest registers an in-flight request, Close waits for it. It returns false once the
+// socket is closing.
+func (s *Socket) beginRequest() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closing {
+		return false
+	}
+	s.requests.Add(1)
+	return true
+}
+
+// Close stops accepting clients, waits up to the drain timeout for in-flight requests and
+// closes all connections. It may be called more than once.
 func (s *Socket) Close() {
-	if s.listener != nil {
-		s.listener.Close()
+	s.mutex.Lock()
+	if !s.closing {
+		s.closing = true
+		close(s.done)
+	}
+	listener := s.listener
+	s.listener = nil
+	grpcServer := s.grpcServer
+	s.grpcServer = nil
+	s.mutex.Unlock()
+
+	if listener != nil {
+		listener.Close()
 		os.Remove(s.socketPath)
 	}
+
+	if grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(s.drainTimeout):
+			grpcServer.Stop()
+		}
+		os.Remove(s.grpcSocketPath)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.requests.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-time.After(s.drainTimeout):
+		s.logger.Warning("Closing connections with requests still in flight")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for conn := range s.connections {
+		conn.Close()
+	}
+}
+
+// SetShutdownHandler sets the function the exit command calls to stop the daemon. It is called
+// while the exit request is in flight, so it must not wait for the socket to close. Without a
+// handler the exit command only closes the socket.
+func (s *Socket) SetShutdownHandler(handler func()) {
+	s.shutdownHandler = handler
 }
 
-var socketListener net.Listener
+// SetReloadHandler sets the function the reload command calls to reload the configuration of
+// the daemon. It returns a summary of the applied changes.
+func (s *Socket) SetReloadHandler(handler func() (string, error)) {
+	s.reloadHandler = handler
+}
 
-func (s *Socket) exitSocketServer() {
-	s.logger.Info("Shutting down socket server...")
-	isRunning = false
+// SetAcknowledgeHandler sets the function called after an anomaly was acknowledged, e.g. to
+// invalidate cached responses.
+func (s *Socket) SetAcknowledgeHandler(handler func()) {
+	s.acknowledgeHandler = handler
+}
 
-	if socketListener != nil {
-		socketListener.Close()
+func (s *Socket) requestReload() *frrProto.Response {
+	if s.reloadHandler == nil {
+		return &frrProto.Response{Status: "error", Message: "The daemon does not support reloading"}
 	}
+	summary, err := s.reloadHandler()
+	if err != nil {
+		return &frrProto.Response{Status: "error", Message: err.Error()}
+	}
+	return &frrProto.Response{Status: "success", Message: summary}
+}
 
-	s.logger.Info("Socket server shut down completed")
-	os.Exit(0)
+func (s *Socket) requestShutdown() {
+	s.logger.Info("Shutdown requested by client")
+	if s.shutdownHandler != nil {
+		s.shutdownHandler()
+		return
+	}
+	// Close drains the in-flight requests, so it must not run within the exit request
+	go s.Close()
 }
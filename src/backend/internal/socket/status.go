@@ -0,0 +1,48 @@
+package socket
+
+import (
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+// SetHealth sets the health model returned on system/status.
+func (s *Socket) SetHealth(h *health.Health) {
+	s.health = h
+}
+
+// getStatus returns the health of the daemon together with the clients of the socket.
+func (s *Socket) getStatus() *frrProto.Response {
+	status := s.health.Status()
+	if status == nil {
+		return &frrProto.Response{
+			Status:  "error",
+			Message: "The daemon does not track its health",
+		}
+	}
+
+	status.DaemonVersion = s.daemonVersion
+
+	s.mutex.Lock()
+	connections := len(s.connections)
+	s.mutex.Unlock()
+	s.subscriptionMutex.Lock()
+	subscribers := len(s.subscribers)
+	s.subscriptionMutex.Unlock()
+
+	status.SocketClients = &frrProto.SocketClients{
+		Connections: int32(connections),
+		Subscribers: int32(subscribers),
+		Served:      s.accepted.Load(),
+		Rejected:    s.rejected.Load(),
+	}
+
+	return &frrProto.Response{
+		Status:  "success",
+		Message: "Returning health of the daemon",
+		Data: &frrProto.ResponseValue{
+			Kind: &frrProto.ResponseValue_HealthStatus{
+				HealthStatus: status,
+			},
+		},
+	}
+}
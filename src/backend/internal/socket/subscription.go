@@ -0,0 +1,287 @@
+package socket
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriptionTopics maps every topic to the request whose response is pushed to its subscribers.
+var subscriptionTopics = map[string]*frrProto.Message{
+	"anomalies":  {Service: "analysis", Command: "areas"},
+	"lifecycle":  {Service: "analysis", Command: "lifecycle"},
+	"checks":     {Service: "analysis", Command: "checks"},
+	"neighbors":  {Service: "ospf", Command: "neighbors"},
+	"interfaces": {Service: "ospf", Command: "interfaces"},
+	"lsdb":       {Service: "ospf", Command: "database"},
+	"rib":        {Service: "frr", Command: "rib"},
+}
+
+// subscriber receives the frames of its topics, either over a socket connection or a gRPC stream.
+// Frames are only pushed while holding the subscription mutex, so push must not block on the client.
+type subscriber struct {
+	topics []string
+	push   func(topic string, frame []byte) error
+	close  func()
+}
+
+// frameQueue passes the frames of a socket subscriber to its writer. A slow client skips
+// intermediate states, only the latest frame of every topic is kept until it is written.
+type frameQueue struct {
+	mutex   sync.Mutex
+	pending map[string][]byte
+	order   []string
+	ready   chan struct{}
+	done    chan struct{}
+}
+
+func newFrameQueue() *frameQueue {
+	return &frameQueue{
+		pending: map[string][]byte{},
+		ready:   make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+func (q *frameQueue) push(topic string, frame []byte) error {
+	q.mutex.Lock()
+	if _, exists := q.pending[topic]; !exists {
+		q.order = append(q.order, topic)
+	}
+	q.pending[topic] = frame
+	q.mutex.Unlock()
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// next waits for pending frames and returns them in the order their topics were queued.
+// It returns false once the queue is closed.
+func (q *frameQueue) next() ([][]byte, bool) {
+	select {
+	case <-q.done:
+		return nil, false
+	case <-q.ready:
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	frames := make([][]byte, 0, len(q.order))
+	for _, topic := range q.order {
+		frames = append(frames, q.pending[topic])
+	}
+	q.pending = map[string][]byte{}
+	q.order = nil
+	return frames, true
+}
+
+func (q *frameQueue) close() {
+	close(q.done)
+}
+
+// SubscriptionTopics returns the names of all topics clients can subscribe to.
+func SubscriptionTopics() []string {
+	topics := make([]string, 0, len(subscriptionTopics))
+	for topic := range subscriptionTopics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// serveSubscription turns the connection into a stream of pushed frames. The topics are
+// a comma separated list in the command, e.g. "anomalies,neighbors". It returns false if
+// the subscription was rejected and the connection keeps serving requests. An accepted
+// subscription gives back its slot of the worker pool by calling releaseWorker.
+func (s *Socket) serveSubscription(conn net.Conn, message *frrProto.Message, releaseWorker func()) bool {
+	var topics []string
+	for _, topic := range strings.Split(message.Command, ",") {
+		topic = strings.TrimSpace(topic)
+		if _, exists := subscriptionTopics[topic]; !exists {
+			if err := s.writeResponse(conn, &frrProto.Response{
+				Status:  "error",
+				Message: fmt.Sprintf("Unknown topic: %q, expected one of %s", topic, strings.Join(SubscriptionTopics(), ", ")),
+			}); err != nil {
+				s.logger.Error(err.Error())
+			}
+			return false
+		}
+		topics = append(topics, topic)
+	}
+
+	select {
+	case s.subscriptionSlots <- struct{}{}:
+	default:
+		s.logger.WithAttrs(map[string]interface{}{
+			"max_subscribers": cap(s.subscriptionSlots),
+		}).Warning("Rejecting subscription, too many subscribers")
+		if err := s.writeResponse(conn, &frrProto.Response{
+			Status:  "error",
+			Message: fmt.Sprintf("Too many subscriptions, at most %d clients subscribe at once", cap(s.subscriptionSlots)),
+		}); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return false
+	}
+	defer func() { <-s.subscriptionSlots }()
+	releaseWorker()
+
+	if err := s.writeResponse(conn, &frrProto.Response{
+		Status:  "success",
+		Message: fmt.Sprintf("Subscribed to %s", strings.Join(topics, ", ")),
+	}); err != nil {
+		s.logger.Error(fmt.Sprintf("Error starting subscription: %s", err.Error()))
+		return true
+	}
+
+	// frames are written by a goroutine of the subscriber, so a slow client doesn't hold up
+	// the collection and analysis cycles which publish them
+	queue := newFrameQueue()
+	sub := &subscriber{
+		topics: topics,
+		push:   queue.push,
+		close:  func() { conn.Close() },
+	}
+	writerDone := make(chan struct{})
+	defer func() { <-writerDone }()
+	defer queue.close()
+	go func() {
+		defer close(writerDone)
+		s.writeFrames(conn, queue)
+	}()
+
+	if err := s.addSubscriber(sub); err != nil {
+		s.logger.Error(fmt.Sprintf("Error starting subscription: %s", err.Error()))
+		return true
+	}
+	defer s.removeSubscriber(sub)
+
+	s.logger.WithAttrs(map[string]interface{}{
+		"topics": strings.Join(topics, ","),
+	}).Info("Client subscribed")
+
+	// a subscription has no idle timeout, the stream ends when the client disconnects
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		s.logger.Error(fmt.Sprintf("Error clearing read deadline: %s", err.Error()))
+		return true
+	}
+	_, _ = io.Copy(io.Discard, conn)
+
+	return true
+}
+
+// writeFrames writes the queued frames until the queue is closed. A failed write closes the
+// connection, which ends the subscription.
+func (s *Socket) writeFrames(conn net.Conn, queue *frameQueue) {
+	for {
+		frames, ok := queue.next()
+		if !ok {
+			return
+		}
+		for _, frame := range frames {
+			if err := writeFrame(conn, frame, s.writeTimeout); err != nil {
+				s.logger.Error(fmt.Sprintf("Error pushing frame to subscriber: %s", err.Error()))
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// addSubscriber pushes the current state of all topics to the subscriber and registers it,
+// later frames are only pushed on changes.
+func (s *Socket) addSubscriber(sub *subscriber) error {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+
+	for _, topic := range sub.topics {
+		frame, err := s.topicFrame(topic)
+		if err != nil {
+			return err
+		}
+		if _, exists := s.published[topic]; !exists {
+			s.published[topic] = frame
+		}
+		if err := sub.push(topic, frame); err != nil {
+			return err
+		}
+	}
+
+	s.subscribers[sub] = struct{}{}
+	return nil
+}
+
+func (s *Socket) removeSubscriber(sub *subscriber) {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+	delete(s.subscribers, sub)
+}
+
+// Publish pushes every subscribed topic whose result changed since it was last pushed.
+// The collector and the analyzer call it after each cycle.
+func (s *Socket) Publish() {
+	s.subscriptionMutex.Lock()
+	defer s.subscriptionMutex.Unlock()
+
+	subscribed := map[string][]*subscriber{}
+	for sub := range s.subscribers {
+		for _, topic := range sub.topics {
+			subscribed[topic] = append(subscribed[topic], sub)
+		}
+	}
+
+	for topic, subscribers := range subscribed {
+		frame, err := s.topicFrame(topic)
+		if err != nil {
+			s.logger.Error(fmt.Sprintf("Error building frame of topic %s: %s", topic, err.Error()))
+			continue
+		}
+		if bytes.Equal(frame, s.published[topic]) {
+			continue
+		}
+		s.published[topic] = frame
+
+		for _, sub := range subscribers {
+			if err := sub.push(topic, frame); err != nil {
+				// closing ends the subscription, which removes the subscriber
+				s.logger.Error(fmt.Sprintf("Error pushing topic %s: %s", topic, err.Error()))
+				sub.close()
+			}
+		}
+	}
+}
+
+// topicFrame returns the marshaled response of a topic. Marshaling is deterministic, so
+// equal results yield equal frames.
+func (s *Socket) topicFrame(topic string) ([]byte, error) {
+	response := s.ProcessCommand(subscriptionTopics[topic])
+	response.Topic = topic
+	return proto.MarshalOptions{Deterministic: true}.Marshal(response)
+}
+
+// writeFrame writes a marshaled response with its length prefix.
+func writeFrame(conn net.Conn, frame []byte, writeTimeout time.Duration) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+
+	sizeBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sizeBuf, uint32(len(frame)))
+	if _, err := conn.Write(sizeBuf); err != nil {
+		return err
+	}
+	_, err := conn.Write(frame)
+	return err
+}
@@ -0,0 +1,154 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	"github.com/frr-mad/frr-mad/src/logger"
+)
+
+const (
+	defaultRestartDelay    = time.Second
+	defaultMaxRestartDelay = 30 * time.Second
+	defaultStopTimeout     = 10 * time.Second
+)
+
+// Component is a long running part of the daemon. Run blocks until the context is canceled
+// and returns nil once the component stopped, a return before that counts as failure.
+type Component struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type supervised struct {
+	component Component
+	cancel    context.CancelFunc
+	stopped   chan struct{}
+}
+
+// Supervisor owns the components of the daemon. It starts them in the order they were added,
+// restarts failed ones with a backoff and stops them in reverse order. The state of the
+// components is reported to Health if it is set.
+type Supervisor struct {
+	RestartDelay    time.Duration
+	MaxRestartDelay time.Duration
+	StopTimeout     time.Duration
+	Health          *health.Health
+	components      []*supervised
+	mutex           sync.Mutex
+	logger          *logger.Logger
+}
+
+func NewSupervisor(logger *logger.Logger) *Supervisor {
+	return &Supervisor{
+		RestartDelay:    defaultRestartDelay,
+		MaxRestartDelay: defaultMaxRestartDelay,
+		StopTimeout:     defaultStopTimeout,
+		logger:          logger,
+	}
+}
+
+// Add registers a component, components added later depend on the earlier ones.
+func (s *Supervisor) Add(component Component) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.components = append(s.components, &supervised{component: component})
+}
+
+// Run starts all components and blocks until the context is canceled, then it stops the
+// components in reverse order and waits up to the stop timeout for each of them.
+func (s *Supervisor) Run(ctx context.Context) error {
+	s.mutex.Lock()
+	components := append([]*supervised(nil), s.components...)
+	s.mutex.Unlock()
+
+	if len(components) == 0 {
+		return errors.New("no components to supervise")
+	}
+
+	for _, c := range components {
+		componentCtx, cancel := context.WithCancel(context.Background())
+		c.cancel = cancel
+		c.stopped = make(chan struct{})
+		go s.supervise(componentCtx, c)
+	}
+
+	<-ctx.Done()
+	s.logger.Info("Stopping components")
+
+	var timedOut []string
+	for i := len(components) - 1; i >= 0; i-- {
+		c := components[i]
+		c.cancel()
+		select {
+		case <-c.stopped:
+			s.logger.WithAttrs(map[string]interface{}{
+				"component": c.component.Name,
+			}).Info("Component stopped")
+		case <-time.After(s.StopTimeout):
+			s.logger.WithAttrs(map[string]interface{}{
+				"component": c.component.Name,
+				"timeout":   s.StopTimeout.String(),
+			}).Error("Component did not stop in time")
+			timedOut = append(timedOut, c.component.Name)
+		}
+	}
+
+	if len(timedOut) > 0 {
+		return fmt.Errorf("components did not stop in time: %v", timedOut)
+	}
+	return nil
+}
+
+// supervise runs the component until its context is canceled and restarts it whenever it
+// fails. The restart delay doubles with every failure in a row up to the maximum.
+func (s *Supervisor) supervise(ctx context.Context, c *supervised) {
+	defer close(c.stopped)
+	defer s.Health.ComponentStopped(c.component.Name)
+
+	delay := s.RestartDelay
+	for {
+		started := time.Now()
+		s.Health.ComponentStarted(c.component.Name)
+		err := runComponent(ctx, c.component)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("stopped unexpectedly")
+		}
+
+		// a component which ran longer than the maximum delay is healthy again
+		if time.Since(started) > s.MaxRestartDelay {
+			delay = s.RestartDelay
+		}
+
+		s.logger.WithAttrs(map[string]interface{}{
+			"component":     c.component.Name,
+			"error":         err.Error(),
+			"restart_delay": delay.String(),
+		}).Error("Component failed, restarting")
+		s.Health.ComponentFailed(c.component.Name, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, s.MaxRestartDelay)
+	}
+}
+
+// runComponent turns a panic of the component into an error, so it is restarted like a failure.
+func runComponent(ctx context.Context, component Component) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return component.Run(ctx)
+}
@@ -9,6 +9,7 @@ package pkg
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,128 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProtocolVersion is raised on incompatible changes of the socket protocol. Clients compare
+// PROTOCOL_VERSION_CURRENT with the version the daemon returns on system/hello.
+type ProtocolVersion int32
+
+const (
+	ProtocolVersion_PROTOCOL_VERSION_UNSPECIFIED ProtocolVersion = 0
+	ProtocolVersion_PROTOCOL_VERSION_1           ProtocolVersion = 1
+	ProtocolVersion_PROTOCOL_VERSION_CURRENT     ProtocolVersion = 1
+)
+
+// Enum value maps for ProtocolVersion.
+var (
+	ProtocolVersion_name = map[int32]string{
+		0: "PROTOCOL_VERSION_UNSPECIFIED",
+		1: "PROTOCOL_VERSION_1",
+		// Duplicate value: 1: "PROTOCOL_VERSION_CURRENT",
+	}
+	ProtocolVersion_value = map[string]int32{
+		"PROTOCOL_VERSION_UNSPECIFIED": 0,
+		"PROTOCOL_VERSION_1":           1,
+		"PROTOCOL_VERSION_CURRENT":     1,
+	}
+)
+
+func (x ProtocolVersion) Enum() *ProtocolVersion {
+	p := new(ProtocolVersion)
+	*p = x
+	return p
+}
+
+func (x ProtocolVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtocolVersion) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[0]
+}
+
+func (x ProtocolVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtocolVersion.Descriptor instead.
+func (ProtocolVersion) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{0}
+}
+
+// AnomalySource names the built-in checks for clients of earlier versions.
+type AnomalySource int32
+
+const (
+	AnomalySource_ANOMALY_SOURCE_UNSPECIFIED   AnomalySource = 0
+	AnomalySource_ANOMALY_SOURCE_ROUTER        AnomalySource = 1
+	AnomalySource_ANOMALY_SOURCE_EXTERNAL      AnomalySource = 2
+	AnomalySource_ANOMALY_SOURCE_NSSA_EXTERNAL AnomalySource = 3
+	AnomalySource_ANOMALY_SOURCE_LSDB_TO_RIB   AnomalySource = 4
+	AnomalySource_ANOMALY_SOURCE_RIB_TO_FIB    AnomalySource = 5 // no check, rejected
+	AnomalySource_ANOMALY_SOURCE_SUMMARY       AnomalySource = 6
+	AnomalySource_ANOMALY_SOURCE_ASBR_SUMMARY  AnomalySource = 7
+	AnomalySource_ANOMALY_SOURCE_ECMP          AnomalySource = 8
+	AnomalySource_ANOMALY_SOURCE_INTENT        AnomalySource = 9
+)
+
+// Enum value maps for AnomalySource.
+var (
+	AnomalySource_name = map[int32]string{
+		0: "ANOMALY_SOURCE_UNSPECIFIED",
+		1: "ANOMALY_SOURCE_ROUTER",
+		2: "ANOMALY_SOURCE_EXTERNAL",
+		3: "ANOMALY_SOURCE_NSSA_EXTERNAL",
+		4: "ANOMALY_SOURCE_LSDB_TO_RIB",
+		5: "ANOMALY_SOURCE_RIB_TO_FIB",
+		6: "ANOMALY_SOURCE_SUMMARY",
+		7: "ANOMALY_SOURCE_ASBR_SUMMARY",
+		8: "ANOMALY_SOURCE_ECMP",
+		9: "ANOMALY_SOURCE_INTENT",
+	}
+	AnomalySource_value = map[string]int32{
+		"ANOMALY_SOURCE_UNSPECIFIED":   0,
+		"ANOMALY_SOURCE_ROUTER":        1,
+		"ANOMALY_SOURCE_EXTERNAL":      2,
+		"ANOMALY_SOURCE_NSSA_EXTERNAL": 3,
+		"ANOMALY_SOURCE_LSDB_TO_RIB":   4,
+		"ANOMALY_SOURCE_RIB_TO_FIB":    5,
+		"ANOMALY_SOURCE_SUMMARY":       6,
+		"ANOMALY_SOURCE_ASBR_SUMMARY":  7,
+		"ANOMALY_SOURCE_ECMP":          8,
+		"ANOMALY_SOURCE_INTENT":        9,
+	}
+)
+
+func (x AnomalySource) Enum() *AnomalySource {
+	p := new(AnomalySource)
+	*p = x
+	return p
+}
+
+func (x AnomalySource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalySource) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[1].Descriptor()
+}
+
+func (AnomalySource) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[1]
+}
+
+func (x AnomalySource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalySource.Descriptor instead.
+func (AnomalySource) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{1}
+}
+
 // Message represents the top-level message structure
 type Message struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
@@ -149,6 +272,8 @@ type Response struct {
 	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Data          *ResponseValue         `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Topic         string                 `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"` // set on frames pushed to subscribers
+	Page          *Page                  `protobuf:"bytes,5,opt,name=page,proto3" json:"page,omitempty"`   // set if the request contained filter or pagination params
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,6 +329,89 @@ func (x *Response) GetData() *ResponseValue {
 	return nil
 }
 
+func (x *Response) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Response) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+// Page describes the part of the filtered entries contained in a response
+type Page struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // entries matching the filters
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                             // 0 means no limit
+	NextOffset    int32                  `protobuf:"varint,4,opt,name=next_offset,json=nextOffset,proto3" json:"next_offset,omitempty"` // 0 once the last page is returned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	mi := &file_protocol_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Page) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Page) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Page) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Page) GetNextOffset() int32 {
+	if x != nil {
+		return x.NextOffset
+	}
+	return 0
+}
+
 type PeerInterfaceMap struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	PeerInterfaceToAddress map[string]string      `protobuf:"bytes,1,rep,name=peer_interface_to_address,json=peerInterfaceToAddress,proto3" json:"peer_interface_to_address,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -213,7 +421,7 @@ type PeerInterfaceMap struct {
 
 func (x *PeerInterfaceMap) Reset() {
 	*x = PeerInterfaceMap{}
-	mi := &file_protocol_proto_msgTypes[3]
+	mi := &file_protocol_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -225,7 +433,7 @@ func (x *PeerInterfaceMap) String() string {
 func (*PeerInterfaceMap) ProtoMessage() {}
 
 func (x *PeerInterfaceMap) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[3]
+	mi := &file_protocol_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,7 +446,7 @@ func (x *PeerInterfaceMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerInterfaceMap.ProtoReflect.Descriptor instead.
 func (*PeerInterfaceMap) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{3}
+	return file_protocol_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PeerInterfaceMap) GetPeerInterfaceToAddress() map[string]string {
@@ -274,6 +482,12 @@ type ResponseValue struct {
 	//	*ResponseValue_StaticFrrConfiguration
 	//	*ResponseValue_SystemMetrics
 	//	*ResponseValue_FrrRouterData
+	//	*ResponseValue_AnomalyLifecycle
+	//	*ResponseValue_LintResult
+	//	*ResponseValue_CheckResults
+	//	*ResponseValue_AreaAnomalies
+	//	*ResponseValue_Hello
+	//	*ResponseValue_HealthStatus
 	Kind          isResponseValue_Kind `protobuf_oneof:"kind"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -281,7 +495,7 @@ type ResponseValue struct {
 
 func (x *ResponseValue) Reset() {
 	*x = ResponseValue{}
-	mi := &file_protocol_proto_msgTypes[4]
+	mi := &file_protocol_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +507,7 @@ func (x *ResponseValue) String() string {
 func (*ResponseValue) ProtoMessage() {}
 
 func (x *ResponseValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[4]
+	mi := &file_protocol_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +520,7 @@ func (x *ResponseValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResponseValue.ProtoReflect.Descriptor instead.
 func (*ResponseValue) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4}
+	return file_protocol_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResponseValue) GetKind() isResponseValue_Kind {
@@ -505,6 +719,60 @@ func (x *ResponseValue) GetFrrRouterData() *FRRRouterData {
 	return nil
 }
 
+func (x *ResponseValue) GetAnomalyLifecycle() *AnomalyLifecycleList {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_AnomalyLifecycle); ok {
+			return x.AnomalyLifecycle
+		}
+	}
+	return nil
+}
+
+func (x *ResponseValue) GetLintResult() *LintResult {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_LintResult); ok {
+			return x.LintResult
+		}
+	}
+	return nil
+}
+
+func (x *ResponseValue) GetCheckResults() *CheckResultList {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_CheckResults); ok {
+			return x.CheckResults
+		}
+	}
+	return nil
+}
+
+func (x *ResponseValue) GetAreaAnomalies() *AreaAnomaliesList {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_AreaAnomalies); ok {
+			return x.AreaAnomalies
+		}
+	}
+	return nil
+}
+
+func (x *ResponseValue) GetHello() *Hello {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_Hello); ok {
+			return x.Hello
+		}
+	}
+	return nil
+}
+
+func (x *ResponseValue) GetHealthStatus() *HealthStatus {
+	if x != nil {
+		if x, ok := x.Kind.(*ResponseValue_HealthStatus); ok {
+			return x.HealthStatus
+		}
+	}
+	return nil
+}
+
 type isResponseValue_Kind interface {
 	isResponseValue_Kind()
 }
@@ -597,6 +865,30 @@ type ResponseValue_FrrRouterData struct {
 	FrrRouterData *FRRRouterData `protobuf:"bytes,21,opt,name=frr_router_data,json=frrRouterData,proto3,oneof"`
 }
 
+type ResponseValue_AnomalyLifecycle struct {
+	AnomalyLifecycle *AnomalyLifecycleList `protobuf:"bytes,22,opt,name=anomaly_lifecycle,json=anomalyLifecycle,proto3,oneof"`
+}
+
+type ResponseValue_LintResult struct {
+	LintResult *LintResult `protobuf:"bytes,23,opt,name=lint_result,json=lintResult,proto3,oneof"`
+}
+
+type ResponseValue_CheckResults struct {
+	CheckResults *CheckResultList `protobuf:"bytes,24,opt,name=check_results,json=checkResults,proto3,oneof"`
+}
+
+type ResponseValue_AreaAnomalies struct {
+	AreaAnomalies *AreaAnomaliesList `protobuf:"bytes,25,opt,name=area_anomalies,json=areaAnomalies,proto3,oneof"`
+}
+
+type ResponseValue_Hello struct {
+	Hello *Hello `protobuf:"bytes,26,opt,name=hello,proto3,oneof"`
+}
+
+type ResponseValue_HealthStatus struct {
+	HealthStatus *HealthStatus `protobuf:"bytes,27,opt,name=health_status,json=healthStatus,proto3,oneof"`
+}
+
 func (*ResponseValue_StringValue) isResponseValue_Kind() {}
 
 func (*ResponseValue_ParsedAnalyzerData) isResponseValue_Kind() {}
@@ -639,30 +931,44 @@ func (*ResponseValue_SystemMetrics) isResponseValue_Kind() {}
 
 func (*ResponseValue_FrrRouterData) isResponseValue_Kind() {}
 
-type NetworkConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RouterId      string                 `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	Areas         []*OSPFArea            `protobuf:"bytes,2,rep,name=areas,proto3" json:"areas,omitempty"`
-	Interfaces    []*OSPFInterfaceConfig `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (*ResponseValue_AnomalyLifecycle) isResponseValue_Kind() {}
+
+func (*ResponseValue_LintResult) isResponseValue_Kind() {}
+
+func (*ResponseValue_CheckResults) isResponseValue_Kind() {}
+
+func (*ResponseValue_AreaAnomalies) isResponseValue_Kind() {}
+
+func (*ResponseValue_Hello) isResponseValue_Kind() {}
+
+func (*ResponseValue_HealthStatus) isResponseValue_Kind() {}
+
+// Hello describes the daemon to clients at the start of a session
+type Hello struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion int32                  `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	DaemonVersion   string                 `protobuf:"bytes,2,opt,name=daemon_version,json=daemonVersion,proto3" json:"daemon_version,omitempty"`
+	Services        []*ServiceCommands     `protobuf:"bytes,3,rep,name=services,proto3" json:"services,omitempty"`
+	Features        []string               `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *NetworkConfig) Reset() {
-	*x = NetworkConfig{}
-	mi := &file_protocol_proto_msgTypes[5]
+func (x *Hello) Reset() {
+	*x = Hello{}
+	mi := &file_protocol_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkConfig) String() string {
+func (x *Hello) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkConfig) ProtoMessage() {}
+func (*Hello) ProtoMessage() {}
 
-func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[5]
+func (x *Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,55 +979,62 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
-func (*NetworkConfig) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
+func (*Hello) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *NetworkConfig) GetRouterId() string {
+func (x *Hello) GetProtocolVersion() int32 {
 	if x != nil {
-		return x.RouterId
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Hello) GetDaemonVersion() string {
+	if x != nil {
+		return x.DaemonVersion
 	}
 	return ""
 }
 
-func (x *NetworkConfig) GetAreas() []*OSPFArea {
+func (x *Hello) GetServices() []*ServiceCommands {
 	if x != nil {
-		return x.Areas
+		return x.Services
 	}
 	return nil
 }
 
-func (x *NetworkConfig) GetInterfaces() []*OSPFInterfaceConfig {
+func (x *Hello) GetFeatures() []string {
 	if x != nil {
-		return x.Interfaces
+		return x.Features
 	}
 	return nil
 }
 
-type OSPFArea struct {
+type ServiceCommands struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Commands      []string               `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OSPFArea) Reset() {
-	*x = OSPFArea{}
-	mi := &file_protocol_proto_msgTypes[6]
+func (x *ServiceCommands) Reset() {
+	*x = ServiceCommands{}
+	mi := &file_protocol_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OSPFArea) String() string {
+func (x *ServiceCommands) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSPFArea) ProtoMessage() {}
+func (*ServiceCommands) ProtoMessage() {}
 
-func (x *OSPFArea) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[6]
+func (x *ServiceCommands) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,51 +1045,49 @@ func (x *OSPFArea) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSPFArea.ProtoReflect.Descriptor instead.
-func (*OSPFArea) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ServiceCommands.ProtoReflect.Descriptor instead.
+func (*ServiceCommands) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *OSPFArea) GetId() string {
+func (x *ServiceCommands) GetService() string {
 	if x != nil {
-		return x.Id
+		return x.Service
 	}
 	return ""
 }
 
-func (x *OSPFArea) GetNetworks() []string {
+func (x *ServiceCommands) GetCommands() []string {
 	if x != nil {
-		return x.Networks
+		return x.Commands
 	}
 	return nil
 }
 
-type OSPFInterfaceConfig struct {
+type NetworkConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Area          string                 `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,3,opt,name=IpAddress,proto3" json:"IpAddress,omitempty"`
-	Passive       bool                   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
-	Cost          int32                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	RouterId      string                 `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	Areas         []*OSPFArea            `protobuf:"bytes,2,rep,name=areas,proto3" json:"areas,omitempty"`
+	Interfaces    []*OSPFInterfaceConfig `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OSPFInterfaceConfig) Reset() {
-	*x = OSPFInterfaceConfig{}
-	mi := &file_protocol_proto_msgTypes[7]
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
+	mi := &file_protocol_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OSPFInterfaceConfig) String() string {
+func (x *NetworkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OSPFInterfaceConfig) ProtoMessage() {}
+func (*NetworkConfig) ProtoMessage() {}
 
-func (x *OSPFInterfaceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[7]
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,14 +1098,128 @@ func (x *OSPFInterfaceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OSPFInterfaceConfig.ProtoReflect.Descriptor instead.
-func (*OSPFInterfaceConfig) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *OSPFInterfaceConfig) GetName() string {
+func (x *NetworkConfig) GetRouterId() string {
 	if x != nil {
-		return x.Name
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetAreas() []*OSPFArea {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+func (x *NetworkConfig) GetInterfaces() []*OSPFInterfaceConfig {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type OSPFArea struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OSPFArea) Reset() {
+	*x = OSPFArea{}
+	mi := &file_protocol_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OSPFArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSPFArea) ProtoMessage() {}
+
+func (x *OSPFArea) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSPFArea.ProtoReflect.Descriptor instead.
+func (*OSPFArea) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *OSPFArea) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OSPFArea) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type OSPFInterfaceConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Area          string                 `protobuf:"bytes,2,opt,name=area,proto3" json:"area,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=IpAddress,proto3" json:"IpAddress,omitempty"`
+	Passive       bool                   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
+	Cost          int32                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OSPFInterfaceConfig) Reset() {
+	*x = OSPFInterfaceConfig{}
+	mi := &file_protocol_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OSPFInterfaceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OSPFInterfaceConfig) ProtoMessage() {}
+
+func (x *OSPFInterfaceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OSPFInterfaceConfig.ProtoReflect.Descriptor instead.
+func (*OSPFInterfaceConfig) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OSPFInterfaceConfig) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
@@ -856,7 +1281,7 @@ type FullFRRData struct {
 
 func (x *FullFRRData) Reset() {
 	*x = FullFRRData{}
-	mi := &file_protocol_proto_msgTypes[8]
+	mi := &file_protocol_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -868,7 +1293,7 @@ func (x *FullFRRData) String() string {
 func (*FullFRRData) ProtoMessage() {}
 
 func (x *FullFRRData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[8]
+	mi := &file_protocol_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1306,7 @@ func (x *FullFRRData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FullFRRData.ProtoReflect.Descriptor instead.
 func (*FullFRRData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{8}
+	return file_protocol_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FullFRRData) GetOspfDatabase() *OSPFDatabase {
@@ -1038,13 +1463,14 @@ type StaticFRRConfiguration struct {
 	OspfConfig         *OSPFConfig            `protobuf:"bytes,10,opt,name=ospf_config,json=ospfConfig,proto3" json:"ospf_config,omitempty"`
 	RouteMap           map[string]*RouteMap   `protobuf:"bytes,11,rep,name=route_map,json=routeMap,proto3" json:"route_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	AccessList         map[string]*AccessList `protobuf:"bytes,12,rep,name=access_list,json=accessList,proto3" json:"access_list,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PrefixList         map[string]*PrefixList `protobuf:"bytes,13,rep,name=prefix_list,json=prefixList,proto3" json:"prefix_list,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *StaticFRRConfiguration) Reset() {
 	*x = StaticFRRConfiguration{}
-	mi := &file_protocol_proto_msgTypes[9]
+	mi := &file_protocol_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1482,7 @@ func (x *StaticFRRConfiguration) String() string {
 func (*StaticFRRConfiguration) ProtoMessage() {}
 
 func (x *StaticFRRConfiguration) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[9]
+	mi := &file_protocol_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1495,7 @@ func (x *StaticFRRConfiguration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticFRRConfiguration.ProtoReflect.Descriptor instead.
 func (*StaticFRRConfiguration) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9}
+	return file_protocol_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StaticFRRConfiguration) GetHostname() string {
@@ -1142,6 +1568,13 @@ func (x *StaticFRRConfiguration) GetAccessList() map[string]*AccessList {
 	return nil
 }
 
+func (x *StaticFRRConfiguration) GetPrefixList() map[string]*PrefixList {
+	if x != nil {
+		return x.PrefixList
+	}
+	return nil
+}
+
 type Interface struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1153,7 +1586,7 @@ type Interface struct {
 
 func (x *Interface) Reset() {
 	*x = Interface{}
-	mi := &file_protocol_proto_msgTypes[10]
+	mi := &file_protocol_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1165,7 +1598,7 @@ func (x *Interface) String() string {
 func (*Interface) ProtoMessage() {}
 
 func (x *Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[10]
+	mi := &file_protocol_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1178,7 +1611,7 @@ func (x *Interface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Interface.ProtoReflect.Descriptor instead.
 func (*Interface) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10}
+	return file_protocol_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Interface) GetName() string {
@@ -1212,7 +1645,7 @@ type StaticRoute struct {
 
 func (x *StaticRoute) Reset() {
 	*x = StaticRoute{}
-	mi := &file_protocol_proto_msgTypes[11]
+	mi := &file_protocol_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1224,7 +1657,7 @@ func (x *StaticRoute) String() string {
 func (*StaticRoute) ProtoMessage() {}
 
 func (x *StaticRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[11]
+	mi := &file_protocol_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1237,7 +1670,7 @@ func (x *StaticRoute) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticRoute.ProtoReflect.Descriptor instead.
 func (*StaticRoute) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11}
+	return file_protocol_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StaticRoute) GetIpPrefix() *IPPrefix {
@@ -1260,13 +1693,17 @@ type OSPFConfig struct {
 	Redistribution      []*Redistribution      `protobuf:"bytes,2,rep,name=redistribution,proto3" json:"redistribution,omitempty"`
 	Area                []*Area                `protobuf:"bytes,3,rep,name=area,proto3" json:"area,omitempty"`
 	VirtualLinkNeighbor string                 `protobuf:"bytes,4,opt,name=virtual_link_neighbor,json=virtualLinkNeighbor,proto3" json:"virtual_link_neighbor,omitempty"`
+	AreaRange           []*AreaRange           `protobuf:"bytes,5,rep,name=area_range,json=areaRange,proto3" json:"area_range,omitempty"`
+	HasDefaultMetric    bool                   `protobuf:"varint,6,opt,name=has_default_metric,json=hasDefaultMetric,proto3" json:"has_default_metric,omitempty"`
+	DefaultMetric       int32                  `protobuf:"varint,7,opt,name=default_metric,json=defaultMetric,proto3" json:"default_metric,omitempty"`
+	DefaultInformation  *DefaultInformation    `protobuf:"bytes,8,opt,name=default_information,json=defaultInformation,proto3" json:"default_information,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *OSPFConfig) Reset() {
 	*x = OSPFConfig{}
-	mi := &file_protocol_proto_msgTypes[12]
+	mi := &file_protocol_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1278,7 +1715,7 @@ func (x *OSPFConfig) String() string {
 func (*OSPFConfig) ProtoMessage() {}
 
 func (x *OSPFConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[12]
+	mi := &file_protocol_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1728,7 @@ func (x *OSPFConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFConfig.ProtoReflect.Descriptor instead.
 func (*OSPFConfig) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{12}
+	return file_protocol_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OSPFConfig) GetRouterId() string {
@@ -1322,30 +1759,62 @@ func (x *OSPFConfig) GetVirtualLinkNeighbor() string {
 	return ""
 }
 
-type Redistribution struct {
+func (x *OSPFConfig) GetAreaRange() []*AreaRange {
+	if x != nil {
+		return x.AreaRange
+	}
+	return nil
+}
+
+func (x *OSPFConfig) GetHasDefaultMetric() bool {
+	if x != nil {
+		return x.HasDefaultMetric
+	}
+	return false
+}
+
+func (x *OSPFConfig) GetDefaultMetric() int32 {
+	if x != nil {
+		return x.DefaultMetric
+	}
+	return 0
+}
+
+func (x *OSPFConfig) GetDefaultInformation() *DefaultInformation {
+	if x != nil {
+		return x.DefaultInformation
+	}
+	return nil
+}
+
+// default-information originate [always] [metric <n>] [metric-type 1|2] [route-map <name>]
+type DefaultInformation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Metric        string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
-	RouteMap      string                 `protobuf:"bytes,3,opt,name=route_map,json=routeMap,proto3" json:"route_map,omitempty"`
+	Originate     bool                   `protobuf:"varint,1,opt,name=originate,proto3" json:"originate,omitempty"`
+	Always        bool                   `protobuf:"varint,2,opt,name=always,proto3" json:"always,omitempty"`
+	HasMetric     bool                   `protobuf:"varint,3,opt,name=has_metric,json=hasMetric,proto3" json:"has_metric,omitempty"`
+	Metric        int32                  `protobuf:"varint,4,opt,name=metric,proto3" json:"metric,omitempty"`
+	MetricType    string                 `protobuf:"bytes,5,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	RouteMap      string                 `protobuf:"bytes,6,opt,name=route_map,json=routeMap,proto3" json:"route_map,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Redistribution) Reset() {
-	*x = Redistribution{}
-	mi := &file_protocol_proto_msgTypes[13]
+func (x *DefaultInformation) Reset() {
+	*x = DefaultInformation{}
+	mi := &file_protocol_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Redistribution) String() string {
+func (x *DefaultInformation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Redistribution) ProtoMessage() {}
+func (*DefaultInformation) ProtoMessage() {}
 
-func (x *Redistribution) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[13]
+func (x *DefaultInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,55 +1825,79 @@ func (x *Redistribution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Redistribution.ProtoReflect.Descriptor instead.
-func (*Redistribution) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DefaultInformation.ProtoReflect.Descriptor instead.
+func (*DefaultInformation) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Redistribution) GetType() string {
+func (x *DefaultInformation) GetOriginate() bool {
 	if x != nil {
-		return x.Type
+		return x.Originate
 	}
-	return ""
+	return false
 }
 
-func (x *Redistribution) GetMetric() string {
+func (x *DefaultInformation) GetAlways() bool {
+	if x != nil {
+		return x.Always
+	}
+	return false
+}
+
+func (x *DefaultInformation) GetHasMetric() bool {
+	if x != nil {
+		return x.HasMetric
+	}
+	return false
+}
+
+func (x *DefaultInformation) GetMetric() int32 {
 	if x != nil {
 		return x.Metric
 	}
+	return 0
+}
+
+func (x *DefaultInformation) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
 	return ""
 }
 
-func (x *Redistribution) GetRouteMap() string {
+func (x *DefaultInformation) GetRouteMap() string {
 	if x != nil {
 		return x.RouteMap
 	}
 	return ""
 }
 
-type Area struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // transit (virtual-link) is an area type because it’s not possible to be nssa/stub AND transit-area
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Redistribution struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Metric         string                 `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"` // configured metric-type (1 or 2)
+	RouteMap       string                 `protobuf:"bytes,3,opt,name=route_map,json=routeMap,proto3" json:"route_map,omitempty"`
+	HasMetricValue bool                   `protobuf:"varint,4,opt,name=has_metric_value,json=hasMetricValue,proto3" json:"has_metric_value,omitempty"`
+	MetricValue    int32                  `protobuf:"varint,5,opt,name=metric_value,json=metricValue,proto3" json:"metric_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Area) Reset() {
-	*x = Area{}
-	mi := &file_protocol_proto_msgTypes[14]
+func (x *Redistribution) Reset() {
+	*x = Redistribution{}
+	mi := &file_protocol_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Area) String() string {
+func (x *Redistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Area) ProtoMessage() {}
+func (*Redistribution) ProtoMessage() {}
 
-func (x *Area) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[14]
+func (x *Redistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,38 +1908,217 @@ func (x *Area) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Area.ProtoReflect.Descriptor instead.
-func (*Area) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Redistribution.ProtoReflect.Descriptor instead.
+func (*Redistribution) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Area) GetName() string {
+func (x *Redistribution) GetType() string {
 	if x != nil {
-		return x.Name
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Area) GetType() string {
+func (x *Redistribution) GetMetric() string {
 	if x != nil {
-		return x.Type
+		return x.Metric
 	}
 	return ""
 }
 
-type RouteMap struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Permit        bool                   `protobuf:"varint,1,opt,name=permit,proto3" json:"permit,omitempty"`
-	Sequence      string                 `protobuf:"bytes,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
-	Match         string                 `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
-	AccessList    string                 `protobuf:"bytes,4,opt,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Redistribution) GetRouteMap() string {
+	if x != nil {
+		return x.RouteMap
+	}
+	return ""
 }
 
-func (x *RouteMap) Reset() {
+func (x *Redistribution) GetHasMetricValue() bool {
+	if x != nil {
+		return x.HasMetricValue
+	}
+	return false
+}
+
+func (x *Redistribution) GetMetricValue() int32 {
+	if x != nil {
+		return x.MetricValue
+	}
+	return 0
+}
+
+type Area struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // transit (virtual-link) is an area type because it’s not possible to be nssa/stub AND transit-area
+	NoSummary            bool                   `protobuf:"varint,3,opt,name=no_summary,json=noSummary,proto3" json:"no_summary,omitempty"`
+	NssaTranslatorRole   string                 `protobuf:"bytes,4,opt,name=nssa_translator_role,json=nssaTranslatorRole,proto3" json:"nssa_translator_role,omitempty"`        // translate-always, translate-candidate or translate-never
+	NssaDefaultOriginate bool                   `protobuf:"varint,5,opt,name=nssa_default_originate,json=nssaDefaultOriginate,proto3" json:"nssa_default_originate,omitempty"` // area <id> nssa default-information-originate
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Area) Reset() {
+	*x = Area{}
+	mi := &file_protocol_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Area) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Area) ProtoMessage() {}
+
+func (x *Area) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Area.ProtoReflect.Descriptor instead.
+func (*Area) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Area) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Area) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Area) GetNoSummary() bool {
+	if x != nil {
+		return x.NoSummary
+	}
+	return false
+}
+
+func (x *Area) GetNssaTranslatorRole() string {
+	if x != nil {
+		return x.NssaTranslatorRole
+	}
+	return ""
+}
+
+func (x *Area) GetNssaDefaultOriginate() bool {
+	if x != nil {
+		return x.NssaDefaultOriginate
+	}
+	return false
+}
+
+type AreaRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Area          string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	IpPrefix      *IPPrefix              `protobuf:"bytes,2,opt,name=ip_prefix,json=ipPrefix,proto3" json:"ip_prefix,omitempty"`
+	NotAdvertise  bool                   `protobuf:"varint,3,opt,name=not_advertise,json=notAdvertise,proto3" json:"not_advertise,omitempty"`
+	HasCost       bool                   `protobuf:"varint,4,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	Cost          uint32                 `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AreaRange) Reset() {
+	*x = AreaRange{}
+	mi := &file_protocol_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AreaRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaRange) ProtoMessage() {}
+
+func (x *AreaRange) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaRange.ProtoReflect.Descriptor instead.
+func (*AreaRange) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AreaRange) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *AreaRange) GetIpPrefix() *IPPrefix {
+	if x != nil {
+		return x.IpPrefix
+	}
+	return nil
+}
+
+func (x *AreaRange) GetNotAdvertise() bool {
+	if x != nil {
+		return x.NotAdvertise
+	}
+	return false
+}
+
+func (x *AreaRange) GetHasCost() bool {
+	if x != nil {
+		return x.HasCost
+	}
+	return false
+}
+
+func (x *AreaRange) GetCost() uint32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type RouteMap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permit        bool                   `protobuf:"varint,1,opt,name=permit,proto3" json:"permit,omitempty"`
+	Sequence      string                 `protobuf:"bytes,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Match         string                 `protobuf:"bytes,3,opt,name=match,proto3" json:"match,omitempty"`
+	AccessList    string                 `protobuf:"bytes,4,opt,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	HasSetMetric  bool                   `protobuf:"varint,5,opt,name=has_set_metric,json=hasSetMetric,proto3" json:"has_set_metric,omitempty"`
+	SetMetric     int32                  `protobuf:"varint,6,opt,name=set_metric,json=setMetric,proto3" json:"set_metric,omitempty"`
+	SetMetricType string                 `protobuf:"bytes,7,opt,name=set_metric_type,json=setMetricType,proto3" json:"set_metric_type,omitempty"` // type-1 or type-2
+	HasSetTag     bool                   `protobuf:"varint,8,opt,name=has_set_tag,json=hasSetTag,proto3" json:"has_set_tag,omitempty"`
+	SetTag        uint32                 `protobuf:"varint,9,opt,name=set_tag,json=setTag,proto3" json:"set_tag,omitempty"`
+	PrefixList    string                 `protobuf:"bytes,10,opt,name=prefix_list,json=prefixList,proto3" json:"prefix_list,omitempty"` // match ip address prefix-list <name>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteMap) Reset() {
 	*x = RouteMap{}
-	mi := &file_protocol_proto_msgTypes[15]
+	mi := &file_protocol_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1458,7 +2130,7 @@ func (x *RouteMap) String() string {
 func (*RouteMap) ProtoMessage() {}
 
 func (x *RouteMap) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[15]
+	mi := &file_protocol_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,7 +2143,7 @@ func (x *RouteMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteMap.ProtoReflect.Descriptor instead.
 func (*RouteMap) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{15}
+	return file_protocol_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RouteMap) GetPermit() bool {
@@ -1502,6 +2174,48 @@ func (x *RouteMap) GetAccessList() string {
 	return ""
 }
 
+func (x *RouteMap) GetHasSetMetric() bool {
+	if x != nil {
+		return x.HasSetMetric
+	}
+	return false
+}
+
+func (x *RouteMap) GetSetMetric() int32 {
+	if x != nil {
+		return x.SetMetric
+	}
+	return 0
+}
+
+func (x *RouteMap) GetSetMetricType() string {
+	if x != nil {
+		return x.SetMetricType
+	}
+	return ""
+}
+
+func (x *RouteMap) GetHasSetTag() bool {
+	if x != nil {
+		return x.HasSetTag
+	}
+	return false
+}
+
+func (x *RouteMap) GetSetTag() uint32 {
+	if x != nil {
+		return x.SetTag
+	}
+	return 0
+}
+
+func (x *RouteMap) GetPrefixList() string {
+	if x != nil {
+		return x.PrefixList
+	}
+	return ""
+}
+
 type AccessList struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1512,7 +2226,7 @@ type AccessList struct {
 
 func (x *AccessList) Reset() {
 	*x = AccessList{}
-	mi := &file_protocol_proto_msgTypes[16]
+	mi := &file_protocol_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1524,7 +2238,7 @@ func (x *AccessList) String() string {
 func (*AccessList) ProtoMessage() {}
 
 func (x *AccessList) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[16]
+	mi := &file_protocol_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1537,7 +2251,7 @@ func (x *AccessList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessList.ProtoReflect.Descriptor instead.
 func (*AccessList) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{16}
+	return file_protocol_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AccessList) GetName() string {
@@ -1569,7 +2283,7 @@ type AccessListItem struct {
 
 func (x *AccessListItem) Reset() {
 	*x = AccessListItem{}
-	mi := &file_protocol_proto_msgTypes[17]
+	mi := &file_protocol_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1581,7 +2295,7 @@ func (x *AccessListItem) String() string {
 func (*AccessListItem) ProtoMessage() {}
 
 func (x *AccessListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[17]
+	mi := &file_protocol_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1594,7 +2308,7 @@ func (x *AccessListItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessListItem.ProtoReflect.Descriptor instead.
 func (*AccessListItem) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{17}
+	return file_protocol_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AccessListItem) GetSequence() uint32 {
@@ -1652,33 +2366,30 @@ func (*AccessListItem_IpPrefix) isAccessListItem_Destination() {}
 
 func (*AccessListItem_Any) isAccessListItem_Destination() {}
 
-type InterfaceIPPrefix struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IpPrefix      *IPPrefix              `protobuf:"bytes,1,opt,name=ip_prefix,json=ipPrefix,proto3" json:"ip_prefix,omitempty"`
-	Ospf          bool                   `protobuf:"varint,2,opt,name=ospf,proto3" json:"ospf,omitempty"`
-	OspfArea      string                 `protobuf:"bytes,3,opt,name=ospf_area,json=ospfArea,proto3" json:"ospf_area,omitempty"`
-	Passive       bool                   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
-	HasPeer       bool                   `protobuf:"varint,5,opt,name=has_peer,json=hasPeer,proto3" json:"has_peer,omitempty"` // true/false
-	PeerIpPrefix  *IPPrefix              `protobuf:"bytes,6,opt,name=peer_ip_prefix,json=peerIpPrefix,proto3" json:"peer_ip_prefix,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ip prefix-list <name> [seq <n>] permit|deny <prefix>|any [ge <n>] [le <n>]
+type PrefixList struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PrefixListItems []*PrefixListItem      `protobuf:"bytes,2,rep,name=prefix_list_items,json=prefixListItems,proto3" json:"prefix_list_items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *InterfaceIPPrefix) Reset() {
-	*x = InterfaceIPPrefix{}
-	mi := &file_protocol_proto_msgTypes[18]
+func (x *PrefixList) Reset() {
+	*x = PrefixList{}
+	mi := &file_protocol_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceIPPrefix) String() string {
+func (x *PrefixList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceIPPrefix) ProtoMessage() {}
+func (*PrefixList) ProtoMessage() {}
 
-func (x *InterfaceIPPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[18]
+func (x *PrefixList) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,64 +2400,204 @@ func (x *InterfaceIPPrefix) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceIPPrefix.ProtoReflect.Descriptor instead.
-func (*InterfaceIPPrefix) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *InterfaceIPPrefix) GetIpPrefix() *IPPrefix {
-	if x != nil {
-		return x.IpPrefix
-	}
-	return nil
-}
-
-func (x *InterfaceIPPrefix) GetOspf() bool {
-	if x != nil {
-		return x.Ospf
-	}
-	return false
+// Deprecated: Use PrefixList.ProtoReflect.Descriptor instead.
+func (*PrefixList) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *InterfaceIPPrefix) GetOspfArea() string {
+func (x *PrefixList) GetName() string {
 	if x != nil {
-		return x.OspfArea
+		return x.Name
 	}
 	return ""
 }
 
-func (x *InterfaceIPPrefix) GetPassive() bool {
-	if x != nil {
-		return x.Passive
-	}
-	return false
-}
-
-func (x *InterfaceIPPrefix) GetHasPeer() bool {
-	if x != nil {
-		return x.HasPeer
-	}
-	return false
-}
-
-func (x *InterfaceIPPrefix) GetPeerIpPrefix() *IPPrefix {
+func (x *PrefixList) GetPrefixListItems() []*PrefixListItem {
 	if x != nil {
-		return x.PeerIpPrefix
+		return x.PrefixListItems
 	}
 	return nil
 }
 
-type IPPrefix struct {
+type PrefixListItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	PrefixLength  uint32                 `protobuf:"varint,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	AccessControl string                 `protobuf:"bytes,2,opt,name=access_control,json=accessControl,proto3" json:"access_control,omitempty"`
+	IpPrefix      *IPPrefix              `protobuf:"bytes,3,opt,name=ip_prefix,json=ipPrefix,proto3" json:"ip_prefix,omitempty"`
+	Any           bool                   `protobuf:"varint,4,opt,name=any,proto3" json:"any,omitempty"`
+	Ge            uint32                 `protobuf:"varint,5,opt,name=ge,proto3" json:"ge,omitempty"`
+	Le            uint32                 `protobuf:"varint,6,opt,name=le,proto3" json:"le,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IPPrefix) Reset() {
+func (x *PrefixListItem) Reset() {
+	*x = PrefixListItem{}
+	mi := &file_protocol_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefixListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefixListItem) ProtoMessage() {}
+
+func (x *PrefixListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefixListItem.ProtoReflect.Descriptor instead.
+func (*PrefixListItem) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PrefixListItem) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PrefixListItem) GetAccessControl() string {
+	if x != nil {
+		return x.AccessControl
+	}
+	return ""
+}
+
+func (x *PrefixListItem) GetIpPrefix() *IPPrefix {
+	if x != nil {
+		return x.IpPrefix
+	}
+	return nil
+}
+
+func (x *PrefixListItem) GetAny() bool {
+	if x != nil {
+		return x.Any
+	}
+	return false
+}
+
+func (x *PrefixListItem) GetGe() uint32 {
+	if x != nil {
+		return x.Ge
+	}
+	return 0
+}
+
+func (x *PrefixListItem) GetLe() uint32 {
+	if x != nil {
+		return x.Le
+	}
+	return 0
+}
+
+type InterfaceIPPrefix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpPrefix      *IPPrefix              `protobuf:"bytes,1,opt,name=ip_prefix,json=ipPrefix,proto3" json:"ip_prefix,omitempty"`
+	Ospf          bool                   `protobuf:"varint,2,opt,name=ospf,proto3" json:"ospf,omitempty"`
+	OspfArea      string                 `protobuf:"bytes,3,opt,name=ospf_area,json=ospfArea,proto3" json:"ospf_area,omitempty"`
+	Passive       bool                   `protobuf:"varint,4,opt,name=passive,proto3" json:"passive,omitempty"`
+	HasPeer       bool                   `protobuf:"varint,5,opt,name=has_peer,json=hasPeer,proto3" json:"has_peer,omitempty"` // true/false
+	PeerIpPrefix  *IPPrefix              `protobuf:"bytes,6,opt,name=peer_ip_prefix,json=peerIpPrefix,proto3" json:"peer_ip_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterfaceIPPrefix) Reset() {
+	*x = InterfaceIPPrefix{}
+	mi := &file_protocol_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceIPPrefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceIPPrefix) ProtoMessage() {}
+
+func (x *InterfaceIPPrefix) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceIPPrefix.ProtoReflect.Descriptor instead.
+func (*InterfaceIPPrefix) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InterfaceIPPrefix) GetIpPrefix() *IPPrefix {
+	if x != nil {
+		return x.IpPrefix
+	}
+	return nil
+}
+
+func (x *InterfaceIPPrefix) GetOspf() bool {
+	if x != nil {
+		return x.Ospf
+	}
+	return false
+}
+
+func (x *InterfaceIPPrefix) GetOspfArea() string {
+	if x != nil {
+		return x.OspfArea
+	}
+	return ""
+}
+
+func (x *InterfaceIPPrefix) GetPassive() bool {
+	if x != nil {
+		return x.Passive
+	}
+	return false
+}
+
+func (x *InterfaceIPPrefix) GetHasPeer() bool {
+	if x != nil {
+		return x.HasPeer
+	}
+	return false
+}
+
+func (x *InterfaceIPPrefix) GetPeerIpPrefix() *IPPrefix {
+	if x != nil {
+		return x.PeerIpPrefix
+	}
+	return nil
+}
+
+type IPPrefix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress     string                 `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	PrefixLength  uint32                 `protobuf:"varint,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IPPrefix) Reset() {
 	*x = IPPrefix{}
-	mi := &file_protocol_proto_msgTypes[19]
+	mi := &file_protocol_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1758,7 +2609,7 @@ func (x *IPPrefix) String() string {
 func (*IPPrefix) ProtoMessage() {}
 
 func (x *IPPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[19]
+	mi := &file_protocol_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1771,7 +2622,7 @@ func (x *IPPrefix) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPPrefix.ProtoReflect.Descriptor instead.
 func (*IPPrefix) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{19}
+	return file_protocol_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *IPPrefix) GetIpAddress() string {
@@ -1799,7 +2650,7 @@ type SystemMetrics struct {
 
 func (x *SystemMetrics) Reset() {
 	*x = SystemMetrics{}
-	mi := &file_protocol_proto_msgTypes[20]
+	mi := &file_protocol_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1811,7 +2662,7 @@ func (x *SystemMetrics) String() string {
 func (*SystemMetrics) ProtoMessage() {}
 
 func (x *SystemMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[20]
+	mi := &file_protocol_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,7 +2675,7 @@ func (x *SystemMetrics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SystemMetrics.ProtoReflect.Descriptor instead.
 func (*SystemMetrics) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{20}
+	return file_protocol_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SystemMetrics) GetCpuAmount() int64 {
@@ -1879,7 +2730,7 @@ type GeneralOspfInformation struct {
 
 func (x *GeneralOspfInformation) Reset() {
 	*x = GeneralOspfInformation{}
-	mi := &file_protocol_proto_msgTypes[21]
+	mi := &file_protocol_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1891,7 +2742,7 @@ func (x *GeneralOspfInformation) String() string {
 func (*GeneralOspfInformation) ProtoMessage() {}
 
 func (x *GeneralOspfInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[21]
+	mi := &file_protocol_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1904,7 +2755,7 @@ func (x *GeneralOspfInformation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneralOspfInformation.ProtoReflect.Descriptor instead.
 func (*GeneralOspfInformation) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{21}
+	return file_protocol_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GeneralOspfInformation) GetRouterId() string {
@@ -2098,7 +2949,7 @@ type GeneralInfoOspfArea struct {
 
 func (x *GeneralInfoOspfArea) Reset() {
 	*x = GeneralInfoOspfArea{}
-	mi := &file_protocol_proto_msgTypes[22]
+	mi := &file_protocol_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2110,7 +2961,7 @@ func (x *GeneralInfoOspfArea) String() string {
 func (*GeneralInfoOspfArea) ProtoMessage() {}
 
 func (x *GeneralInfoOspfArea) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[22]
+	mi := &file_protocol_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,7 +2974,7 @@ func (x *GeneralInfoOspfArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeneralInfoOspfArea.ProtoReflect.Descriptor instead.
 func (*GeneralInfoOspfArea) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{22}
+	return file_protocol_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GeneralInfoOspfArea) GetBackbone() bool {
@@ -2283,7 +3134,7 @@ type FRRRouterData struct {
 
 func (x *FRRRouterData) Reset() {
 	*x = FRRRouterData{}
-	mi := &file_protocol_proto_msgTypes[23]
+	mi := &file_protocol_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2295,7 +3146,7 @@ func (x *FRRRouterData) String() string {
 func (*FRRRouterData) ProtoMessage() {}
 
 func (x *FRRRouterData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[23]
+	mi := &file_protocol_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2308,7 +3159,7 @@ func (x *FRRRouterData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FRRRouterData.ProtoReflect.Descriptor instead.
 func (*FRRRouterData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{23}
+	return file_protocol_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FRRRouterData) GetRouterName() string {
@@ -2335,7 +3186,7 @@ type OSPFRouterData struct {
 
 func (x *OSPFRouterData) Reset() {
 	*x = OSPFRouterData{}
-	mi := &file_protocol_proto_msgTypes[24]
+	mi := &file_protocol_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2347,7 +3198,7 @@ func (x *OSPFRouterData) String() string {
 func (*OSPFRouterData) ProtoMessage() {}
 
 func (x *OSPFRouterData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[24]
+	mi := &file_protocol_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,7 +3211,7 @@ func (x *OSPFRouterData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFRouterData.ProtoReflect.Descriptor instead.
 func (*OSPFRouterData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{24}
+	return file_protocol_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *OSPFRouterData) GetRouterId() string {
@@ -2386,7 +3237,7 @@ type OSPFRouterArea struct {
 
 func (x *OSPFRouterArea) Reset() {
 	*x = OSPFRouterArea{}
-	mi := &file_protocol_proto_msgTypes[25]
+	mi := &file_protocol_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2398,7 +3249,7 @@ func (x *OSPFRouterArea) String() string {
 func (*OSPFRouterArea) ProtoMessage() {}
 
 func (x *OSPFRouterArea) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[25]
+	mi := &file_protocol_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2411,7 +3262,7 @@ func (x *OSPFRouterArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFRouterArea.ProtoReflect.Descriptor instead.
 func (*OSPFRouterArea) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{25}
+	return file_protocol_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *OSPFRouterArea) GetLsaEntries() map[string]*OSPFRouterLSA {
@@ -2442,7 +3293,7 @@ type OSPFRouterLSA struct {
 
 func (x *OSPFRouterLSA) Reset() {
 	*x = OSPFRouterLSA{}
-	mi := &file_protocol_proto_msgTypes[26]
+	mi := &file_protocol_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2454,7 +3305,7 @@ func (x *OSPFRouterLSA) String() string {
 func (*OSPFRouterLSA) ProtoMessage() {}
 
 func (x *OSPFRouterLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[26]
+	mi := &file_protocol_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2467,7 +3318,7 @@ func (x *OSPFRouterLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFRouterLSA.ProtoReflect.Descriptor instead.
 func (*OSPFRouterLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{26}
+	return file_protocol_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *OSPFRouterLSA) GetLsaAge() int32 {
@@ -2578,7 +3429,7 @@ type OSPFRouterLSALink struct {
 
 func (x *OSPFRouterLSALink) Reset() {
 	*x = OSPFRouterLSALink{}
-	mi := &file_protocol_proto_msgTypes[27]
+	mi := &file_protocol_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2590,7 +3441,7 @@ func (x *OSPFRouterLSALink) String() string {
 func (*OSPFRouterLSALink) ProtoMessage() {}
 
 func (x *OSPFRouterLSALink) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[27]
+	mi := &file_protocol_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +3454,7 @@ func (x *OSPFRouterLSALink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFRouterLSALink.ProtoReflect.Descriptor instead.
 func (*OSPFRouterLSALink) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{27}
+	return file_protocol_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *OSPFRouterLSALink) GetLinkType() string {
@@ -2679,7 +3530,7 @@ type OSPFNetworkData struct {
 
 func (x *OSPFNetworkData) Reset() {
 	*x = OSPFNetworkData{}
-	mi := &file_protocol_proto_msgTypes[28]
+	mi := &file_protocol_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2691,7 +3542,7 @@ func (x *OSPFNetworkData) String() string {
 func (*OSPFNetworkData) ProtoMessage() {}
 
 func (x *OSPFNetworkData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[28]
+	mi := &file_protocol_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,7 +3555,7 @@ func (x *OSPFNetworkData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFNetworkData.ProtoReflect.Descriptor instead.
 func (*OSPFNetworkData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{28}
+	return file_protocol_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *OSPFNetworkData) GetRouterId() string {
@@ -2730,7 +3581,7 @@ type NetAreaState struct {
 
 func (x *NetAreaState) Reset() {
 	*x = NetAreaState{}
-	mi := &file_protocol_proto_msgTypes[29]
+	mi := &file_protocol_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2742,7 +3593,7 @@ func (x *NetAreaState) String() string {
 func (*NetAreaState) ProtoMessage() {}
 
 func (x *NetAreaState) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[29]
+	mi := &file_protocol_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,7 +3606,7 @@ func (x *NetAreaState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetAreaState.ProtoReflect.Descriptor instead.
 func (*NetAreaState) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{29}
+	return file_protocol_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *NetAreaState) GetLsaEntries() map[string]*NetworkLSA {
@@ -2784,7 +3635,7 @@ type NetworkLSA struct {
 
 func (x *NetworkLSA) Reset() {
 	*x = NetworkLSA{}
-	mi := &file_protocol_proto_msgTypes[30]
+	mi := &file_protocol_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2796,7 +3647,7 @@ func (x *NetworkLSA) String() string {
 func (*NetworkLSA) ProtoMessage() {}
 
 func (x *NetworkLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[30]
+	mi := &file_protocol_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2809,7 +3660,7 @@ func (x *NetworkLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkLSA.ProtoReflect.Descriptor instead.
 func (*NetworkLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{30}
+	return file_protocol_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *NetworkLSA) GetLsaAge() int32 {
@@ -2898,7 +3749,7 @@ type AttachedRouter struct {
 
 func (x *AttachedRouter) Reset() {
 	*x = AttachedRouter{}
-	mi := &file_protocol_proto_msgTypes[31]
+	mi := &file_protocol_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +3761,7 @@ func (x *AttachedRouter) String() string {
 func (*AttachedRouter) ProtoMessage() {}
 
 func (x *AttachedRouter) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[31]
+	mi := &file_protocol_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +3774,7 @@ func (x *AttachedRouter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttachedRouter.ProtoReflect.Descriptor instead.
 func (*AttachedRouter) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{31}
+	return file_protocol_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AttachedRouter) GetAttachedRouterId() string {
@@ -2944,7 +3795,7 @@ type OSPFSummaryData struct {
 
 func (x *OSPFSummaryData) Reset() {
 	*x = OSPFSummaryData{}
-	mi := &file_protocol_proto_msgTypes[32]
+	mi := &file_protocol_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2956,7 +3807,7 @@ func (x *OSPFSummaryData) String() string {
 func (*OSPFSummaryData) ProtoMessage() {}
 
 func (x *OSPFSummaryData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[32]
+	mi := &file_protocol_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2969,7 +3820,7 @@ func (x *OSPFSummaryData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFSummaryData.ProtoReflect.Descriptor instead.
 func (*OSPFSummaryData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{32}
+	return file_protocol_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *OSPFSummaryData) GetRouterId() string {
@@ -3002,7 +3853,7 @@ type SummaryAreaState struct {
 
 func (x *SummaryAreaState) Reset() {
 	*x = SummaryAreaState{}
-	mi := &file_protocol_proto_msgTypes[33]
+	mi := &file_protocol_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +3865,7 @@ func (x *SummaryAreaState) String() string {
 func (*SummaryAreaState) ProtoMessage() {}
 
 func (x *SummaryAreaState) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[33]
+	mi := &file_protocol_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +3878,7 @@ func (x *SummaryAreaState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryAreaState.ProtoReflect.Descriptor instead.
 func (*SummaryAreaState) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{33}
+	return file_protocol_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *SummaryAreaState) GetLsaEntries() map[string]*SummaryLSA {
@@ -3056,7 +3907,7 @@ type SummaryLSA struct {
 
 func (x *SummaryLSA) Reset() {
 	*x = SummaryLSA{}
-	mi := &file_protocol_proto_msgTypes[34]
+	mi := &file_protocol_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3068,7 +3919,7 @@ func (x *SummaryLSA) String() string {
 func (*SummaryLSA) ProtoMessage() {}
 
 func (x *SummaryLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[34]
+	mi := &file_protocol_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3081,7 +3932,7 @@ func (x *SummaryLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryLSA.ProtoReflect.Descriptor instead.
 func (*SummaryLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{34}
+	return file_protocol_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *SummaryLSA) GetLsaAge() int32 {
@@ -3171,7 +4022,7 @@ type OSPFAsbrSummaryData struct {
 
 func (x *OSPFAsbrSummaryData) Reset() {
 	*x = OSPFAsbrSummaryData{}
-	mi := &file_protocol_proto_msgTypes[35]
+	mi := &file_protocol_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3183,7 +4034,7 @@ func (x *OSPFAsbrSummaryData) String() string {
 func (*OSPFAsbrSummaryData) ProtoMessage() {}
 
 func (x *OSPFAsbrSummaryData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[35]
+	mi := &file_protocol_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3196,7 +4047,7 @@ func (x *OSPFAsbrSummaryData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFAsbrSummaryData.ProtoReflect.Descriptor instead.
 func (*OSPFAsbrSummaryData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{35}
+	return file_protocol_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *OSPFAsbrSummaryData) GetRouterId() string {
@@ -3223,7 +4074,7 @@ type OSPFExternalData struct {
 
 func (x *OSPFExternalData) Reset() {
 	*x = OSPFExternalData{}
-	mi := &file_protocol_proto_msgTypes[36]
+	mi := &file_protocol_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3235,7 +4086,7 @@ func (x *OSPFExternalData) String() string {
 func (*OSPFExternalData) ProtoMessage() {}
 
 func (x *OSPFExternalData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[36]
+	mi := &file_protocol_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3248,7 +4099,7 @@ func (x *OSPFExternalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFExternalData.ProtoReflect.Descriptor instead.
 func (*OSPFExternalData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{36}
+	return file_protocol_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *OSPFExternalData) GetRouterId() string {
@@ -3288,7 +4139,7 @@ type ExternalLSA struct {
 
 func (x *ExternalLSA) Reset() {
 	*x = ExternalLSA{}
-	mi := &file_protocol_proto_msgTypes[37]
+	mi := &file_protocol_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3300,7 +4151,7 @@ func (x *ExternalLSA) String() string {
 func (*ExternalLSA) ProtoMessage() {}
 
 func (x *ExternalLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[37]
+	mi := &file_protocol_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,7 +4164,7 @@ func (x *ExternalLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalLSA.ProtoReflect.Descriptor instead.
 func (*ExternalLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{37}
+	return file_protocol_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ExternalLSA) GetLsaAge() int32 {
@@ -3431,7 +4282,7 @@ type OSPFNssaExternalData struct {
 
 func (x *OSPFNssaExternalData) Reset() {
 	*x = OSPFNssaExternalData{}
-	mi := &file_protocol_proto_msgTypes[38]
+	mi := &file_protocol_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3443,7 +4294,7 @@ func (x *OSPFNssaExternalData) String() string {
 func (*OSPFNssaExternalData) ProtoMessage() {}
 
 func (x *OSPFNssaExternalData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[38]
+	mi := &file_protocol_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3456,7 +4307,7 @@ func (x *OSPFNssaExternalData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFNssaExternalData.ProtoReflect.Descriptor instead.
 func (*OSPFNssaExternalData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{38}
+	return file_protocol_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *OSPFNssaExternalData) GetRouterId() string {
@@ -3482,7 +4333,7 @@ type NssaExternalArea struct {
 
 func (x *NssaExternalArea) Reset() {
 	*x = NssaExternalArea{}
-	mi := &file_protocol_proto_msgTypes[39]
+	mi := &file_protocol_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3494,7 +4345,7 @@ func (x *NssaExternalArea) String() string {
 func (*NssaExternalArea) ProtoMessage() {}
 
 func (x *NssaExternalArea) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[39]
+	mi := &file_protocol_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3507,7 +4358,7 @@ func (x *NssaExternalArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NssaExternalArea.ProtoReflect.Descriptor instead.
 func (*NssaExternalArea) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{39}
+	return file_protocol_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *NssaExternalArea) GetData() map[string]*NssaExternalLSA {
@@ -3540,7 +4391,7 @@ type NssaExternalLSA struct {
 
 func (x *NssaExternalLSA) Reset() {
 	*x = NssaExternalLSA{}
-	mi := &file_protocol_proto_msgTypes[40]
+	mi := &file_protocol_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3552,7 +4403,7 @@ func (x *NssaExternalLSA) String() string {
 func (*NssaExternalLSA) ProtoMessage() {}
 
 func (x *NssaExternalLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[40]
+	mi := &file_protocol_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3565,7 +4416,7 @@ func (x *NssaExternalLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NssaExternalLSA.ProtoReflect.Descriptor instead.
 func (*NssaExternalLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{40}
+	return file_protocol_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *NssaExternalLSA) GetLsaAge() int32 {
@@ -3686,7 +4537,7 @@ type OSPFDatabase struct {
 
 func (x *OSPFDatabase) Reset() {
 	*x = OSPFDatabase{}
-	mi := &file_protocol_proto_msgTypes[41]
+	mi := &file_protocol_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3698,7 +4549,7 @@ func (x *OSPFDatabase) String() string {
 func (*OSPFDatabase) ProtoMessage() {}
 
 func (x *OSPFDatabase) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[41]
+	mi := &file_protocol_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3711,7 +4562,7 @@ func (x *OSPFDatabase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFDatabase.ProtoReflect.Descriptor instead.
 func (*OSPFDatabase) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{41}
+	return file_protocol_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *OSPFDatabase) GetRouterId() string {
@@ -3760,7 +4611,7 @@ type OSPFDatabaseArea struct {
 
 func (x *OSPFDatabaseArea) Reset() {
 	*x = OSPFDatabaseArea{}
-	mi := &file_protocol_proto_msgTypes[42]
+	mi := &file_protocol_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3772,7 +4623,7 @@ func (x *OSPFDatabaseArea) String() string {
 func (*OSPFDatabaseArea) ProtoMessage() {}
 
 func (x *OSPFDatabaseArea) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[42]
+	mi := &file_protocol_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3785,7 +4636,7 @@ func (x *OSPFDatabaseArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFDatabaseArea.ProtoReflect.Descriptor instead.
 func (*OSPFDatabaseArea) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{42}
+	return file_protocol_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *OSPFDatabaseArea) GetRouterLinkStates() []*RouterDataLSA {
@@ -3871,7 +4722,7 @@ type BaseLSA struct {
 
 func (x *BaseLSA) Reset() {
 	*x = BaseLSA{}
-	mi := &file_protocol_proto_msgTypes[43]
+	mi := &file_protocol_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3883,7 +4734,7 @@ func (x *BaseLSA) String() string {
 func (*BaseLSA) ProtoMessage() {}
 
 func (x *BaseLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[43]
+	mi := &file_protocol_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3896,7 +4747,7 @@ func (x *BaseLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseLSA.ProtoReflect.Descriptor instead.
 func (*BaseLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{43}
+	return file_protocol_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *BaseLSA) GetLsId() string {
@@ -3944,7 +4795,7 @@ type RouterDataLSA struct {
 
 func (x *RouterDataLSA) Reset() {
 	*x = RouterDataLSA{}
-	mi := &file_protocol_proto_msgTypes[44]
+	mi := &file_protocol_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3956,7 +4807,7 @@ func (x *RouterDataLSA) String() string {
 func (*RouterDataLSA) ProtoMessage() {}
 
 func (x *RouterDataLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[44]
+	mi := &file_protocol_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3969,7 +4820,7 @@ func (x *RouterDataLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouterDataLSA.ProtoReflect.Descriptor instead.
 func (*RouterDataLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{44}
+	return file_protocol_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *RouterDataLSA) GetBase() *BaseLSA {
@@ -3995,7 +4846,7 @@ type NetworkDataLSA struct {
 
 func (x *NetworkDataLSA) Reset() {
 	*x = NetworkDataLSA{}
-	mi := &file_protocol_proto_msgTypes[45]
+	mi := &file_protocol_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4007,7 +4858,7 @@ func (x *NetworkDataLSA) String() string {
 func (*NetworkDataLSA) ProtoMessage() {}
 
 func (x *NetworkDataLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[45]
+	mi := &file_protocol_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4020,7 +4871,7 @@ func (x *NetworkDataLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkDataLSA.ProtoReflect.Descriptor instead.
 func (*NetworkDataLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{45}
+	return file_protocol_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *NetworkDataLSA) GetBase() *BaseLSA {
@@ -4040,7 +4891,7 @@ type SummaryDataLSA struct {
 
 func (x *SummaryDataLSA) Reset() {
 	*x = SummaryDataLSA{}
-	mi := &file_protocol_proto_msgTypes[46]
+	mi := &file_protocol_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4052,7 +4903,7 @@ func (x *SummaryDataLSA) String() string {
 func (*SummaryDataLSA) ProtoMessage() {}
 
 func (x *SummaryDataLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[46]
+	mi := &file_protocol_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4065,7 +4916,7 @@ func (x *SummaryDataLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SummaryDataLSA.ProtoReflect.Descriptor instead.
 func (*SummaryDataLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{46}
+	return file_protocol_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *SummaryDataLSA) GetBase() *BaseLSA {
@@ -4091,7 +4942,7 @@ type ASBRSummaryLSA struct {
 
 func (x *ASBRSummaryLSA) Reset() {
 	*x = ASBRSummaryLSA{}
-	mi := &file_protocol_proto_msgTypes[47]
+	mi := &file_protocol_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4103,7 +4954,7 @@ func (x *ASBRSummaryLSA) String() string {
 func (*ASBRSummaryLSA) ProtoMessage() {}
 
 func (x *ASBRSummaryLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[47]
+	mi := &file_protocol_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4116,7 +4967,7 @@ func (x *ASBRSummaryLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASBRSummaryLSA.ProtoReflect.Descriptor instead.
 func (*ASBRSummaryLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{47}
+	return file_protocol_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *ASBRSummaryLSA) GetBase() *BaseLSA {
@@ -4138,7 +4989,7 @@ type NSSAExternalLSAData struct {
 
 func (x *NSSAExternalLSAData) Reset() {
 	*x = NSSAExternalLSAData{}
-	mi := &file_protocol_proto_msgTypes[48]
+	mi := &file_protocol_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4150,7 +5001,7 @@ func (x *NSSAExternalLSAData) String() string {
 func (*NSSAExternalLSAData) ProtoMessage() {}
 
 func (x *NSSAExternalLSAData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[48]
+	mi := &file_protocol_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4163,7 +5014,7 @@ func (x *NSSAExternalLSAData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NSSAExternalLSAData.ProtoReflect.Descriptor instead.
 func (*NSSAExternalLSAData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{48}
+	return file_protocol_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *NSSAExternalLSAData) GetBase() *BaseLSA {
@@ -4206,7 +5057,7 @@ type ASExternalLSA struct {
 
 func (x *ASExternalLSA) Reset() {
 	*x = ASExternalLSA{}
-	mi := &file_protocol_proto_msgTypes[49]
+	mi := &file_protocol_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4218,7 +5069,7 @@ func (x *ASExternalLSA) String() string {
 func (*ASExternalLSA) ProtoMessage() {}
 
 func (x *ASExternalLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[49]
+	mi := &file_protocol_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4231,7 +5082,7 @@ func (x *ASExternalLSA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASExternalLSA.ProtoReflect.Descriptor instead.
 func (*ASExternalLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{49}
+	return file_protocol_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ASExternalLSA) GetBase() *BaseLSA {
@@ -4273,7 +5124,7 @@ type OSPFExternalAll struct {
 
 func (x *OSPFExternalAll) Reset() {
 	*x = OSPFExternalAll{}
-	mi := &file_protocol_proto_msgTypes[50]
+	mi := &file_protocol_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4285,7 +5136,7 @@ func (x *OSPFExternalAll) String() string {
 func (*OSPFExternalAll) ProtoMessage() {}
 
 func (x *OSPFExternalAll) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[50]
+	mi := &file_protocol_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4298,7 +5149,7 @@ func (x *OSPFExternalAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFExternalAll.ProtoReflect.Descriptor instead.
 func (*OSPFExternalAll) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{50}
+	return file_protocol_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *OSPFExternalAll) GetRouterId() string {
@@ -4338,7 +5189,7 @@ type ASExternalLinkState struct {
 
 func (x *ASExternalLinkState) Reset() {
 	*x = ASExternalLinkState{}
-	mi := &file_protocol_proto_msgTypes[51]
+	mi := &file_protocol_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4350,7 +5201,7 @@ func (x *ASExternalLinkState) String() string {
 func (*ASExternalLinkState) ProtoMessage() {}
 
 func (x *ASExternalLinkState) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[51]
+	mi := &file_protocol_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4363,7 +5214,7 @@ func (x *ASExternalLinkState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASExternalLinkState.ProtoReflect.Descriptor instead.
 func (*ASExternalLinkState) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{51}
+	return file_protocol_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ASExternalLinkState) GetLsaAge() int32 {
@@ -4482,7 +5333,7 @@ type OSPFNssaExternalAll struct {
 
 func (x *OSPFNssaExternalAll) Reset() {
 	*x = OSPFNssaExternalAll{}
-	mi := &file_protocol_proto_msgTypes[52]
+	mi := &file_protocol_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4494,7 +5345,7 @@ func (x *OSPFNssaExternalAll) String() string {
 func (*OSPFNssaExternalAll) ProtoMessage() {}
 
 func (x *OSPFNssaExternalAll) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[52]
+	mi := &file_protocol_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4507,7 +5358,7 @@ func (x *OSPFNssaExternalAll) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFNssaExternalAll.ProtoReflect.Descriptor instead.
 func (*OSPFNssaExternalAll) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{52}
+	return file_protocol_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *OSPFNssaExternalAll) GetRouterId() string {
@@ -4534,7 +5385,7 @@ type OSPFNeighbors struct {
 
 func (x *OSPFNeighbors) Reset() {
 	*x = OSPFNeighbors{}
-	mi := &file_protocol_proto_msgTypes[53]
+	mi := &file_protocol_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4546,7 +5397,7 @@ func (x *OSPFNeighbors) String() string {
 func (*OSPFNeighbors) ProtoMessage() {}
 
 func (x *OSPFNeighbors) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[53]
+	mi := &file_protocol_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4559,7 +5410,7 @@ func (x *OSPFNeighbors) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OSPFNeighbors.ProtoReflect.Descriptor instead.
 func (*OSPFNeighbors) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{53}
+	return file_protocol_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *OSPFNeighbors) GetNeighbors() map[string]*NeighborList {
@@ -4578,7 +5429,7 @@ type NeighborList struct {
 
 func (x *NeighborList) Reset() {
 	*x = NeighborList{}
-	mi := &file_protocol_proto_msgTypes[54]
+	mi := &file_protocol_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4590,7 +5441,7 @@ func (x *NeighborList) String() string {
 func (*NeighborList) ProtoMessage() {}
 
 func (x *NeighborList) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[54]
+	mi := &file_protocol_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4603,7 +5454,7 @@ func (x *NeighborList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NeighborList.ProtoReflect.Descriptor instead.
 func (*NeighborList) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{54}
+	return file_protocol_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *NeighborList) GetNeighbors() []*Neighbor {
@@ -4641,7 +5492,7 @@ type Neighbor struct {
 
 func (x *Neighbor) Reset() {
 	*x = Neighbor{}
-	mi := &file_protocol_proto_msgTypes[55]
+	mi := &file_protocol_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4653,7 +5504,7 @@ func (x *Neighbor) String() string {
 func (*Neighbor) ProtoMessage() {}
 
 func (x *Neighbor) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[55]
+	mi := &file_protocol_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4666,7 +5517,7 @@ func (x *Neighbor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Neighbor.ProtoReflect.Descriptor instead.
 func (*Neighbor) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{55}
+	return file_protocol_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Neighbor) GetPriority() int32 {
@@ -4819,7 +5670,7 @@ type InterfaceList struct {
 
 func (x *InterfaceList) Reset() {
 	*x = InterfaceList{}
-	mi := &file_protocol_proto_msgTypes[56]
+	mi := &file_protocol_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4831,7 +5682,7 @@ func (x *InterfaceList) String() string {
 func (*InterfaceList) ProtoMessage() {}
 
 func (x *InterfaceList) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[56]
+	mi := &file_protocol_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4844,7 +5695,7 @@ func (x *InterfaceList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterfaceList.ProtoReflect.Descriptor instead.
 func (*InterfaceList) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{56}
+	return file_protocol_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *InterfaceList) GetInterfaces() map[string]*SingleInterface {
@@ -4890,7 +5741,7 @@ type SingleInterface struct {
 
 func (x *SingleInterface) Reset() {
 	*x = SingleInterface{}
-	mi := &file_protocol_proto_msgTypes[57]
+	mi := &file_protocol_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4902,7 +5753,7 @@ func (x *SingleInterface) String() string {
 func (*SingleInterface) ProtoMessage() {}
 
 func (x *SingleInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[57]
+	mi := &file_protocol_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4915,7 +5766,7 @@ func (x *SingleInterface) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleInterface.ProtoReflect.Descriptor instead.
 func (*SingleInterface) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{57}
+	return file_protocol_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SingleInterface) GetAdministrativeStatus() string {
@@ -5125,7 +5976,7 @@ type IpAddress struct {
 
 func (x *IpAddress) Reset() {
 	*x = IpAddress{}
-	mi := &file_protocol_proto_msgTypes[58]
+	mi := &file_protocol_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5137,7 +5988,7 @@ func (x *IpAddress) String() string {
 func (*IpAddress) ProtoMessage() {}
 
 func (x *IpAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[58]
+	mi := &file_protocol_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5150,7 +6001,7 @@ func (x *IpAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IpAddress.ProtoReflect.Descriptor instead.
 func (*IpAddress) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{58}
+	return file_protocol_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *IpAddress) GetAddress() string {
@@ -5201,7 +6052,7 @@ type EvpnMh struct {
 
 func (x *EvpnMh) Reset() {
 	*x = EvpnMh{}
-	mi := &file_protocol_proto_msgTypes[59]
+	mi := &file_protocol_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5213,7 +6064,7 @@ func (x *EvpnMh) String() string {
 func (*EvpnMh) ProtoMessage() {}
 
 func (x *EvpnMh) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[59]
+	mi := &file_protocol_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5226,7 +6077,7 @@ func (x *EvpnMh) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EvpnMh.ProtoReflect.Descriptor instead.
 func (*EvpnMh) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{59}
+	return file_protocol_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *EvpnMh) GetEthernetSegmentId() string {
@@ -5372,7 +6223,7 @@ type RoutingInformationBase struct {
 
 func (x *RoutingInformationBase) Reset() {
 	*x = RoutingInformationBase{}
-	mi := &file_protocol_proto_msgTypes[60]
+	mi := &file_protocol_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5384,7 +6235,7 @@ func (x *RoutingInformationBase) String() string {
 func (*RoutingInformationBase) ProtoMessage() {}
 
 func (x *RoutingInformationBase) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[60]
+	mi := &file_protocol_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5397,7 +6248,7 @@ func (x *RoutingInformationBase) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutingInformationBase.ProtoReflect.Descriptor instead.
 func (*RoutingInformationBase) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{60}
+	return file_protocol_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *RoutingInformationBase) GetRoutes() map[string]*RouteEntry {
@@ -5416,7 +6267,7 @@ type RouteEntry struct {
 
 func (x *RouteEntry) Reset() {
 	*x = RouteEntry{}
-	mi := &file_protocol_proto_msgTypes[61]
+	mi := &file_protocol_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5428,7 +6279,7 @@ func (x *RouteEntry) String() string {
 func (*RouteEntry) ProtoMessage() {}
 
 func (x *RouteEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[61]
+	mi := &file_protocol_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5441,7 +6292,7 @@ func (x *RouteEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteEntry.ProtoReflect.Descriptor instead.
 func (*RouteEntry) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{61}
+	return file_protocol_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *RouteEntry) GetRoutes() []*Route {
@@ -5478,7 +6329,7 @@ type Route struct {
 
 func (x *Route) Reset() {
 	*x = Route{}
-	mi := &file_protocol_proto_msgTypes[62]
+	mi := &file_protocol_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5490,7 +6341,7 @@ func (x *Route) String() string {
 func (*Route) ProtoMessage() {}
 
 func (x *Route) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[62]
+	mi := &file_protocol_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5503,7 +6354,7 @@ func (x *Route) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Route.ProtoReflect.Descriptor instead.
 func (*Route) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{62}
+	return file_protocol_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *Route) GetPrefix() string {
@@ -5657,7 +6508,7 @@ type Nexthop struct {
 
 func (x *Nexthop) Reset() {
 	*x = Nexthop{}
-	mi := &file_protocol_proto_msgTypes[63]
+	mi := &file_protocol_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5669,7 +6520,7 @@ func (x *Nexthop) String() string {
 func (*Nexthop) ProtoMessage() {}
 
 func (x *Nexthop) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[63]
+	mi := &file_protocol_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5682,7 +6533,7 @@ func (x *Nexthop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Nexthop.ProtoReflect.Descriptor instead.
 func (*Nexthop) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{63}
+	return file_protocol_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Nexthop) GetFlags() int32 {
@@ -5766,7 +6617,7 @@ type RibFibSummaryRoutes struct {
 
 func (x *RibFibSummaryRoutes) Reset() {
 	*x = RibFibSummaryRoutes{}
-	mi := &file_protocol_proto_msgTypes[64]
+	mi := &file_protocol_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5778,7 +6629,7 @@ func (x *RibFibSummaryRoutes) String() string {
 func (*RibFibSummaryRoutes) ProtoMessage() {}
 
 func (x *RibFibSummaryRoutes) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[64]
+	mi := &file_protocol_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5791,7 +6642,7 @@ func (x *RibFibSummaryRoutes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RibFibSummaryRoutes.ProtoReflect.Descriptor instead.
 func (*RibFibSummaryRoutes) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{64}
+	return file_protocol_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *RibFibSummaryRoutes) GetRouteSummaries() []*RouteSummary {
@@ -5828,7 +6679,7 @@ type RouteSummary struct {
 
 func (x *RouteSummary) Reset() {
 	*x = RouteSummary{}
-	mi := &file_protocol_proto_msgTypes[65]
+	mi := &file_protocol_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5840,7 +6691,7 @@ func (x *RouteSummary) String() string {
 func (*RouteSummary) ProtoMessage() {}
 
 func (x *RouteSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[65]
+	mi := &file_protocol_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5853,7 +6704,7 @@ func (x *RouteSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteSummary.ProtoReflect.Descriptor instead.
 func (*RouteSummary) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{65}
+	return file_protocol_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *RouteSummary) GetFib() int32 {
@@ -5892,20 +6743,30 @@ func (x *RouteSummary) GetType() string {
 }
 
 // new
+// The anomaly fields are compatibility aliases of the findings of the built-in check of the same
+// name in check_results, clients should read the check results. No check fills rib_to_fib_anomaly.
 type AnomalyAnalysis struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	RouterAnomaly       *AnomalyDetection      `protobuf:"bytes,1,opt,name=router_anomaly,json=routerAnomaly,proto3" json:"router_anomaly,omitempty"`
-	ExternalAnomaly     *AnomalyDetection      `protobuf:"bytes,2,opt,name=external_anomaly,json=externalAnomaly,proto3" json:"external_anomaly,omitempty"`
-	NssaExternalAnomaly *AnomalyDetection      `protobuf:"bytes,3,opt,name=nssa_external_anomaly,json=nssaExternalAnomaly,proto3" json:"nssa_external_anomaly,omitempty"`
-	LsdbToRibAnomaly    *AnomalyDetection      `protobuf:"bytes,4,opt,name=lsdb_to_rib_anomaly,json=lsdbToRibAnomaly,proto3" json:"lsdb_to_rib_anomaly,omitempty"`
-	RibToFibAnomaly     *AnomalyDetection      `protobuf:"bytes,5,opt,name=rib_to_fib_anomaly,json=ribToFibAnomaly,proto3" json:"rib_to_fib_anomaly,omitempty"`
+	state               protoimpl.MessageState      `protogen:"open.v1"`
+	RouterAnomaly       *AnomalyDetection           `protobuf:"bytes,1,opt,name=router_anomaly,json=routerAnomaly,proto3" json:"router_anomaly,omitempty"`
+	ExternalAnomaly     *AnomalyDetection           `protobuf:"bytes,2,opt,name=external_anomaly,json=externalAnomaly,proto3" json:"external_anomaly,omitempty"`
+	NssaExternalAnomaly *AnomalyDetection           `protobuf:"bytes,3,opt,name=nssa_external_anomaly,json=nssaExternalAnomaly,proto3" json:"nssa_external_anomaly,omitempty"`
+	LsdbToRibAnomaly    *AnomalyDetection           `protobuf:"bytes,4,opt,name=lsdb_to_rib_anomaly,json=lsdbToRibAnomaly,proto3" json:"lsdb_to_rib_anomaly,omitempty"`
+	RibToFibAnomaly     *AnomalyDetection           `protobuf:"bytes,5,opt,name=rib_to_fib_anomaly,json=ribToFibAnomaly,proto3" json:"rib_to_fib_anomaly,omitempty"`
+	SummaryAnomaly      *AnomalyDetection           `protobuf:"bytes,6,opt,name=summary_anomaly,json=summaryAnomaly,proto3" json:"summary_anomaly,omitempty"`
+	AsbrSummaryAnomaly  *AnomalyDetection           `protobuf:"bytes,7,opt,name=asbr_summary_anomaly,json=asbrSummaryAnomaly,proto3" json:"asbr_summary_anomaly,omitempty"`
+	EcmpAnomaly         *AnomalyDetection           `protobuf:"bytes,8,opt,name=ecmp_anomaly,json=ecmpAnomaly,proto3" json:"ecmp_anomaly,omitempty"`
+	Lifecycle           []*AnomalyLifecycle         `protobuf:"bytes,9,rep,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	Acknowledgements    map[string]*Acknowledgement `protobuf:"bytes,10,rep,name=acknowledgements,proto3" json:"acknowledgements,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by anomaly id
+	IntentAnomaly       *AnomalyDetection           `protobuf:"bytes,11,opt,name=intent_anomaly,json=intentAnomaly,proto3" json:"intent_anomaly,omitempty"`
+	CheckResults        []*CheckResult              `protobuf:"bytes,12,rep,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`    // one entry per registered check, in execution order
+	AreaAnomalies       []*AreaAnomalies            `protobuf:"bytes,13,rep,name=area_anomalies,json=areaAnomalies,proto3" json:"area_anomalies,omitempty"` // ordered by area, anomalies without area last
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *AnomalyAnalysis) Reset() {
 	*x = AnomalyAnalysis{}
-	mi := &file_protocol_proto_msgTypes[66]
+	mi := &file_protocol_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5917,7 +6778,7 @@ func (x *AnomalyAnalysis) String() string {
 func (*AnomalyAnalysis) ProtoMessage() {}
 
 func (x *AnomalyAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[66]
+	mi := &file_protocol_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5930,7 +6791,7 @@ func (x *AnomalyAnalysis) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnomalyAnalysis.ProtoReflect.Descriptor instead.
 func (*AnomalyAnalysis) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{66}
+	return file_protocol_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *AnomalyAnalysis) GetRouterAnomaly() *AnomalyDetection {
@@ -5968,127 +6829,90 @@ func (x *AnomalyAnalysis) GetRibToFibAnomaly() *AnomalyDetection {
 	return nil
 }
 
-type AnomalyDetection struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	HasOverAdvertisedPrefixes bool                   `protobuf:"varint,1,opt,name=HasOverAdvertisedPrefixes,proto3" json:"HasOverAdvertisedPrefixes,omitempty"`
-	HasUnAdvertisedPrefixes   bool                   `protobuf:"varint,2,opt,name=HasUnAdvertisedPrefixes,proto3" json:"HasUnAdvertisedPrefixes,omitempty"`
-	HasDuplicatePrefixes      bool                   `protobuf:"varint,3,opt,name=HasDuplicatePrefixes,proto3" json:"HasDuplicatePrefixes,omitempty"`
-	HasMisconfiguredPrefixes  bool                   `protobuf:"varint,4,opt,name=HasMisconfiguredPrefixes,proto3" json:"HasMisconfiguredPrefixes,omitempty"`
-	SuperfluousEntries        []*Advertisement       `protobuf:"bytes,5,rep,name=superfluous_entries,json=superfluousEntries,proto3" json:"superfluous_entries,omitempty"`
-	MissingEntries            []*Advertisement       `protobuf:"bytes,6,rep,name=missing_entries,json=missingEntries,proto3" json:"missing_entries,omitempty"`
-	DuplicateEntries          []*Advertisement       `protobuf:"bytes,7,rep,name=duplicate_entries,json=duplicateEntries,proto3" json:"duplicate_entries,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
-}
-
-func (x *AnomalyDetection) Reset() {
-	*x = AnomalyDetection{}
-	mi := &file_protocol_proto_msgTypes[67]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AnomalyDetection) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AnomalyDetection) ProtoMessage() {}
-
-func (x *AnomalyDetection) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[67]
+func (x *AnomalyAnalysis) GetSummaryAnomaly() *AnomalyDetection {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SummaryAnomaly
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AnomalyDetection.ProtoReflect.Descriptor instead.
-func (*AnomalyDetection) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{67}
+	return nil
 }
 
-func (x *AnomalyDetection) GetHasOverAdvertisedPrefixes() bool {
+func (x *AnomalyAnalysis) GetAsbrSummaryAnomaly() *AnomalyDetection {
 	if x != nil {
-		return x.HasOverAdvertisedPrefixes
+		return x.AsbrSummaryAnomaly
 	}
-	return false
+	return nil
 }
 
-func (x *AnomalyDetection) GetHasUnAdvertisedPrefixes() bool {
+func (x *AnomalyAnalysis) GetEcmpAnomaly() *AnomalyDetection {
 	if x != nil {
-		return x.HasUnAdvertisedPrefixes
+		return x.EcmpAnomaly
 	}
-	return false
+	return nil
 }
 
-func (x *AnomalyDetection) GetHasDuplicatePrefixes() bool {
+func (x *AnomalyAnalysis) GetLifecycle() []*AnomalyLifecycle {
 	if x != nil {
-		return x.HasDuplicatePrefixes
+		return x.Lifecycle
 	}
-	return false
+	return nil
 }
 
-func (x *AnomalyDetection) GetHasMisconfiguredPrefixes() bool {
+func (x *AnomalyAnalysis) GetAcknowledgements() map[string]*Acknowledgement {
 	if x != nil {
-		return x.HasMisconfiguredPrefixes
+		return x.Acknowledgements
 	}
-	return false
+	return nil
 }
 
-func (x *AnomalyDetection) GetSuperfluousEntries() []*Advertisement {
+func (x *AnomalyAnalysis) GetIntentAnomaly() *AnomalyDetection {
 	if x != nil {
-		return x.SuperfluousEntries
+		return x.IntentAnomaly
 	}
 	return nil
 }
 
-func (x *AnomalyDetection) GetMissingEntries() []*Advertisement {
+func (x *AnomalyAnalysis) GetCheckResults() []*CheckResult {
 	if x != nil {
-		return x.MissingEntries
+		return x.CheckResults
 	}
 	return nil
 }
 
-func (x *AnomalyDetection) GetDuplicateEntries() []*Advertisement {
+func (x *AnomalyAnalysis) GetAreaAnomalies() []*AreaAnomalies {
 	if x != nil {
-		return x.DuplicateEntries
+		return x.AreaAnomalies
 	}
 	return nil
 }
 
-type Advertisement struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	InterfaceAddress string                 `protobuf:"bytes,1,opt,name=InterfaceAddress,proto3" json:"InterfaceAddress,omitempty"`
-	LinkStateId      string                 `protobuf:"bytes,2,opt,name=LinkStateId,proto3" json:"LinkStateId,omitempty"`
-	PrefixLength     string                 `protobuf:"bytes,3,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
-	LinkType         string                 `protobuf:"bytes,4,opt,name=LinkType,proto3" json:"LinkType,omitempty"`
-	PBit             bool                   `protobuf:"varint,5,opt,name=PBit,proto3" json:"PBit,omitempty"`
-	Options          string                 `protobuf:"bytes,6,opt,name=Options,proto3" json:"Options,omitempty"`
-	Ospf             bool                   `protobuf:"varint,7,opt,name=Ospf,proto3" json:"Ospf,omitempty"`
-	OspfArea         string                 `protobuf:"bytes,8,opt,name=ospf_area,json=ospfArea,proto3" json:"ospf_area,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// AreaAnomalies groups the anomalies of all sources by the OSPF area of the entry.
+// Anomalies without an area, e.g. of AS external LSAs or the FIB, are grouped under "none".
+type AreaAnomalies struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Area          string                       `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Sources       map[string]*AnomalyDetection `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by anomaly source
+	Total         uint32                       `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Actionable    uint32                       `protobuf:"varint,4,opt,name=actionable,proto3" json:"actionable,omitempty"` // neither suppressed nor acknowledged
+	Critical      uint32                       `protobuf:"varint,5,opt,name=critical,proto3" json:"critical,omitempty"`     // actionable with severity critical
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Advertisement) Reset() {
-	*x = Advertisement{}
-	mi := &file_protocol_proto_msgTypes[68]
+func (x *AreaAnomalies) Reset() {
+	*x = AreaAnomalies{}
+	mi := &file_protocol_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Advertisement) String() string {
+func (x *AreaAnomalies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Advertisement) ProtoMessage() {}
+func (*AreaAnomalies) ProtoMessage() {}
 
-func (x *Advertisement) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[68]
+func (x *AreaAnomalies) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6099,90 +6923,68 @@ func (x *Advertisement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Advertisement.ProtoReflect.Descriptor instead.
-func (*Advertisement) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *Advertisement) GetInterfaceAddress() string {
-	if x != nil {
-		return x.InterfaceAddress
-	}
-	return ""
-}
-
-func (x *Advertisement) GetLinkStateId() string {
-	if x != nil {
-		return x.LinkStateId
-	}
-	return ""
-}
-
-func (x *Advertisement) GetPrefixLength() string {
-	if x != nil {
-		return x.PrefixLength
-	}
-	return ""
+// Deprecated: Use AreaAnomalies.ProtoReflect.Descriptor instead.
+func (*AreaAnomalies) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Advertisement) GetLinkType() string {
+func (x *AreaAnomalies) GetArea() string {
 	if x != nil {
-		return x.LinkType
+		return x.Area
 	}
 	return ""
 }
 
-func (x *Advertisement) GetPBit() bool {
+func (x *AreaAnomalies) GetSources() map[string]*AnomalyDetection {
 	if x != nil {
-		return x.PBit
+		return x.Sources
 	}
-	return false
+	return nil
 }
 
-func (x *Advertisement) GetOptions() string {
+func (x *AreaAnomalies) GetTotal() uint32 {
 	if x != nil {
-		return x.Options
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *Advertisement) GetOspf() bool {
+func (x *AreaAnomalies) GetActionable() uint32 {
 	if x != nil {
-		return x.Ospf
+		return x.Actionable
 	}
-	return false
+	return 0
 }
 
-func (x *Advertisement) GetOspfArea() string {
+func (x *AreaAnomalies) GetCritical() uint32 {
 	if x != nil {
-		return x.OspfArea
+		return x.Critical
 	}
-	return ""
+	return 0
 }
 
-type AccessListAnalyzer struct {
+type AreaAnomaliesList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccessList    string                 `protobuf:"bytes,1,opt,name=AccessList,proto3" json:"AccessList,omitempty"`
-	AclEntry      []*ACLEntry            `protobuf:"bytes,2,rep,name=acl_entry,json=aclEntry,proto3" json:"acl_entry,omitempty"`
+	Areas         []*AreaAnomalies       `protobuf:"bytes,1,rep,name=areas,proto3" json:"areas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessListAnalyzer) Reset() {
-	*x = AccessListAnalyzer{}
-	mi := &file_protocol_proto_msgTypes[69]
+func (x *AreaAnomaliesList) Reset() {
+	*x = AreaAnomaliesList{}
+	mi := &file_protocol_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessListAnalyzer) String() string {
+func (x *AreaAnomaliesList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessListAnalyzer) ProtoMessage() {}
+func (*AreaAnomaliesList) ProtoMessage() {}
 
-func (x *AccessListAnalyzer) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[69]
+func (x *AreaAnomaliesList) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6193,51 +6995,48 @@ func (x *AccessListAnalyzer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessListAnalyzer.ProtoReflect.Descriptor instead.
-func (*AccessListAnalyzer) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *AccessListAnalyzer) GetAccessList() string {
-	if x != nil {
-		return x.AccessList
-	}
-	return ""
+// Deprecated: Use AreaAnomaliesList.ProtoReflect.Descriptor instead.
+func (*AreaAnomaliesList) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AccessListAnalyzer) GetAclEntry() []*ACLEntry {
+func (x *AreaAnomaliesList) GetAreas() []*AreaAnomalies {
 	if x != nil {
-		return x.AclEntry
+		return x.Areas
 	}
 	return nil
 }
 
-type ACLEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IPAddress     string                 `protobuf:"bytes,1,opt,name=IPAddress,proto3" json:"IPAddress,omitempty"`
-	PrefixLength  int32                  `protobuf:"varint,2,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
-	IsPermit      bool                   `protobuf:"varint,3,opt,name=IsPermit,proto3" json:"IsPermit,omitempty"`
-	Any           bool                   `protobuf:"varint,4,opt,name=Any,proto3" json:"Any,omitempty"`
-	Sequence      int32                  `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// CheckResult is the outcome of a single registered check of the last analysis cycle.
+type CheckResult struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // also the anomaly source of its findings
+	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Inputs               []string               `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Enabled              bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Builtin              bool                   `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"` // shipped with the daemon, its findings are aliased by a field of AnomalyAnalysis
+	DurationMicroseconds int64                  `protobuf:"varint,6,opt,name=duration_microseconds,json=durationMicroseconds,proto3" json:"duration_microseconds,omitempty"`
+	Error                string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Findings             *AnomalyDetection      `protobuf:"bytes,8,opt,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ACLEntry) Reset() {
-	*x = ACLEntry{}
-	mi := &file_protocol_proto_msgTypes[70]
+func (x *CheckResult) Reset() {
+	*x = CheckResult{}
+	mi := &file_protocol_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ACLEntry) String() string {
+func (x *CheckResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ACLEntry) ProtoMessage() {}
+func (*CheckResult) ProtoMessage() {}
 
-func (x *ACLEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[70]
+func (x *CheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6248,71 +7047,89 @@ func (x *ACLEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ACLEntry.ProtoReflect.Descriptor instead.
-func (*ACLEntry) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use CheckResult.ProtoReflect.Descriptor instead.
+func (*CheckResult) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ACLEntry) GetIPAddress() string {
+func (x *CheckResult) GetName() string {
 	if x != nil {
-		return x.IPAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ACLEntry) GetPrefixLength() int32 {
+func (x *CheckResult) GetDescription() string {
 	if x != nil {
-		return x.PrefixLength
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *ACLEntry) GetIsPermit() bool {
+func (x *CheckResult) GetInputs() []string {
 	if x != nil {
-		return x.IsPermit
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *CheckResult) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
 	return false
 }
 
-func (x *ACLEntry) GetAny() bool {
+func (x *CheckResult) GetBuiltin() bool {
 	if x != nil {
-		return x.Any
+		return x.Builtin
 	}
 	return false
 }
 
-func (x *ACLEntry) GetSequence() int32 {
+func (x *CheckResult) GetDurationMicroseconds() int64 {
 	if x != nil {
-		return x.Sequence
+		return x.DurationMicroseconds
 	}
 	return 0
 }
 
-type StaticList struct {
+func (x *CheckResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CheckResult) GetFindings() *AnomalyDetection {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type CheckResultList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IpAddress     string                 `protobuf:"bytes,1,opt,name=IpAddress,proto3" json:"IpAddress,omitempty"`
-	PrefixLength  int32                  `protobuf:"varint,2,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
-	NextHop       string                 `protobuf:"bytes,3,opt,name=NextHop,proto3" json:"NextHop,omitempty"`
-	Area          string                 `protobuf:"bytes,4,opt,name=Area,proto3" json:"Area,omitempty"`
+	CheckResults  []*CheckResult         `protobuf:"bytes,1,rep,name=check_results,json=checkResults,proto3" json:"check_results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StaticList) Reset() {
-	*x = StaticList{}
-	mi := &file_protocol_proto_msgTypes[71]
+func (x *CheckResultList) Reset() {
+	*x = CheckResultList{}
+	mi := &file_protocol_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StaticList) String() string {
+func (x *CheckResultList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StaticList) ProtoMessage() {}
+func (*CheckResultList) ProtoMessage() {}
 
-func (x *StaticList) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[71]
+func (x *CheckResultList) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6323,64 +7140,44 @@ func (x *StaticList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StaticList.ProtoReflect.Descriptor instead.
-func (*StaticList) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *StaticList) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
-}
-
-func (x *StaticList) GetPrefixLength() int32 {
-	if x != nil {
-		return x.PrefixLength
-	}
-	return 0
-}
-
-func (x *StaticList) GetNextHop() string {
-	if x != nil {
-		return x.NextHop
-	}
-	return ""
+// Deprecated: Use CheckResultList.ProtoReflect.Descriptor instead.
+func (*CheckResultList) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *StaticList) GetArea() string {
+func (x *CheckResultList) GetCheckResults() []*CheckResult {
 	if x != nil {
-		return x.Area
+		return x.CheckResults
 	}
-	return ""
+	return nil
 }
 
-type IntraAreaLsa struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	RouterId      string                 `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	RouterType    string                 `protobuf:"bytes,3,opt,name=router_type,json=routerType,proto3" json:"router_type,omitempty"`
-	Areas         []*AreaAnalyzer        `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Acknowledgement silences a single anomaly until it expires.
+type Acknowledgement struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AcknowledgedAt int64                  `protobuf:"varint,2,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	ExpiresAt      int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Comment        string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *IntraAreaLsa) Reset() {
-	*x = IntraAreaLsa{}
-	mi := &file_protocol_proto_msgTypes[72]
+func (x *Acknowledgement) Reset() {
+	*x = Acknowledgement{}
+	mi := &file_protocol_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IntraAreaLsa) String() string {
+func (x *Acknowledgement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IntraAreaLsa) ProtoMessage() {}
+func (*Acknowledgement) ProtoMessage() {}
 
-func (x *IntraAreaLsa) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[72]
+func (x *Acknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6391,64 +7188,79 @@ func (x *IntraAreaLsa) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IntraAreaLsa.ProtoReflect.Descriptor instead.
-func (*IntraAreaLsa) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use Acknowledgement.ProtoReflect.Descriptor instead.
+func (*Acknowledgement) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *IntraAreaLsa) GetHostname() string {
+func (x *Acknowledgement) GetId() string {
 	if x != nil {
-		return x.Hostname
+		return x.Id
 	}
 	return ""
 }
 
-func (x *IntraAreaLsa) GetRouterId() string {
+func (x *Acknowledgement) GetAcknowledgedAt() int64 {
 	if x != nil {
-		return x.RouterId
+		return x.AcknowledgedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *IntraAreaLsa) GetRouterType() string {
+func (x *Acknowledgement) GetExpiresAt() int64 {
 	if x != nil {
-		return x.RouterType
+		return x.ExpiresAt
 	}
-	return ""
+	return 0
 }
 
-func (x *IntraAreaLsa) GetAreas() []*AreaAnalyzer {
+func (x *Acknowledgement) GetComment() string {
 	if x != nil {
-		return x.Areas
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-type InterAreaLsa struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	RouterId      string                 `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	RouterType    string                 `protobuf:"bytes,3,opt,name=router_type,json=routerType,proto3" json:"router_type,omitempty"`
-	Areas         []*AreaAnalyzer        `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AnomalyLifecycle tracks a single anomaly across analysis cycles.
+// Timestamps are unix seconds, resolved_at is 0 while the anomaly is active.
+type AnomalyLifecycle struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // source:anomaly_type:prefix:area
+	Source            string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	AnomalyType       string                 `protobuf:"bytes,3,opt,name=anomaly_type,json=anomalyType,proto3" json:"anomaly_type,omitempty"` // overadvertised, unadvertised, duplicate or misconfigured
+	Prefix            string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Area              string                 `protobuf:"bytes,5,opt,name=area,proto3" json:"area,omitempty"`
+	FirstSeen         int64                  `protobuf:"varint,6,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen          int64                  `protobuf:"varint,7,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	ResolvedAt        int64                  `protobuf:"varint,8,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	ActiveSince       int64                  `protobuf:"varint,9,opt,name=active_since,json=activeSince,proto3" json:"active_since,omitempty"`
+	DurationSeconds   int64                  `protobuf:"varint,10,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // duration of the current or last active period
+	FlapCount         uint32                 `protobuf:"varint,11,opt,name=flap_count,json=flapCount,proto3" json:"flap_count,omitempty"`
+	ConsecutiveCycles uint32                 `protobuf:"varint,12,opt,name=consecutive_cycles,json=consecutiveCycles,proto3" json:"consecutive_cycles,omitempty"`
+	Active            bool                   `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	Confirmed         bool                   `protobuf:"varint,14,opt,name=confirmed,proto3" json:"confirmed,omitempty"` // active for at least the configured hold-down cycles
+	Severity          string                 `protobuf:"bytes,15,opt,name=severity,proto3" json:"severity,omitempty"`
+	Suppressed        bool                   `protobuf:"varint,16,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	Acknowledged      bool                   `protobuf:"varint,17,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *InterAreaLsa) Reset() {
-	*x = InterAreaLsa{}
-	mi := &file_protocol_proto_msgTypes[73]
+func (x *AnomalyLifecycle) Reset() {
+	*x = AnomalyLifecycle{}
+	mi := &file_protocol_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterAreaLsa) String() string {
+func (x *AnomalyLifecycle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterAreaLsa) ProtoMessage() {}
+func (*AnomalyLifecycle) ProtoMessage() {}
 
-func (x *InterAreaLsa) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[73]
+func (x *AnomalyLifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6459,64 +7271,1397 @@ func (x *InterAreaLsa) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterAreaLsa.ProtoReflect.Descriptor instead.
-func (*InterAreaLsa) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use AnomalyLifecycle.ProtoReflect.Descriptor instead.
+func (*AnomalyLifecycle) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *InterAreaLsa) GetHostname() string {
+func (x *AnomalyLifecycle) GetId() string {
 	if x != nil {
-		return x.Hostname
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InterAreaLsa) GetRouterId() string {
+func (x *AnomalyLifecycle) GetSource() string {
 	if x != nil {
-		return x.RouterId
+		return x.Source
 	}
 	return ""
 }
 
-func (x *InterAreaLsa) GetRouterType() string {
+func (x *AnomalyLifecycle) GetAnomalyType() string {
 	if x != nil {
-		return x.RouterType
+		return x.AnomalyType
 	}
 	return ""
 }
 
-func (x *InterAreaLsa) GetAreas() []*AreaAnalyzer {
+func (x *AnomalyLifecycle) GetPrefix() string {
 	if x != nil {
-		return x.Areas
+		return x.Prefix
 	}
-	return nil
-}
-
-type AreaAnalyzer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AreaName      string                 `protobuf:"bytes,1,opt,name=area_name,json=areaName,proto3" json:"area_name,omitempty"`
-	LsaType       string                 `protobuf:"bytes,2,opt,name=lsa_type,json=lsaType,proto3" json:"lsa_type,omitempty"`
-	AreaType      string                 `protobuf:"bytes,3,opt,name=area_type,json=areaType,proto3" json:"area_type,omitempty"`
-	Links         []*Advertisement       `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
+}
+
+func (x *AnomalyLifecycle) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *AnomalyLifecycle) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetResolvedAt() int64 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetActiveSince() int64 {
+	if x != nil {
+		return x.ActiveSince
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetFlapCount() uint32 {
+	if x != nil {
+		return x.FlapCount
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetConsecutiveCycles() uint32 {
+	if x != nil {
+		return x.ConsecutiveCycles
+	}
+	return 0
+}
+
+func (x *AnomalyLifecycle) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AnomalyLifecycle) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *AnomalyLifecycle) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AnomalyLifecycle) GetSuppressed() bool {
+	if x != nil {
+		return x.Suppressed
+	}
+	return false
+}
+
+func (x *AnomalyLifecycle) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+type AnomalyLifecycleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*AnomalyLifecycle    `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyLifecycleList) Reset() {
+	*x = AnomalyLifecycleList{}
+	mi := &file_protocol_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyLifecycleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyLifecycleList) ProtoMessage() {}
+
+func (x *AnomalyLifecycleList) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyLifecycleList.ProtoReflect.Descriptor instead.
+func (*AnomalyLifecycleList) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AnomalyLifecycleList) GetAnomalies() []*AnomalyLifecycle {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type LintFinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName      string                 `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"` // info, warning or critical
+	Object        string                 `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`     // configuration object, e.g. route-map lanroutes
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintFinding) Reset() {
+	*x = LintFinding{}
+	mi := &file_protocol_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintFinding) ProtoMessage() {}
+
+func (x *LintFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintFinding.ProtoReflect.Descriptor instead.
+func (*LintFinding) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *LintFinding) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *LintFinding) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *LintFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *LintFinding) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *LintFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type LintResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Findings      []*LintFinding         `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LintResult) Reset() {
+	*x = LintResult{}
+	mi := &file_protocol_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintResult) ProtoMessage() {}
+
+func (x *LintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintResult.ProtoReflect.Descriptor instead.
+func (*LintResult) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *LintResult) GetFindings() []*LintFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+type AnomalyDetection struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	HasOverAdvertisedPrefixes bool                   `protobuf:"varint,1,opt,name=HasOverAdvertisedPrefixes,proto3" json:"HasOverAdvertisedPrefixes,omitempty"`
+	HasUnAdvertisedPrefixes   bool                   `protobuf:"varint,2,opt,name=HasUnAdvertisedPrefixes,proto3" json:"HasUnAdvertisedPrefixes,omitempty"`
+	HasDuplicatePrefixes      bool                   `protobuf:"varint,3,opt,name=HasDuplicatePrefixes,proto3" json:"HasDuplicatePrefixes,omitempty"`
+	HasMisconfiguredPrefixes  bool                   `protobuf:"varint,4,opt,name=HasMisconfiguredPrefixes,proto3" json:"HasMisconfiguredPrefixes,omitempty"`
+	SuperfluousEntries        []*Advertisement       `protobuf:"bytes,5,rep,name=superfluous_entries,json=superfluousEntries,proto3" json:"superfluous_entries,omitempty"`
+	MissingEntries            []*Advertisement       `protobuf:"bytes,6,rep,name=missing_entries,json=missingEntries,proto3" json:"missing_entries,omitempty"`
+	DuplicateEntries          []*Advertisement       `protobuf:"bytes,7,rep,name=duplicate_entries,json=duplicateEntries,proto3" json:"duplicate_entries,omitempty"`
+	MisconfiguredEntries      []*Advertisement       `protobuf:"bytes,8,rep,name=misconfigured_entries,json=misconfiguredEntries,proto3" json:"misconfigured_entries,omitempty"`
+	// deviations with an expected cause, e.g. OSPF routes shadowed by a better administrative distance, they are no anomalies
+	ExplainedEntries []*Advertisement `protobuf:"bytes,9,rep,name=explained_entries,json=explainedEntries,proto3" json:"explained_entries,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AnomalyDetection) Reset() {
+	*x = AnomalyDetection{}
+	mi := &file_protocol_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyDetection) ProtoMessage() {}
+
+func (x *AnomalyDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyDetection.ProtoReflect.Descriptor instead.
+func (*AnomalyDetection) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AnomalyDetection) GetHasOverAdvertisedPrefixes() bool {
+	if x != nil {
+		return x.HasOverAdvertisedPrefixes
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetHasUnAdvertisedPrefixes() bool {
+	if x != nil {
+		return x.HasUnAdvertisedPrefixes
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetHasDuplicatePrefixes() bool {
+	if x != nil {
+		return x.HasDuplicatePrefixes
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetHasMisconfiguredPrefixes() bool {
+	if x != nil {
+		return x.HasMisconfiguredPrefixes
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetSuperfluousEntries() []*Advertisement {
+	if x != nil {
+		return x.SuperfluousEntries
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetMissingEntries() []*Advertisement {
+	if x != nil {
+		return x.MissingEntries
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetDuplicateEntries() []*Advertisement {
+	if x != nil {
+		return x.DuplicateEntries
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetMisconfiguredEntries() []*Advertisement {
+	if x != nil {
+		return x.MisconfiguredEntries
+	}
+	return nil
+}
+
+func (x *AnomalyDetection) GetExplainedEntries() []*Advertisement {
+	if x != nil {
+		return x.ExplainedEntries
+	}
+	return nil
+}
+
+type Advertisement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InterfaceAddress  string                 `protobuf:"bytes,1,opt,name=InterfaceAddress,proto3" json:"InterfaceAddress,omitempty"`
+	LinkStateId       string                 `protobuf:"bytes,2,opt,name=LinkStateId,proto3" json:"LinkStateId,omitempty"`
+	PrefixLength      string                 `protobuf:"bytes,3,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
+	LinkType          string                 `protobuf:"bytes,4,opt,name=LinkType,proto3" json:"LinkType,omitempty"`
+	PBit              bool                   `protobuf:"varint,5,opt,name=PBit,proto3" json:"PBit,omitempty"`
+	Options           string                 `protobuf:"bytes,6,opt,name=Options,proto3" json:"Options,omitempty"`
+	Ospf              bool                   `protobuf:"varint,7,opt,name=Ospf,proto3" json:"Ospf,omitempty"`
+	OspfArea          string                 `protobuf:"bytes,8,opt,name=ospf_area,json=ospfArea,proto3" json:"ospf_area,omitempty"`
+	Metric            int32                  `protobuf:"varint,9,opt,name=metric,proto3" json:"metric,omitempty"`
+	Reason            string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	RouteSelection    string                 `protobuf:"bytes,11,opt,name=route_selection,json=routeSelection,proto3" json:"route_selection,omitempty"` // shadowed, not-selected or missing
+	Severity          string                 `protobuf:"bytes,12,opt,name=severity,proto3" json:"severity,omitempty"`                                   // info, warning or critical
+	Suppressed        bool                   `protobuf:"varint,13,opt,name=suppressed,proto3" json:"suppressed,omitempty"`
+	Acknowledged      bool                   `protobuf:"varint,14,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	SuppressionReason string                 `protobuf:"bytes,15,opt,name=suppression_reason,json=suppressionReason,proto3" json:"suppression_reason,omitempty"` // matching suppression rule or acknowledgement comment
+	ReasonCode        string                 `protobuf:"bytes,16,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`                      // machine-readable root cause, e.g. interface_down
+	Explanation       string                 `protobuf:"bytes,17,opt,name=explanation,proto3" json:"explanation,omitempty"`                                      // human-readable root cause built from the collected data
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Advertisement) Reset() {
+	*x = Advertisement{}
+	mi := &file_protocol_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertisement) ProtoMessage() {}
+
+func (x *Advertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertisement.ProtoReflect.Descriptor instead.
+func (*Advertisement) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Advertisement) GetInterfaceAddress() string {
+	if x != nil {
+		return x.InterfaceAddress
+	}
+	return ""
+}
+
+func (x *Advertisement) GetLinkStateId() string {
+	if x != nil {
+		return x.LinkStateId
+	}
+	return ""
+}
+
+func (x *Advertisement) GetPrefixLength() string {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return ""
+}
+
+func (x *Advertisement) GetLinkType() string {
+	if x != nil {
+		return x.LinkType
+	}
+	return ""
+}
+
+func (x *Advertisement) GetPBit() bool {
+	if x != nil {
+		return x.PBit
+	}
+	return false
+}
+
+func (x *Advertisement) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *Advertisement) GetOspf() bool {
+	if x != nil {
+		return x.Ospf
+	}
+	return false
+}
+
+func (x *Advertisement) GetOspfArea() string {
+	if x != nil {
+		return x.OspfArea
+	}
+	return ""
+}
+
+func (x *Advertisement) GetMetric() int32 {
+	if x != nil {
+		return x.Metric
+	}
+	return 0
+}
+
+func (x *Advertisement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Advertisement) GetRouteSelection() string {
+	if x != nil {
+		return x.RouteSelection
+	}
+	return ""
+}
+
+func (x *Advertisement) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Advertisement) GetSuppressed() bool {
+	if x != nil {
+		return x.Suppressed
+	}
+	return false
+}
+
+func (x *Advertisement) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *Advertisement) GetSuppressionReason() string {
+	if x != nil {
+		return x.SuppressionReason
+	}
+	return ""
+}
+
+func (x *Advertisement) GetReasonCode() string {
+	if x != nil {
+		return x.ReasonCode
+	}
+	return ""
+}
+
+func (x *Advertisement) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type AccessListAnalyzer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessList    string                 `protobuf:"bytes,1,opt,name=AccessList,proto3" json:"AccessList,omitempty"`
+	AclEntry      []*ACLEntry            `protobuf:"bytes,2,rep,name=acl_entry,json=aclEntry,proto3" json:"acl_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessListAnalyzer) Reset() {
+	*x = AccessListAnalyzer{}
+	mi := &file_protocol_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessListAnalyzer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessListAnalyzer) ProtoMessage() {}
+
+func (x *AccessListAnalyzer) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessListAnalyzer.ProtoReflect.Descriptor instead.
+func (*AccessListAnalyzer) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AccessListAnalyzer) GetAccessList() string {
+	if x != nil {
+		return x.AccessList
+	}
+	return ""
+}
+
+func (x *AccessListAnalyzer) GetAclEntry() []*ACLEntry {
+	if x != nil {
+		return x.AclEntry
+	}
+	return nil
+}
+
+type ACLEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IPAddress     string                 `protobuf:"bytes,1,opt,name=IPAddress,proto3" json:"IPAddress,omitempty"`
+	PrefixLength  int32                  `protobuf:"varint,2,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
+	IsPermit      bool                   `protobuf:"varint,3,opt,name=IsPermit,proto3" json:"IsPermit,omitempty"`
+	Any           bool                   `protobuf:"varint,4,opt,name=Any,proto3" json:"Any,omitempty"`
+	Sequence      int32                  `protobuf:"varint,5,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ACLEntry) Reset() {
+	*x = ACLEntry{}
+	mi := &file_protocol_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ACLEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ACLEntry) ProtoMessage() {}
+
+func (x *ACLEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ACLEntry.ProtoReflect.Descriptor instead.
+func (*ACLEntry) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ACLEntry) GetIPAddress() string {
+	if x != nil {
+		return x.IPAddress
+	}
+	return ""
+}
+
+func (x *ACLEntry) GetPrefixLength() int32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *ACLEntry) GetIsPermit() bool {
+	if x != nil {
+		return x.IsPermit
+	}
+	return false
+}
+
+func (x *ACLEntry) GetAny() bool {
+	if x != nil {
+		return x.Any
+	}
+	return false
+}
+
+func (x *ACLEntry) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type StaticList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IpAddress     string                 `protobuf:"bytes,1,opt,name=IpAddress,proto3" json:"IpAddress,omitempty"`
+	PrefixLength  int32                  `protobuf:"varint,2,opt,name=PrefixLength,proto3" json:"PrefixLength,omitempty"`
+	NextHop       string                 `protobuf:"bytes,3,opt,name=NextHop,proto3" json:"NextHop,omitempty"`
+	Area          string                 `protobuf:"bytes,4,opt,name=Area,proto3" json:"Area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaticList) Reset() {
+	*x = StaticList{}
+	mi := &file_protocol_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaticList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticList) ProtoMessage() {}
+
+func (x *StaticList) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticList.ProtoReflect.Descriptor instead.
+func (*StaticList) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StaticList) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *StaticList) GetPrefixLength() int32 {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return 0
+}
+
+func (x *StaticList) GetNextHop() string {
+	if x != nil {
+		return x.NextHop
+	}
+	return ""
+}
+
+func (x *StaticList) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+type IntraAreaLsa struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	RouterId      string                 `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	RouterType    string                 `protobuf:"bytes,3,opt,name=router_type,json=routerType,proto3" json:"router_type,omitempty"`
+	Areas         []*AreaAnalyzer        `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntraAreaLsa) Reset() {
+	*x = IntraAreaLsa{}
+	mi := &file_protocol_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntraAreaLsa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntraAreaLsa) ProtoMessage() {}
+
+func (x *IntraAreaLsa) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntraAreaLsa.ProtoReflect.Descriptor instead.
+func (*IntraAreaLsa) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *IntraAreaLsa) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *IntraAreaLsa) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *IntraAreaLsa) GetRouterType() string {
+	if x != nil {
+		return x.RouterType
+	}
+	return ""
+}
+
+func (x *IntraAreaLsa) GetAreas() []*AreaAnalyzer {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+type InterAreaLsa struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	RouterId      string                 `protobuf:"bytes,2,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	RouterType    string                 `protobuf:"bytes,3,opt,name=router_type,json=routerType,proto3" json:"router_type,omitempty"`
+	Areas         []*AreaAnalyzer        `protobuf:"bytes,4,rep,name=areas,proto3" json:"areas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterAreaLsa) Reset() {
+	*x = InterAreaLsa{}
+	mi := &file_protocol_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterAreaLsa) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterAreaLsa) ProtoMessage() {}
+
+func (x *InterAreaLsa) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterAreaLsa.ProtoReflect.Descriptor instead.
+func (*InterAreaLsa) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *InterAreaLsa) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *InterAreaLsa) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *InterAreaLsa) GetRouterType() string {
+	if x != nil {
+		return x.RouterType
+	}
+	return ""
+}
+
+func (x *InterAreaLsa) GetAreas() []*AreaAnalyzer {
+	if x != nil {
+		return x.Areas
+	}
+	return nil
+}
+
+type AreaAnalyzer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AreaName      string                 `protobuf:"bytes,1,opt,name=area_name,json=areaName,proto3" json:"area_name,omitempty"`
+	LsaType       string                 `protobuf:"bytes,2,opt,name=lsa_type,json=lsaType,proto3" json:"lsa_type,omitempty"`
+	AreaType      string                 `protobuf:"bytes,3,opt,name=area_type,json=areaType,proto3" json:"area_type,omitempty"`
+	Links         []*Advertisement       `protobuf:"bytes,4,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AreaAnalyzer) Reset() {
 	*x = AreaAnalyzer{}
-	mi := &file_protocol_proto_msgTypes[74]
+	mi := &file_protocol_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AreaAnalyzer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaAnalyzer) ProtoMessage() {}
+
+func (x *AreaAnalyzer) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaAnalyzer.ProtoReflect.Descriptor instead.
+func (*AreaAnalyzer) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AreaAnalyzer) GetAreaName() string {
+	if x != nil {
+		return x.AreaName
+	}
+	return ""
+}
+
+func (x *AreaAnalyzer) GetLsaType() string {
+	if x != nil {
+		return x.LsaType
+	}
+	return ""
+}
+
+func (x *AreaAnalyzer) GetAreaType() string {
+	if x != nil {
+		return x.AreaType
+	}
+	return ""
+}
+
+func (x *AreaAnalyzer) GetLinks() []*Advertisement {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type RibPrefixes struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Prefix         string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	PrefixLength   string                 `protobuf:"bytes,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
+	Protocol       string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	NextHopAddress string                 `protobuf:"bytes,4,opt,name=next_hop_address,json=nextHopAddress,proto3" json:"next_hop_address,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RibPrefixes) Reset() {
+	*x = RibPrefixes{}
+	mi := &file_protocol_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RibPrefixes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RibPrefixes) ProtoMessage() {}
+
+func (x *RibPrefixes) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RibPrefixes.ProtoReflect.Descriptor instead.
+func (*RibPrefixes) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RibPrefixes) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *RibPrefixes) GetPrefixLength() string {
+	if x != nil {
+		return x.PrefixLength
+	}
+	return ""
+}
+
+func (x *RibPrefixes) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *RibPrefixes) GetNextHopAddress() string {
+	if x != nil {
+		return x.NextHopAddress
+	}
+	return ""
+}
+
+type ParsedAnalyzerData struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ShouldRouterLsdb       *IntraAreaLsa          `protobuf:"bytes,1,opt,name=should_router_lsdb,json=shouldRouterLsdb,proto3" json:"should_router_lsdb,omitempty"`
+	ShouldExternalLsdb     *InterAreaLsa          `protobuf:"bytes,2,opt,name=should_external_lsdb,json=shouldExternalLsdb,proto3" json:"should_external_lsdb,omitempty"`
+	ShouldNssaExternalLsdb *InterAreaLsa          `protobuf:"bytes,3,opt,name=should_nssa_external_lsdb,json=shouldNssaExternalLsdb,proto3" json:"should_nssa_external_lsdb,omitempty"`
+	P2PMap                 *PeerInterfaceMap      `protobuf:"bytes,4,opt,name=p2p_map,json=p2pMap,proto3" json:"p2p_map,omitempty"`
+	ShouldSummaryLsdb      *InterAreaLsa          `protobuf:"bytes,5,opt,name=should_summary_lsdb,json=shouldSummaryLsdb,proto3" json:"should_summary_lsdb,omitempty"`
+	ShouldAsbrSummaryLsdb  *InterAreaLsa          `protobuf:"bytes,6,opt,name=should_asbr_summary_lsdb,json=shouldAsbrSummaryLsdb,proto3" json:"should_asbr_summary_lsdb,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ParsedAnalyzerData) Reset() {
+	*x = ParsedAnalyzerData{}
+	mi := &file_protocol_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ParsedAnalyzerData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedAnalyzerData) ProtoMessage() {}
+
+func (x *ParsedAnalyzerData) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedAnalyzerData.ProtoReflect.Descriptor instead.
+func (*ParsedAnalyzerData) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ParsedAnalyzerData) GetShouldRouterLsdb() *IntraAreaLsa {
+	if x != nil {
+		return x.ShouldRouterLsdb
+	}
+	return nil
+}
+
+func (x *ParsedAnalyzerData) GetShouldExternalLsdb() *InterAreaLsa {
+	if x != nil {
+		return x.ShouldExternalLsdb
+	}
+	return nil
+}
+
+func (x *ParsedAnalyzerData) GetShouldNssaExternalLsdb() *InterAreaLsa {
+	if x != nil {
+		return x.ShouldNssaExternalLsdb
+	}
+	return nil
+}
+
+func (x *ParsedAnalyzerData) GetP2PMap() *PeerInterfaceMap {
+	if x != nil {
+		return x.P2PMap
+	}
+	return nil
+}
+
+func (x *ParsedAnalyzerData) GetShouldSummaryLsdb() *InterAreaLsa {
+	if x != nil {
+		return x.ShouldSummaryLsdb
+	}
+	return nil
+}
+
+func (x *ParsedAnalyzerData) GetShouldAsbrSummaryLsdb() *InterAreaLsa {
+	if x != nil {
+		return x.ShouldAsbrSummaryLsdb
+	}
+	return nil
+}
+
+// Main message containing the router information
+type OspfRouterInfo struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	RouterId         string                     `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
+	RouterLinkStates map[string]*AreaLinkStates `protobuf:"bytes,2,rep,name=router_link_states,json=routerLinkStates,proto3" json:"router_link_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OspfRouterInfo) Reset() {
+	*x = OspfRouterInfo{}
+	mi := &file_protocol_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OspfRouterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OspfRouterInfo) ProtoMessage() {}
+
+func (x *OspfRouterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OspfRouterInfo.ProtoReflect.Descriptor instead.
+func (*OspfRouterInfo) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *OspfRouterInfo) GetRouterId() string {
+	if x != nil {
+		return x.RouterId
+	}
+	return ""
+}
+
+func (x *OspfRouterInfo) GetRouterLinkStates() map[string]*AreaLinkStates {
+	if x != nil {
+		return x.RouterLinkStates
+	}
+	return nil
+}
+
+// Container for all link states in an area
+type AreaLinkStates struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RouterLsas    map[string]*RouterLSA  `protobuf:"bytes,1,rep,name=router_lsas,json=routerLsas,proto3" json:"router_lsas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AreaLinkStates) Reset() {
+	*x = AreaLinkStates{}
+	mi := &file_protocol_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AreaLinkStates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaLinkStates) ProtoMessage() {}
+
+func (x *AreaLinkStates) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaLinkStates.ProtoReflect.Descriptor instead.
+func (*AreaLinkStates) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AreaLinkStates) GetRouterLsas() map[string]*RouterLSA {
+	if x != nil {
+		return x.RouterLsas
+	}
+	return nil
+}
+
+// Router Link State Advertisement
+type RouterLSA struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LsaAge            int32                  `protobuf:"varint,1,opt,name=lsa_age,json=lsaAge,proto3" json:"lsa_age,omitempty"`
+	Options           string                 `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	LsaFlags          int32                  `protobuf:"varint,3,opt,name=lsa_flags,json=lsaFlags,proto3" json:"lsa_flags,omitempty"`
+	Flags             int32                  `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
+	Asbr              bool                   `protobuf:"varint,5,opt,name=asbr,proto3" json:"asbr,omitempty"`
+	LsaType           string                 `protobuf:"bytes,6,opt,name=lsa_type,json=lsaType,proto3" json:"lsa_type,omitempty"`
+	LinkStateId       string                 `protobuf:"bytes,7,opt,name=link_state_id,json=linkStateId,proto3" json:"link_state_id,omitempty"`
+	AdvertisingRouter string                 `protobuf:"bytes,8,opt,name=advertising_router,json=advertisingRouter,proto3" json:"advertising_router,omitempty"`
+	LsaSeqNumber      string                 `protobuf:"bytes,9,opt,name=lsa_seq_number,json=lsaSeqNumber,proto3" json:"lsa_seq_number,omitempty"`
+	Checksum          string                 `protobuf:"bytes,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Length            int32                  `protobuf:"varint,11,opt,name=length,proto3" json:"length,omitempty"`
+	NumOfLinks        int32                  `protobuf:"varint,12,opt,name=num_of_links,json=numOfLinks,proto3" json:"num_of_links,omitempty"`
+	RouterLinks       map[string]*RouterLink `protobuf:"bytes,13,rep,name=router_links,json=routerLinks,proto3" json:"router_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RouterLSA) Reset() {
+	*x = RouterLSA{}
+	mi := &file_protocol_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouterLSA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouterLSA) ProtoMessage() {}
+
+func (x *RouterLSA) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouterLSA.ProtoReflect.Descriptor instead.
+func (*RouterLSA) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RouterLSA) GetLsaAge() int32 {
+	if x != nil {
+		return x.LsaAge
+	}
+	return 0
+}
+
+func (x *RouterLSA) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetLsaFlags() int32 {
+	if x != nil {
+		return x.LsaFlags
+	}
+	return 0
+}
+
+func (x *RouterLSA) GetFlags() int32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+func (x *RouterLSA) GetAsbr() bool {
+	if x != nil {
+		return x.Asbr
+	}
+	return false
+}
+
+func (x *RouterLSA) GetLsaType() string {
+	if x != nil {
+		return x.LsaType
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetLinkStateId() string {
+	if x != nil {
+		return x.LinkStateId
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetAdvertisingRouter() string {
+	if x != nil {
+		return x.AdvertisingRouter
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetLsaSeqNumber() string {
+	if x != nil {
+		return x.LsaSeqNumber
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *RouterLSA) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *RouterLSA) GetNumOfLinks() int32 {
+	if x != nil {
+		return x.NumOfLinks
+	}
+	return 0
+}
+
+func (x *RouterLSA) GetRouterLinks() map[string]*RouterLink {
+	if x != nil {
+		return x.RouterLinks
+	}
+	return nil
+}
+
+// Individual router link
+type RouterLink struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	LinkType string                 `protobuf:"bytes,1,opt,name=link_type,json=linkType,proto3" json:"link_type,omitempty"`
+	// For transit networks
+	DesignatedRouterAddress *string `protobuf:"bytes,2,opt,name=designated_router_address,json=designatedRouterAddress,proto3,oneof" json:"designated_router_address,omitempty"`
+	RouterInterfaceAddress  *string `protobuf:"bytes,3,opt,name=router_interface_address,json=routerInterfaceAddress,proto3,oneof" json:"router_interface_address,omitempty"`
+	// For stub networks
+	NetworkAddress  *string `protobuf:"bytes,4,opt,name=network_address,json=networkAddress,proto3,oneof" json:"network_address,omitempty"`
+	NetworkMask     *string `protobuf:"bytes,5,opt,name=network_mask,json=networkMask,proto3,oneof" json:"network_mask,omitempty"`
+	NumOfTosMetrics int32   `protobuf:"varint,6,opt,name=num_of_tos_metrics,json=numOfTosMetrics,proto3" json:"num_of_tos_metrics,omitempty"`
+	Tos0Metric      int32   `protobuf:"varint,7,opt,name=tos0_metric,json=tos0Metric,proto3" json:"tos0_metric,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RouterLink) Reset() {
+	*x = RouterLink{}
+	mi := &file_protocol_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AreaAnalyzer) String() string {
+func (x *RouterLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AreaAnalyzer) ProtoMessage() {}
+func (*RouterLink) ProtoMessage() {}
 
-func (x *AreaAnalyzer) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[74]
+func (x *RouterLink) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6527,64 +8672,95 @@ func (x *AreaAnalyzer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AreaAnalyzer.ProtoReflect.Descriptor instead.
-func (*AreaAnalyzer) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use RouterLink.ProtoReflect.Descriptor instead.
+func (*RouterLink) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *AreaAnalyzer) GetAreaName() string {
+func (x *RouterLink) GetLinkType() string {
 	if x != nil {
-		return x.AreaName
+		return x.LinkType
 	}
 	return ""
 }
 
-func (x *AreaAnalyzer) GetLsaType() string {
-	if x != nil {
-		return x.LsaType
+func (x *RouterLink) GetDesignatedRouterAddress() string {
+	if x != nil && x.DesignatedRouterAddress != nil {
+		return *x.DesignatedRouterAddress
 	}
 	return ""
 }
 
-func (x *AreaAnalyzer) GetAreaType() string {
-	if x != nil {
-		return x.AreaType
+func (x *RouterLink) GetRouterInterfaceAddress() string {
+	if x != nil && x.RouterInterfaceAddress != nil {
+		return *x.RouterInterfaceAddress
 	}
 	return ""
 }
 
-func (x *AreaAnalyzer) GetLinks() []*Advertisement {
+func (x *RouterLink) GetNetworkAddress() string {
+	if x != nil && x.NetworkAddress != nil {
+		return *x.NetworkAddress
+	}
+	return ""
+}
+
+func (x *RouterLink) GetNetworkMask() string {
+	if x != nil && x.NetworkMask != nil {
+		return *x.NetworkMask
+	}
+	return ""
+}
+
+func (x *RouterLink) GetNumOfTosMetrics() int32 {
 	if x != nil {
-		return x.Links
+		return x.NumOfTosMetrics
 	}
-	return nil
+	return 0
 }
 
-type RibPrefixes struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Prefix         string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
-	PrefixLength   string                 `protobuf:"bytes,2,opt,name=prefix_length,json=prefixLength,proto3" json:"prefix_length,omitempty"`
-	Protocol       string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	NextHopAddress string                 `protobuf:"bytes,4,opt,name=next_hop_address,json=nextHopAddress,proto3" json:"next_hop_address,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *RouterLink) GetTos0Metric() int32 {
+	if x != nil {
+		return x.Tos0Metric
+	}
+	return 0
 }
 
-func (x *RibPrefixes) Reset() {
-	*x = RibPrefixes{}
-	mi := &file_protocol_proto_msgTypes[75]
+// HealthStatus is returned by system/status. Times are unix seconds, state is ok, degraded or
+// failed and problems lists the reasons of a state other than ok.
+type HealthStatus struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	State                          string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Problems                       []string               `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	DaemonVersion                  string                 `protobuf:"bytes,3,opt,name=daemon_version,json=daemonVersion,proto3" json:"daemon_version,omitempty"`
+	StartedAt                      int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Components                     []*ComponentHealth     `protobuf:"bytes,5,rep,name=components,proto3" json:"components,omitempty"`
+	LastCollection                 int64                  `protobuf:"varint,6,opt,name=last_collection,json=lastCollection,proto3" json:"last_collection,omitempty"`
+	CollectionDurationMicroseconds int64                  `protobuf:"varint,7,opt,name=collection_duration_microseconds,json=collectionDurationMicroseconds,proto3" json:"collection_duration_microseconds,omitempty"`
+	Commands                       []*CommandHealth       `protobuf:"bytes,8,rep,name=commands,proto3" json:"commands,omitempty"`
+	LastAnalysis                   int64                  `protobuf:"varint,9,opt,name=last_analysis,json=lastAnalysis,proto3" json:"last_analysis,omitempty"`
+	AnalysisDurationMicroseconds   int64                  `protobuf:"varint,10,opt,name=analysis_duration_microseconds,json=analysisDurationMicroseconds,proto3" json:"analysis_duration_microseconds,omitempty"`
+	ParseErrors                    uint64                 `protobuf:"varint,11,opt,name=parse_errors,json=parseErrors,proto3" json:"parse_errors,omitempty"`
+	SocketClients                  *SocketClients         `protobuf:"bytes,12,opt,name=socket_clients,json=socketClients,proto3" json:"socket_clients,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *HealthStatus) Reset() {
+	*x = HealthStatus{}
+	mi := &file_protocol_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RibPrefixes) String() string {
+func (x *HealthStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RibPrefixes) ProtoMessage() {}
+func (*HealthStatus) ProtoMessage() {}
 
-func (x *RibPrefixes) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[75]
+func (x *HealthStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6595,64 +8771,122 @@ func (x *RibPrefixes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RibPrefixes.ProtoReflect.Descriptor instead.
-func (*RibPrefixes) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use HealthStatus.ProtoReflect.Descriptor instead.
+func (*HealthStatus) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *RibPrefixes) GetPrefix() string {
+func (x *HealthStatus) GetState() string {
 	if x != nil {
-		return x.Prefix
+		return x.State
 	}
 	return ""
 }
 
-func (x *RibPrefixes) GetPrefixLength() string {
+func (x *HealthStatus) GetProblems() []string {
 	if x != nil {
-		return x.PrefixLength
+		return x.Problems
 	}
-	return ""
+	return nil
 }
 
-func (x *RibPrefixes) GetProtocol() string {
+func (x *HealthStatus) GetDaemonVersion() string {
 	if x != nil {
-		return x.Protocol
+		return x.DaemonVersion
 	}
 	return ""
 }
 
-func (x *RibPrefixes) GetNextHopAddress() string {
+func (x *HealthStatus) GetStartedAt() int64 {
 	if x != nil {
-		return x.NextHopAddress
+		return x.StartedAt
 	}
-	return ""
+	return 0
 }
 
-type ParsedAnalyzerData struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	ShouldRouterLsdb       *IntraAreaLsa          `protobuf:"bytes,1,opt,name=should_router_lsdb,json=shouldRouterLsdb,proto3" json:"should_router_lsdb,omitempty"`
-	ShouldExternalLsdb     *InterAreaLsa          `protobuf:"bytes,2,opt,name=should_external_lsdb,json=shouldExternalLsdb,proto3" json:"should_external_lsdb,omitempty"`
-	ShouldNssaExternalLsdb *InterAreaLsa          `protobuf:"bytes,3,opt,name=should_nssa_external_lsdb,json=shouldNssaExternalLsdb,proto3" json:"should_nssa_external_lsdb,omitempty"`
-	P2PMap                 *PeerInterfaceMap      `protobuf:"bytes,4,opt,name=p2p_map,json=p2pMap,proto3" json:"p2p_map,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *HealthStatus) GetComponents() []*ComponentHealth {
+	if x != nil {
+		return x.Components
+	}
+	return nil
 }
 
-func (x *ParsedAnalyzerData) Reset() {
-	*x = ParsedAnalyzerData{}
-	mi := &file_protocol_proto_msgTypes[76]
+func (x *HealthStatus) GetLastCollection() int64 {
+	if x != nil {
+		return x.LastCollection
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetCollectionDurationMicroseconds() int64 {
+	if x != nil {
+		return x.CollectionDurationMicroseconds
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetCommands() []*CommandHealth {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *HealthStatus) GetLastAnalysis() int64 {
+	if x != nil {
+		return x.LastAnalysis
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetAnalysisDurationMicroseconds() int64 {
+	if x != nil {
+		return x.AnalysisDurationMicroseconds
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetParseErrors() uint64 {
+	if x != nil {
+		return x.ParseErrors
+	}
+	return 0
+}
+
+func (x *HealthStatus) GetSocketClients() *SocketClients {
+	if x != nil {
+		return x.SocketClients
+	}
+	return nil
+}
+
+// ComponentHealth is the state of a supervised component: running, restarting or stopped
+type ComponentHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Restarts      uint32                 `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComponentHealth) Reset() {
+	*x = ComponentHealth{}
+	mi := &file_protocol_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ParsedAnalyzerData) String() string {
+func (x *ComponentHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParsedAnalyzerData) ProtoMessage() {}
+func (*ComponentHealth) ProtoMessage() {}
 
-func (x *ParsedAnalyzerData) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[76]
+func (x *ComponentHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6663,63 +8897,74 @@ func (x *ParsedAnalyzerData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParsedAnalyzerData.ProtoReflect.Descriptor instead.
-func (*ParsedAnalyzerData) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use ComponentHealth.ProtoReflect.Descriptor instead.
+func (*ComponentHealth) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ParsedAnalyzerData) GetShouldRouterLsdb() *IntraAreaLsa {
+func (x *ComponentHealth) GetName() string {
 	if x != nil {
-		return x.ShouldRouterLsdb
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ParsedAnalyzerData) GetShouldExternalLsdb() *InterAreaLsa {
+func (x *ComponentHealth) GetState() string {
 	if x != nil {
-		return x.ShouldExternalLsdb
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ParsedAnalyzerData) GetShouldNssaExternalLsdb() *InterAreaLsa {
+func (x *ComponentHealth) GetSince() int64 {
 	if x != nil {
-		return x.ShouldNssaExternalLsdb
+		return x.Since
 	}
-	return nil
+	return 0
 }
 
-func (x *ParsedAnalyzerData) GetP2PMap() *PeerInterfaceMap {
+func (x *ComponentHealth) GetRestarts() uint32 {
 	if x != nil {
-		return x.P2PMap
+		return x.Restarts
 	}
-	return nil
+	return 0
+}
+
+func (x *ComponentHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
 }
 
-// Main message containing the router information
-type OspfRouterInfo struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	RouterId         string                     `protobuf:"bytes,1,opt,name=router_id,json=routerId,proto3" json:"router_id,omitempty"`
-	RouterLinkStates map[string]*AreaLinkStates `protobuf:"bytes,2,rep,name=router_link_states,json=routerLinkStates,proto3" json:"router_link_states,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// CommandHealth tracks a single FRR command of the collection cycle
+type CommandHealth struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LastSuccess          int64                  `protobuf:"varint,2,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	DurationMicroseconds int64                  `protobuf:"varint,3,opt,name=duration_microseconds,json=durationMicroseconds,proto3" json:"duration_microseconds,omitempty"` // of the last successful run
+	Failures             uint64                 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	ParseErrors          uint64                 `protobuf:"varint,5,opt,name=parse_errors,json=parseErrors,proto3" json:"parse_errors,omitempty"`
+	LastError            string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // empty if the last run succeeded
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *OspfRouterInfo) Reset() {
-	*x = OspfRouterInfo{}
-	mi := &file_protocol_proto_msgTypes[77]
+func (x *CommandHealth) Reset() {
+	*x = CommandHealth{}
+	mi := &file_protocol_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OspfRouterInfo) String() string {
+func (x *CommandHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OspfRouterInfo) ProtoMessage() {}
+func (*CommandHealth) ProtoMessage() {}
 
-func (x *OspfRouterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[77]
+func (x *CommandHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6730,105 +8975,78 @@ func (x *OspfRouterInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OspfRouterInfo.ProtoReflect.Descriptor instead.
-func (*OspfRouterInfo) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use CommandHealth.ProtoReflect.Descriptor instead.
+func (*CommandHealth) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *OspfRouterInfo) GetRouterId() string {
+func (x *CommandHealth) GetName() string {
 	if x != nil {
-		return x.RouterId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OspfRouterInfo) GetRouterLinkStates() map[string]*AreaLinkStates {
+func (x *CommandHealth) GetLastSuccess() int64 {
 	if x != nil {
-		return x.RouterLinkStates
+		return x.LastSuccess
 	}
-	return nil
-}
-
-// Container for all link states in an area
-type AreaLinkStates struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RouterLsas    map[string]*RouterLSA  `protobuf:"bytes,1,rep,name=router_lsas,json=routerLsas,proto3" json:"router_lsas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AreaLinkStates) Reset() {
-	*x = AreaLinkStates{}
-	mi := &file_protocol_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *AreaLinkStates) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CommandHealth) GetDurationMicroseconds() int64 {
+	if x != nil {
+		return x.DurationMicroseconds
+	}
+	return 0
 }
 
-func (*AreaLinkStates) ProtoMessage() {}
-
-func (x *AreaLinkStates) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[78]
+func (x *CommandHealth) GetFailures() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Failures
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AreaLinkStates.ProtoReflect.Descriptor instead.
-func (*AreaLinkStates) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{78}
+func (x *CommandHealth) GetParseErrors() uint64 {
+	if x != nil {
+		return x.ParseErrors
+	}
+	return 0
 }
 
-func (x *AreaLinkStates) GetRouterLsas() map[string]*RouterLSA {
+func (x *CommandHealth) GetLastError() string {
 	if x != nil {
-		return x.RouterLsas
+		return x.LastError
 	}
-	return nil
+	return ""
 }
 
-// Router Link State Advertisement
-type RouterLSA struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	LsaAge            int32                  `protobuf:"varint,1,opt,name=lsa_age,json=lsaAge,proto3" json:"lsa_age,omitempty"`
-	Options           string                 `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
-	LsaFlags          int32                  `protobuf:"varint,3,opt,name=lsa_flags,json=lsaFlags,proto3" json:"lsa_flags,omitempty"`
-	Flags             int32                  `protobuf:"varint,4,opt,name=flags,proto3" json:"flags,omitempty"`
-	Asbr              bool                   `protobuf:"varint,5,opt,name=asbr,proto3" json:"asbr,omitempty"`
-	LsaType           string                 `protobuf:"bytes,6,opt,name=lsa_type,json=lsaType,proto3" json:"lsa_type,omitempty"`
-	LinkStateId       string                 `protobuf:"bytes,7,opt,name=link_state_id,json=linkStateId,proto3" json:"link_state_id,omitempty"`
-	AdvertisingRouter string                 `protobuf:"bytes,8,opt,name=advertising_router,json=advertisingRouter,proto3" json:"advertising_router,omitempty"`
-	LsaSeqNumber      string                 `protobuf:"bytes,9,opt,name=lsa_seq_number,json=lsaSeqNumber,proto3" json:"lsa_seq_number,omitempty"`
-	Checksum          string                 `protobuf:"bytes,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
-	Length            int32                  `protobuf:"varint,11,opt,name=length,proto3" json:"length,omitempty"`
-	NumOfLinks        int32                  `protobuf:"varint,12,opt,name=num_of_links,json=numOfLinks,proto3" json:"num_of_links,omitempty"`
-	RouterLinks       map[string]*RouterLink `protobuf:"bytes,13,rep,name=router_links,json=routerLinks,proto3" json:"router_links,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SocketClients struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   int32                  `protobuf:"varint,1,opt,name=connections,proto3" json:"connections,omitempty"`
+	Subscribers   int32                  `protobuf:"varint,2,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Served        uint64                 `protobuf:"varint,3,opt,name=served,proto3" json:"served,omitempty"` // clients accepted since the start
+	Rejected      uint64                 `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouterLSA) Reset() {
-	*x = RouterLSA{}
-	mi := &file_protocol_proto_msgTypes[79]
+func (x *SocketClients) Reset() {
+	*x = SocketClients{}
+	mi := &file_protocol_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouterLSA) String() string {
+func (x *SocketClients) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouterLSA) ProtoMessage() {}
+func (*SocketClients) ProtoMessage() {}
 
-func (x *RouterLSA) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[79]
+func (x *SocketClients) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6839,133 +9057,165 @@ func (x *RouterLSA) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouterLSA.ProtoReflect.Descriptor instead.
-func (*RouterLSA) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use SocketClients.ProtoReflect.Descriptor instead.
+func (*SocketClients) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *RouterLSA) GetLsaAge() int32 {
+func (x *SocketClients) GetConnections() int32 {
 	if x != nil {
-		return x.LsaAge
+		return x.Connections
 	}
 	return 0
 }
 
-func (x *RouterLSA) GetOptions() string {
+func (x *SocketClients) GetSubscribers() int32 {
 	if x != nil {
-		return x.Options
+		return x.Subscribers
 	}
-	return ""
+	return 0
 }
 
-func (x *RouterLSA) GetLsaFlags() int32 {
+func (x *SocketClients) GetServed() uint64 {
 	if x != nil {
-		return x.LsaFlags
+		return x.Served
 	}
 	return 0
 }
 
-func (x *RouterLSA) GetFlags() int32 {
+func (x *SocketClients) GetRejected() uint64 {
 	if x != nil {
-		return x.Flags
+		return x.Rejected
 	}
 	return 0
 }
 
-func (x *RouterLSA) GetAsbr() bool {
+// Query filters, paginates and trims the entries of a response like the params of a Message.
+// The number of matching entries and the next offset are returned in the response header
+// metadata page-total and page-next-offset.
+type Query struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Area              string                 `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	Prefix            string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Protocol          string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	LsaType           string                 `protobuf:"bytes,4,opt,name=lsa_type,json=lsaType,proto3" json:"lsa_type,omitempty"`
+	AdvertisingRouter string                 `protobuf:"bytes,5,opt,name=advertising_router,json=advertisingRouter,proto3" json:"advertising_router,omitempty"`
+	Offset            int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit             int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Fields            []string               `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	mi := &file_protocol_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[101]
 	if x != nil {
-		return x.Asbr
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *RouterLSA) GetLsaType() string {
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Query) GetArea() string {
 	if x != nil {
-		return x.LsaType
+		return x.Area
 	}
 	return ""
 }
 
-func (x *RouterLSA) GetLinkStateId() string {
+func (x *Query) GetPrefix() string {
 	if x != nil {
-		return x.LinkStateId
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *RouterLSA) GetAdvertisingRouter() string {
+func (x *Query) GetProtocol() string {
 	if x != nil {
-		return x.AdvertisingRouter
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *RouterLSA) GetLsaSeqNumber() string {
+func (x *Query) GetLsaType() string {
 	if x != nil {
-		return x.LsaSeqNumber
+		return x.LsaType
 	}
 	return ""
 }
 
-func (x *RouterLSA) GetChecksum() string {
+func (x *Query) GetAdvertisingRouter() string {
 	if x != nil {
-		return x.Checksum
+		return x.AdvertisingRouter
 	}
 	return ""
 }
 
-func (x *RouterLSA) GetLength() int32 {
+func (x *Query) GetOffset() int32 {
 	if x != nil {
-		return x.Length
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *RouterLSA) GetNumOfLinks() int32 {
+func (x *Query) GetLimit() int32 {
 	if x != nil {
-		return x.NumOfLinks
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *RouterLSA) GetRouterLinks() map[string]*RouterLink {
+func (x *Query) GetFields() []string {
 	if x != nil {
-		return x.RouterLinks
+		return x.Fields
 	}
 	return nil
 }
 
-// Individual router link
-type RouterLink struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	LinkType string                 `protobuf:"bytes,1,opt,name=link_type,json=linkType,proto3" json:"link_type,omitempty"`
-	// For transit networks
-	DesignatedRouterAddress *string `protobuf:"bytes,2,opt,name=designated_router_address,json=designatedRouterAddress,proto3,oneof" json:"designated_router_address,omitempty"`
-	RouterInterfaceAddress  *string `protobuf:"bytes,3,opt,name=router_interface_address,json=routerInterfaceAddress,proto3,oneof" json:"router_interface_address,omitempty"`
-	// For stub networks
-	NetworkAddress  *string `protobuf:"bytes,4,opt,name=network_address,json=networkAddress,proto3,oneof" json:"network_address,omitempty"`
-	NetworkMask     *string `protobuf:"bytes,5,opt,name=network_mask,json=networkMask,proto3,oneof" json:"network_mask,omitempty"`
-	NumOfTosMetrics int32   `protobuf:"varint,6,opt,name=num_of_tos_metrics,json=numOfTosMetrics,proto3" json:"num_of_tos_metrics,omitempty"`
-	Tos0Metric      int32   `protobuf:"varint,7,opt,name=tos0_metric,json=tos0Metric,proto3" json:"tos0_metric,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        AnomalySource          `protobuf:"varint,1,opt,name=source,proto3,enum=communication.AnomalySource" json:"source,omitempty"` // used if check is empty
+	Check         string                 `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`                                     // name of the check, see GetCheckResults
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouterLink) Reset() {
-	*x = RouterLink{}
-	mi := &file_protocol_proto_msgTypes[80]
+func (x *AnomaliesRequest) Reset() {
+	*x = AnomaliesRequest{}
+	mi := &file_protocol_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouterLink) String() string {
+func (x *AnomaliesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouterLink) ProtoMessage() {}
+func (*AnomaliesRequest) ProtoMessage() {}
 
-func (x *RouterLink) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[80]
+func (x *AnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6976,65 +9226,90 @@ func (x *RouterLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouterLink.ProtoReflect.Descriptor instead.
-func (*RouterLink) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use AnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*AnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *RouterLink) GetLinkType() string {
+func (x *AnomaliesRequest) GetSource() AnomalySource {
 	if x != nil {
-		return x.LinkType
+		return x.Source
 	}
-	return ""
+	return AnomalySource_ANOMALY_SOURCE_UNSPECIFIED
 }
 
-func (x *RouterLink) GetDesignatedRouterAddress() string {
-	if x != nil && x.DesignatedRouterAddress != nil {
-		return *x.DesignatedRouterAddress
+func (x *AnomaliesRequest) GetCheck() string {
+	if x != nil {
+		return x.Check
 	}
 	return ""
 }
 
-func (x *RouterLink) GetRouterInterfaceAddress() string {
-	if x != nil && x.RouterInterfaceAddress != nil {
-		return *x.RouterInterfaceAddress
-	}
-	return ""
+type AcknowledgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Duration      string                 `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"` // e.g. 2h, the default acknowledgement duration if empty
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouterLink) GetNetworkAddress() string {
-	if x != nil && x.NetworkAddress != nil {
-		return *x.NetworkAddress
+func (x *AcknowledgeRequest) Reset() {
+	*x = AcknowledgeRequest{}
+	mi := &file_protocol_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeRequest) ProtoMessage() {}
+
+func (x *AcknowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RouterLink) GetNetworkMask() string {
-	if x != nil && x.NetworkMask != nil {
-		return *x.NetworkMask
+// Deprecated: Use AcknowledgeRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AcknowledgeRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RouterLink) GetNumOfTosMetrics() int32 {
+func (x *AcknowledgeRequest) GetDuration() string {
 	if x != nil {
-		return x.NumOfTosMetrics
+		return x.Duration
 	}
-	return 0
+	return ""
 }
 
-func (x *RouterLink) GetTos0Metric() int32 {
+func (x *AcknowledgeRequest) GetComment() string {
 	if x != nil {
-		return x.Tos0Metric
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
 var File_protocol_proto protoreflect.FileDescriptor
 
 const file_protocol_proto_rawDesc = "" +
 	"\n" +
-	"\x0eprotocol.proto\x12\rcommunication\"\xd2\x01\n" +
+	"\x0eprotocol.proto\x12\rcommunication\x1a\x1bgoogle/protobuf/empty.proto\"\xd2\x01\n" +
 	"\aMessage\x12\x18\n" +
 	"\aservice\x18\x01 \x01(\tR\aservice\x12\x18\n" +
 	"\acommand\x18\x02 \x01(\tR\acommand\x12:\n" +
@@ -7048,16 +9323,24 @@ const file_protocol_proto_rawDesc = "" +
 	"\x06params\x18\x03 \x03(\v2\".communication.Command.ParamsEntryR\x06params\x1aW\n" +
 	"\vParamsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
-	"\x05value\x18\x02 \x01(\v2\x1c.communication.ResponseValueR\x05value:\x028\x01\"n\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.communication.ResponseValueR\x05value:\x028\x01\"\xad\x01\n" +
 	"\bResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
-	"\x04data\x18\x03 \x01(\v2\x1c.communication.ResponseValueR\x04data\"\xd5\x01\n" +
+	"\x04data\x18\x03 \x01(\v2\x1c.communication.ResponseValueR\x04data\x12\x14\n" +
+	"\x05topic\x18\x04 \x01(\tR\x05topic\x12'\n" +
+	"\x04page\x18\x05 \x01(\v2\x13.communication.PageR\x04page\"k\n" +
+	"\x04Page\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1f\n" +
+	"\vnext_offset\x18\x04 \x01(\x05R\n" +
+	"nextOffset\"\xd5\x01\n" +
 	"\x10PeerInterfaceMap\x12v\n" +
 	"\x19peer_interface_to_address\x18\x01 \x03(\v2;.communication.PeerInterfaceMap.PeerInterfaceToAddressEntryR\x16peerInterfaceToAddress\x1aI\n" +
 	"\x1bPeerInterfaceToAddressEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa6\r\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbc\x10\n" +
 	"\rResponseValue\x12#\n" +
 	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12U\n" +
 	"\x14parsed_analyzer_data\x18\x02 \x01(\v2!.communication.ParsedAnalyzerDataH\x00R\x12parsedAnalyzerData\x12;\n" +
@@ -7082,8 +9365,23 @@ const file_protocol_proto_rawDesc = "" +
 	"\x16rib_fib_summary_routes\x18\x12 \x01(\v2\".communication.RibFibSummaryRoutesH\x00R\x13ribFibSummaryRoutes\x12a\n" +
 	"\x18static_frr_configuration\x18\x13 \x01(\v2%.communication.StaticFRRConfigurationH\x00R\x16staticFrrConfiguration\x12E\n" +
 	"\x0esystem_metrics\x18\x14 \x01(\v2\x1c.communication.SystemMetricsH\x00R\rsystemMetrics\x12F\n" +
-	"\x0ffrr_router_data\x18\x15 \x01(\v2\x1c.communication.FRRRouterDataH\x00R\rfrrRouterDataB\x06\n" +
-	"\x04kind\"\x9f\x01\n" +
+	"\x0ffrr_router_data\x18\x15 \x01(\v2\x1c.communication.FRRRouterDataH\x00R\rfrrRouterData\x12R\n" +
+	"\x11anomaly_lifecycle\x18\x16 \x01(\v2#.communication.AnomalyLifecycleListH\x00R\x10anomalyLifecycle\x12<\n" +
+	"\vlint_result\x18\x17 \x01(\v2\x19.communication.LintResultH\x00R\n" +
+	"lintResult\x12E\n" +
+	"\rcheck_results\x18\x18 \x01(\v2\x1e.communication.CheckResultListH\x00R\fcheckResults\x12I\n" +
+	"\x0earea_anomalies\x18\x19 \x01(\v2 .communication.AreaAnomaliesListH\x00R\rareaAnomalies\x12,\n" +
+	"\x05hello\x18\x1a \x01(\v2\x14.communication.HelloH\x00R\x05hello\x12B\n" +
+	"\rhealth_status\x18\x1b \x01(\v2\x1b.communication.HealthStatusH\x00R\fhealthStatusB\x06\n" +
+	"\x04kind\"\xb1\x01\n" +
+	"\x05Hello\x12)\n" +
+	"\x10protocol_version\x18\x01 \x01(\x05R\x0fprotocolVersion\x12%\n" +
+	"\x0edaemon_version\x18\x02 \x01(\tR\rdaemonVersion\x12:\n" +
+	"\bservices\x18\x03 \x03(\v2\x1e.communication.ServiceCommandsR\bservices\x12\x1a\n" +
+	"\bfeatures\x18\x04 \x03(\tR\bfeatures\"G\n" +
+	"\x0fServiceCommands\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x1a\n" +
+	"\bcommands\x18\x02 \x03(\tR\bcommands\"\x9f\x01\n" +
 	"\rNetworkConfig\x12\x1b\n" +
 	"\trouter_id\x18\x01 \x01(\tR\brouterId\x12-\n" +
 	"\x05areas\x18\x02 \x03(\v2\x17.communication.OSPFAreaR\x05areas\x12B\n" +
@@ -7122,7 +9420,7 @@ const file_protocol_proto_rawDesc = "" +
 	"\x16rib_fib_summary_routes\x18\x11 \x01(\v2\".communication.RibFibSummaryRoutesR\x13ribFibSummaryRoutes\x12_\n" +
 	"\x18static_frr_configuration\x18\x12 \x01(\v2%.communication.StaticFRRConfigurationR\x16staticFrrConfiguration\x12C\n" +
 	"\x0esystem_metrics\x18\x13 \x01(\v2\x1c.communication.SystemMetricsR\rsystemMetrics\x12D\n" +
-	"\x0ffrr_router_data\x18\x14 \x01(\v2\x1c.communication.FRRRouterDataR\rfrrRouterData\"\xea\x05\n" +
+	"\x0ffrr_router_data\x18\x14 \x01(\v2\x1c.communication.FRRRouterDataR\rfrrRouterData\"\x9c\a\n" +
 	"\x16StaticFRRConfiguration\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1f\n" +
 	"\vfrr_version\x18\x02 \x01(\tR\n" +
@@ -7139,39 +9437,79 @@ const file_protocol_proto_rawDesc = "" +
 	"ospfConfig\x12P\n" +
 	"\troute_map\x18\v \x03(\v23.communication.StaticFRRConfiguration.RouteMapEntryR\brouteMap\x12V\n" +
 	"\vaccess_list\x18\f \x03(\v25.communication.StaticFRRConfiguration.AccessListEntryR\n" +
-	"accessList\x1aT\n" +
+	"accessList\x12V\n" +
+	"\vprefix_list\x18\r \x03(\v25.communication.StaticFRRConfiguration.PrefixListEntryR\n" +
+	"prefixList\x1aT\n" +
 	"\rRouteMapEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
 	"\x05value\x18\x02 \x01(\v2\x17.communication.RouteMapR\x05value:\x028\x01\x1aX\n" +
 	"\x0fAccessListEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.communication.AccessListR\x05value:\x028\x01\"\x89\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.communication.AccessListR\x05value:\x028\x01\x1aX\n" +
+	"\x0fPrefixListEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.communication.PrefixListR\x05value:\x028\x01\"\x89\x01\n" +
 	"\tInterface\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12T\n" +
 	"\x15interface_ip_prefixes\x18\x02 \x03(\v2 .communication.InterfaceIPPrefixR\x13interfaceIpPrefixes\x12\x12\n" +
 	"\x04area\x18\x03 \x01(\tR\x04area\"^\n" +
 	"\vStaticRoute\x124\n" +
 	"\tip_prefix\x18\x01 \x01(\v2\x17.communication.IPPrefixR\bipPrefix\x12\x19\n" +
-	"\bnext_hop\x18\x02 \x01(\tR\anextHop\"\xcd\x01\n" +
+	"\bnext_hop\x18\x02 \x01(\tR\anextHop\"\xaf\x03\n" +
 	"\n" +
 	"OSPFConfig\x12\x1b\n" +
 	"\trouter_id\x18\x01 \x01(\tR\brouterId\x12E\n" +
 	"\x0eredistribution\x18\x02 \x03(\v2\x1d.communication.RedistributionR\x0eredistribution\x12'\n" +
 	"\x04area\x18\x03 \x03(\v2\x13.communication.AreaR\x04area\x122\n" +
-	"\x15virtual_link_neighbor\x18\x04 \x01(\tR\x13virtualLinkNeighbor\"Y\n" +
+	"\x15virtual_link_neighbor\x18\x04 \x01(\tR\x13virtualLinkNeighbor\x127\n" +
+	"\n" +
+	"area_range\x18\x05 \x03(\v2\x18.communication.AreaRangeR\tareaRange\x12,\n" +
+	"\x12has_default_metric\x18\x06 \x01(\bR\x10hasDefaultMetric\x12%\n" +
+	"\x0edefault_metric\x18\a \x01(\x05R\rdefaultMetric\x12R\n" +
+	"\x13default_information\x18\b \x01(\v2!.communication.DefaultInformationR\x12defaultInformation\"\xbf\x01\n" +
+	"\x12DefaultInformation\x12\x1c\n" +
+	"\toriginate\x18\x01 \x01(\bR\toriginate\x12\x16\n" +
+	"\x06always\x18\x02 \x01(\bR\x06always\x12\x1d\n" +
+	"\n" +
+	"has_metric\x18\x03 \x01(\bR\thasMetric\x12\x16\n" +
+	"\x06metric\x18\x04 \x01(\x05R\x06metric\x12\x1f\n" +
+	"\vmetric_type\x18\x05 \x01(\tR\n" +
+	"metricType\x12\x1b\n" +
+	"\troute_map\x18\x06 \x01(\tR\brouteMap\"\xa6\x01\n" +
 	"\x0eRedistribution\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06metric\x18\x02 \x01(\tR\x06metric\x12\x1b\n" +
-	"\troute_map\x18\x03 \x01(\tR\brouteMap\".\n" +
+	"\troute_map\x18\x03 \x01(\tR\brouteMap\x12(\n" +
+	"\x10has_metric_value\x18\x04 \x01(\bR\x0ehasMetricValue\x12!\n" +
+	"\fmetric_value\x18\x05 \x01(\x05R\vmetricValue\"\xb5\x01\n" +
 	"\x04Area\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x02 \x01(\tR\x04type\"u\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"no_summary\x18\x03 \x01(\bR\tnoSummary\x120\n" +
+	"\x14nssa_translator_role\x18\x04 \x01(\tR\x12nssaTranslatorRole\x124\n" +
+	"\x16nssa_default_originate\x18\x05 \x01(\bR\x14nssaDefaultOriginate\"\xa9\x01\n" +
+	"\tAreaRange\x12\x12\n" +
+	"\x04area\x18\x01 \x01(\tR\x04area\x124\n" +
+	"\tip_prefix\x18\x02 \x01(\v2\x17.communication.IPPrefixR\bipPrefix\x12#\n" +
+	"\rnot_advertise\x18\x03 \x01(\bR\fnotAdvertise\x12\x19\n" +
+	"\bhas_cost\x18\x04 \x01(\bR\ahasCost\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\rR\x04cost\"\xbc\x02\n" +
 	"\bRouteMap\x12\x16\n" +
 	"\x06permit\x18\x01 \x01(\bR\x06permit\x12\x1a\n" +
 	"\bsequence\x18\x02 \x01(\tR\bsequence\x12\x14\n" +
 	"\x05match\x18\x03 \x01(\tR\x05match\x12\x1f\n" +
 	"\vaccess_list\x18\x04 \x01(\tR\n" +
-	"accessList\"k\n" +
+	"accessList\x12$\n" +
+	"\x0ehas_set_metric\x18\x05 \x01(\bR\fhasSetMetric\x12\x1d\n" +
+	"\n" +
+	"set_metric\x18\x06 \x01(\x05R\tsetMetric\x12&\n" +
+	"\x0fset_metric_type\x18\a \x01(\tR\rsetMetricType\x12\x1e\n" +
+	"\vhas_set_tag\x18\b \x01(\bR\thasSetTag\x12\x17\n" +
+	"\aset_tag\x18\t \x01(\rR\x06setTag\x12\x1f\n" +
+	"\vprefix_list\x18\n" +
+	" \x01(\tR\n" +
+	"prefixList\"k\n" +
 	"\n" +
 	"AccessList\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
@@ -7181,7 +9519,18 @@ const file_protocol_proto_rawDesc = "" +
 	"\x0eaccess_control\x18\x02 \x01(\tR\raccessControl\x126\n" +
 	"\tip_prefix\x18\x03 \x01(\v2\x17.communication.IPPrefixH\x00R\bipPrefix\x12\x12\n" +
 	"\x03any\x18\x04 \x01(\bH\x00R\x03anyB\r\n" +
-	"\vdestination\"\xee\x01\n" +
+	"\vdestination\"k\n" +
+	"\n" +
+	"PrefixList\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
+	"\x11prefix_list_items\x18\x02 \x03(\v2\x1d.communication.PrefixListItemR\x0fprefixListItems\"\xbb\x01\n" +
+	"\x0ePrefixListItem\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\rR\bsequence\x12%\n" +
+	"\x0eaccess_control\x18\x02 \x01(\tR\raccessControl\x124\n" +
+	"\tip_prefix\x18\x03 \x01(\v2\x17.communication.IPPrefixR\bipPrefix\x12\x10\n" +
+	"\x03any\x18\x04 \x01(\bR\x03any\x12\x0e\n" +
+	"\x02ge\x18\x05 \x01(\rR\x02ge\x12\x0e\n" +
+	"\x02le\x18\x06 \x01(\rR\x02le\"\xee\x01\n" +
 	"\x11InterfaceIPPrefix\x124\n" +
 	"\tip_prefix\x18\x01 \x01(\v2\x17.communication.IPPrefixR\bipPrefix\x12\x12\n" +
 	"\x04ospf\x18\x02 \x01(\bR\x04ospf\x12\x1b\n" +
@@ -7655,13 +10004,90 @@ const file_protocol_proto_rawDesc = "" +
 	"\x0efib_off_loaded\x18\x03 \x01(\x05R\ffibOffLoaded\x12\x1f\n" +
 	"\vfib_trapped\x18\x04 \x01(\x05R\n" +
 	"fibTrapped\x12\x12\n" +
-	"\x04type\x18\x05 \x01(\tR\x04type\"\x98\x03\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\"\xcd\b\n" +
 	"\x0fAnomalyAnalysis\x12F\n" +
 	"\x0erouter_anomaly\x18\x01 \x01(\v2\x1f.communication.AnomalyDetectionR\rrouterAnomaly\x12J\n" +
 	"\x10external_anomaly\x18\x02 \x01(\v2\x1f.communication.AnomalyDetectionR\x0fexternalAnomaly\x12S\n" +
 	"\x15nssa_external_anomaly\x18\x03 \x01(\v2\x1f.communication.AnomalyDetectionR\x13nssaExternalAnomaly\x12N\n" +
 	"\x13lsdb_to_rib_anomaly\x18\x04 \x01(\v2\x1f.communication.AnomalyDetectionR\x10lsdbToRibAnomaly\x12L\n" +
-	"\x12rib_to_fib_anomaly\x18\x05 \x01(\v2\x1f.communication.AnomalyDetectionR\x0fribToFibAnomaly\"\xdb\x03\n" +
+	"\x12rib_to_fib_anomaly\x18\x05 \x01(\v2\x1f.communication.AnomalyDetectionR\x0fribToFibAnomaly\x12H\n" +
+	"\x0fsummary_anomaly\x18\x06 \x01(\v2\x1f.communication.AnomalyDetectionR\x0esummaryAnomaly\x12Q\n" +
+	"\x14asbr_summary_anomaly\x18\a \x01(\v2\x1f.communication.AnomalyDetectionR\x12asbrSummaryAnomaly\x12B\n" +
+	"\fecmp_anomaly\x18\b \x01(\v2\x1f.communication.AnomalyDetectionR\vecmpAnomaly\x12=\n" +
+	"\tlifecycle\x18\t \x03(\v2\x1f.communication.AnomalyLifecycleR\tlifecycle\x12`\n" +
+	"\x10acknowledgements\x18\n" +
+	" \x03(\v24.communication.AnomalyAnalysis.AcknowledgementsEntryR\x10acknowledgements\x12F\n" +
+	"\x0eintent_anomaly\x18\v \x01(\v2\x1f.communication.AnomalyDetectionR\rintentAnomaly\x12?\n" +
+	"\rcheck_results\x18\f \x03(\v2\x1a.communication.CheckResultR\fcheckResults\x12C\n" +
+	"\x0earea_anomalies\x18\r \x03(\v2\x1c.communication.AreaAnomaliesR\rareaAnomalies\x1ac\n" +
+	"\x15AcknowledgementsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x124\n" +
+	"\x05value\x18\x02 \x01(\v2\x1e.communication.AcknowledgementR\x05value:\x028\x01\"\x97\x02\n" +
+	"\rAreaAnomalies\x12\x12\n" +
+	"\x04area\x18\x01 \x01(\tR\x04area\x12C\n" +
+	"\asources\x18\x02 \x03(\v2).communication.AreaAnomalies.SourcesEntryR\asources\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\x12\x1e\n" +
+	"\n" +
+	"actionable\x18\x04 \x01(\rR\n" +
+	"actionable\x12\x1a\n" +
+	"\bcritical\x18\x05 \x01(\rR\bcritical\x1a[\n" +
+	"\fSourcesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.communication.AnomalyDetectionR\x05value:\x028\x01\"G\n" +
+	"\x11AreaAnomaliesList\x122\n" +
+	"\x05areas\x18\x01 \x03(\v2\x1c.communication.AreaAnomaliesR\x05areas\"\x97\x02\n" +
+	"\vCheckResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06inputs\x18\x03 \x03(\tR\x06inputs\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12\x18\n" +
+	"\abuiltin\x18\x05 \x01(\bR\abuiltin\x123\n" +
+	"\x15duration_microseconds\x18\x06 \x01(\x03R\x14durationMicroseconds\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12;\n" +
+	"\bfindings\x18\b \x01(\v2\x1f.communication.AnomalyDetectionR\bfindings\"R\n" +
+	"\x0fCheckResultList\x12?\n" +
+	"\rcheck_results\x18\x01 \x03(\v2\x1a.communication.CheckResultR\fcheckResults\"\x83\x01\n" +
+	"\x0fAcknowledgement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0facknowledged_at\x18\x02 \x01(\x03R\x0eacknowledgedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\x98\x04\n" +
+	"\x10AnomalyLifecycle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12!\n" +
+	"\fanomaly_type\x18\x03 \x01(\tR\vanomalyType\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x12\n" +
+	"\x04area\x18\x05 \x01(\tR\x04area\x12\x1d\n" +
+	"\n" +
+	"first_seen\x18\x06 \x01(\x03R\tfirstSeen\x12\x1b\n" +
+	"\tlast_seen\x18\a \x01(\x03R\blastSeen\x12\x1f\n" +
+	"\vresolved_at\x18\b \x01(\x03R\n" +
+	"resolvedAt\x12!\n" +
+	"\factive_since\x18\t \x01(\x03R\vactiveSince\x12)\n" +
+	"\x10duration_seconds\x18\n" +
+	" \x01(\x03R\x0fdurationSeconds\x12\x1d\n" +
+	"\n" +
+	"flap_count\x18\v \x01(\rR\tflapCount\x12-\n" +
+	"\x12consecutive_cycles\x18\f \x01(\rR\x11consecutiveCycles\x12\x16\n" +
+	"\x06active\x18\r \x01(\bR\x06active\x12\x1c\n" +
+	"\tconfirmed\x18\x0e \x01(\bR\tconfirmed\x12\x1a\n" +
+	"\bseverity\x18\x0f \x01(\tR\bseverity\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\x10 \x01(\bR\n" +
+	"suppressed\x12\"\n" +
+	"\facknowledged\x18\x11 \x01(\bR\facknowledged\"U\n" +
+	"\x14AnomalyLifecycleList\x12=\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x1f.communication.AnomalyLifecycleR\tanomalies\"\x91\x01\n" +
+	"\vLintFinding\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12\x1b\n" +
+	"\trule_name\x18\x02 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x16\n" +
+	"\x06object\x18\x04 \x01(\tR\x06object\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"D\n" +
+	"\n" +
+	"LintResult\x126\n" +
+	"\bfindings\x18\x01 \x03(\v2\x1a.communication.LintFindingR\bfindings\"\xf9\x04\n" +
 	"\x10AnomalyDetection\x12<\n" +
 	"\x19HasOverAdvertisedPrefixes\x18\x01 \x01(\bR\x19HasOverAdvertisedPrefixes\x128\n" +
 	"\x17HasUnAdvertisedPrefixes\x18\x02 \x01(\bR\x17HasUnAdvertisedPrefixes\x122\n" +
@@ -7669,7 +10095,9 @@ const file_protocol_proto_rawDesc = "" +
 	"\x18HasMisconfiguredPrefixes\x18\x04 \x01(\bR\x18HasMisconfiguredPrefixes\x12M\n" +
 	"\x13superfluous_entries\x18\x05 \x03(\v2\x1c.communication.AdvertisementR\x12superfluousEntries\x12E\n" +
 	"\x0fmissing_entries\x18\x06 \x03(\v2\x1c.communication.AdvertisementR\x0emissingEntries\x12I\n" +
-	"\x11duplicate_entries\x18\a \x03(\v2\x1c.communication.AdvertisementR\x10duplicateEntries\"\xfc\x01\n" +
+	"\x11duplicate_entries\x18\a \x03(\v2\x1c.communication.AdvertisementR\x10duplicateEntries\x12Q\n" +
+	"\x15misconfigured_entries\x18\b \x03(\v2\x1c.communication.AdvertisementR\x14misconfiguredEntries\x12I\n" +
+	"\x11explained_entries\x18\t \x03(\v2\x1c.communication.AdvertisementR\x10explainedEntries\"\xa7\x04\n" +
 	"\rAdvertisement\x12*\n" +
 	"\x10InterfaceAddress\x18\x01 \x01(\tR\x10InterfaceAddress\x12 \n" +
 	"\vLinkStateId\x18\x02 \x01(\tR\vLinkStateId\x12\"\n" +
@@ -7678,7 +10106,20 @@ const file_protocol_proto_rawDesc = "" +
 	"\x04PBit\x18\x05 \x01(\bR\x04PBit\x12\x18\n" +
 	"\aOptions\x18\x06 \x01(\tR\aOptions\x12\x12\n" +
 	"\x04Ospf\x18\a \x01(\bR\x04Ospf\x12\x1b\n" +
-	"\tospf_area\x18\b \x01(\tR\bospfArea\"j\n" +
+	"\tospf_area\x18\b \x01(\tR\bospfArea\x12\x16\n" +
+	"\x06metric\x18\t \x01(\x05R\x06metric\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12'\n" +
+	"\x0froute_selection\x18\v \x01(\tR\x0erouteSelection\x12\x1a\n" +
+	"\bseverity\x18\f \x01(\tR\bseverity\x12\x1e\n" +
+	"\n" +
+	"suppressed\x18\r \x01(\bR\n" +
+	"suppressed\x12\"\n" +
+	"\facknowledged\x18\x0e \x01(\bR\facknowledged\x12-\n" +
+	"\x12suppression_reason\x18\x0f \x01(\tR\x11suppressionReason\x12\x1f\n" +
+	"\vreason_code\x18\x10 \x01(\tR\n" +
+	"reasonCode\x12 \n" +
+	"\vexplanation\x18\x11 \x01(\tR\vexplanation\"j\n" +
 	"\x12AccessListAnalyzer\x12\x1e\n" +
 	"\n" +
 	"AccessList\x18\x01 \x01(\tR\n" +
@@ -7717,12 +10158,14 @@ const file_protocol_proto_rawDesc = "" +
 	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12#\n" +
 	"\rprefix_length\x18\x02 \x01(\tR\fprefixLength\x12\x1a\n" +
 	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12(\n" +
-	"\x10next_hop_address\x18\x04 \x01(\tR\x0enextHopAddress\"\xc0\x02\n" +
+	"\x10next_hop_address\x18\x04 \x01(\tR\x0enextHopAddress\"\xe3\x03\n" +
 	"\x12ParsedAnalyzerData\x12I\n" +
 	"\x12should_router_lsdb\x18\x01 \x01(\v2\x1b.communication.IntraAreaLsaR\x10shouldRouterLsdb\x12M\n" +
 	"\x14should_external_lsdb\x18\x02 \x01(\v2\x1b.communication.InterAreaLsaR\x12shouldExternalLsdb\x12V\n" +
 	"\x19should_nssa_external_lsdb\x18\x03 \x01(\v2\x1b.communication.InterAreaLsaR\x16shouldNssaExternalLsdb\x128\n" +
-	"\ap2p_map\x18\x04 \x01(\v2\x1f.communication.PeerInterfaceMapR\x06p2pMap\"\xf4\x01\n" +
+	"\ap2p_map\x18\x04 \x01(\v2\x1f.communication.PeerInterfaceMapR\x06p2pMap\x12K\n" +
+	"\x13should_summary_lsdb\x18\x05 \x01(\v2\x1b.communication.InterAreaLsaR\x11shouldSummaryLsdb\x12T\n" +
+	"\x18should_asbr_summary_lsdb\x18\x06 \x01(\v2\x1b.communication.InterAreaLsaR\x15shouldAsbrSummaryLsdb\"\xf4\x01\n" +
 	"\x0eOspfRouterInfo\x12\x1b\n" +
 	"\trouter_id\x18\x01 \x01(\tR\brouterId\x12a\n" +
 	"\x12router_link_states\x18\x02 \x03(\v23.communication.OspfRouterInfo.RouterLinkStatesEntryR\x10routerLinkStates\x1ab\n" +
@@ -7767,7 +10210,105 @@ const file_protocol_proto_rawDesc = "" +
 	"\x1a_designated_router_addressB\x1b\n" +
 	"\x19_router_interface_addressB\x12\n" +
 	"\x10_network_addressB\x0f\n" +
-	"\r_network_maskB0Z.github.com/frr-mad/frr-mad/src/backend/pkg;pkgb\x06proto3"
+	"\r_network_mask\"\xc6\x04\n" +
+	"\fHealthStatus\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x1a\n" +
+	"\bproblems\x18\x02 \x03(\tR\bproblems\x12%\n" +
+	"\x0edaemon_version\x18\x03 \x01(\tR\rdaemonVersion\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12>\n" +
+	"\n" +
+	"components\x18\x05 \x03(\v2\x1e.communication.ComponentHealthR\n" +
+	"components\x12'\n" +
+	"\x0flast_collection\x18\x06 \x01(\x03R\x0elastCollection\x12H\n" +
+	" collection_duration_microseconds\x18\a \x01(\x03R\x1ecollectionDurationMicroseconds\x128\n" +
+	"\bcommands\x18\b \x03(\v2\x1c.communication.CommandHealthR\bcommands\x12#\n" +
+	"\rlast_analysis\x18\t \x01(\x03R\flastAnalysis\x12D\n" +
+	"\x1eanalysis_duration_microseconds\x18\n" +
+	" \x01(\x03R\x1canalysisDurationMicroseconds\x12!\n" +
+	"\fparse_errors\x18\v \x01(\x04R\vparseErrors\x12C\n" +
+	"\x0esocket_clients\x18\f \x01(\v2\x1c.communication.SocketClientsR\rsocketClients\"\x8c\x01\n" +
+	"\x0fComponentHealth\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x1a\n" +
+	"\brestarts\x18\x04 \x01(\rR\brestarts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\"\xd9\x01\n" +
+	"\rCommandHealth\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\flast_success\x18\x02 \x01(\x03R\vlastSuccess\x123\n" +
+	"\x15duration_microseconds\x18\x03 \x01(\x03R\x14durationMicroseconds\x12\x1a\n" +
+	"\bfailures\x18\x04 \x01(\x04R\bfailures\x12!\n" +
+	"\fparse_errors\x18\x05 \x01(\x04R\vparseErrors\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\"\x87\x01\n" +
+	"\rSocketClients\x12 \n" +
+	"\vconnections\x18\x01 \x01(\x05R\vconnections\x12 \n" +
+	"\vsubscribers\x18\x02 \x01(\x05R\vsubscribers\x12\x16\n" +
+	"\x06served\x18\x03 \x01(\x04R\x06served\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x04R\brejected\"\xdf\x01\n" +
+	"\x05Query\x12\x12\n" +
+	"\x04area\x18\x01 \x01(\tR\x04area\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12\x19\n" +
+	"\blsa_type\x18\x04 \x01(\tR\alsaType\x12-\n" +
+	"\x12advertising_router\x18\x05 \x01(\tR\x11advertisingRouter\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06fields\x18\b \x03(\tR\x06fields\"^\n" +
+	"\x10AnomaliesRequest\x124\n" +
+	"\x06source\x18\x01 \x01(\x0e2\x1c.communication.AnomalySourceR\x06source\x12\x14\n" +
+	"\x05check\x18\x02 \x01(\tR\x05check\"Z\n" +
+	"\x12AcknowledgeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\tR\bduration\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment*m\n" +
+	"\x0fProtocolVersion\x12 \n" +
+	"\x1cPROTOCOL_VERSION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PROTOCOL_VERSION_1\x10\x01\x12\x1c\n" +
+	"\x18PROTOCOL_VERSION_CURRENT\x10\x01\x1a\x02\x10\x01*\xb9\x02\n" +
+	"\rAnomalySource\x12\x1e\n" +
+	"\x1aANOMALY_SOURCE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ANOMALY_SOURCE_ROUTER\x10\x01\x12\x1b\n" +
+	"\x17ANOMALY_SOURCE_EXTERNAL\x10\x02\x12 \n" +
+	"\x1cANOMALY_SOURCE_NSSA_EXTERNAL\x10\x03\x12\x1e\n" +
+	"\x1aANOMALY_SOURCE_LSDB_TO_RIB\x10\x04\x12\x1d\n" +
+	"\x19ANOMALY_SOURCE_RIB_TO_FIB\x10\x05\x12\x1a\n" +
+	"\x16ANOMALY_SOURCE_SUMMARY\x10\x06\x12\x1f\n" +
+	"\x1bANOMALY_SOURCE_ASBR_SUMMARY\x10\a\x12\x17\n" +
+	"\x13ANOMALY_SOURCE_ECMP\x10\b\x12\x19\n" +
+	"\x15ANOMALY_SOURCE_INTENT\x10\t2\xb4\x11\n" +
+	"\x06FrrMad\x128\n" +
+	"\bGetHello\x12\x16.google.protobuf.Empty\x1a\x14.communication.Hello\x12@\n" +
+	"\tGetStatus\x12\x16.google.protobuf.Empty\x1a\x1b.communication.HealthStatus\x12H\n" +
+	"\x10GetSystemMetrics\x12\x16.google.protobuf.Empty\x1a\x1c.communication.SystemMetrics\x12E\n" +
+	"\rGetRouterData\x12\x16.google.protobuf.Empty\x1a\x1c.communication.FRRRouterData\x12E\n" +
+	"\x06GetRib\x12\x14.communication.Query\x1a%.communication.RoutingInformationBase\x12L\n" +
+	"\x10GetRibFibSummary\x12\x14.communication.Query\x1a\".communication.RibFibSummaryRoutes\x12U\n" +
+	"\x16GetStaticConfiguration\x12\x14.communication.Query\x1a%.communication.StaticFRRConfiguration\x12D\n" +
+	"\x0fGetOspfDatabase\x12\x14.communication.Query\x1a\x1b.communication.OSPFDatabase\x12X\n" +
+	"\x19GetGeneralOspfInformation\x12\x14.communication.Query\x1a%.communication.GeneralOspfInformation\x12H\n" +
+	"\x11GetOspfRouterData\x12\x14.communication.Query\x1a\x1d.communication.OSPFRouterData\x12J\n" +
+	"\x12GetOspfNetworkData\x12\x14.communication.Query\x1a\x1e.communication.OSPFNetworkData\x12J\n" +
+	"\x12GetOspfSummaryData\x12\x14.communication.Query\x1a\x1e.communication.OSPFSummaryData\x12R\n" +
+	"\x16GetOspfAsbrSummaryData\x12\x14.communication.Query\x1a\".communication.OSPFAsbrSummaryData\x12L\n" +
+	"\x13GetOspfExternalData\x12\x14.communication.Query\x1a\x1f.communication.OSPFExternalData\x12T\n" +
+	"\x17GetOspfNssaExternalData\x12\x14.communication.Query\x1a#.communication.OSPFNssaExternalData\x12F\n" +
+	"\x10GetOspfNeighbors\x12\x14.communication.Query\x1a\x1c.communication.OSPFNeighbors\x12C\n" +
+	"\rGetInterfaces\x12\x14.communication.Query\x1a\x1c.communication.InterfaceList\x12P\n" +
+	"\fGetAnomalies\x12\x1f.communication.AnomaliesRequest\x1a\x1f.communication.AnomalyDetection\x12L\n" +
+	"\x10GetAreaAnomalies\x12\x16.google.protobuf.Empty\x1a .communication.AreaAnomaliesList\x12R\n" +
+	"\x13GetAnomalyLifecycle\x12\x16.google.protobuf.Empty\x1a#.communication.AnomalyLifecycleList\x12W\n" +
+	"\x12AcknowledgeAnomaly\x12!.communication.AcknowledgeRequest\x1a\x1e.communication.Acknowledgement\x12I\n" +
+	"\x0fGetCheckResults\x12\x16.google.protobuf.Empty\x1a\x1e.communication.CheckResultList\x12B\n" +
+	"\rGetLintResult\x12\x16.google.protobuf.Empty\x1a\x19.communication.LintResult\x12L\n" +
+	"\x0eWatchAnomalies\x12\x16.google.protobuf.Empty\x1a .communication.AreaAnomaliesList0\x01\x12V\n" +
+	"\x15WatchAnomalyLifecycle\x12\x16.google.protobuf.Empty\x1a#.communication.AnomalyLifecycleList0\x01\x12J\n" +
+	"\x12WatchOspfNeighbors\x12\x14.communication.Query\x1a\x1c.communication.OSPFNeighbors0\x01\x12G\n" +
+	"\x0fWatchInterfaces\x12\x14.communication.Query\x1a\x1c.communication.InterfaceList0\x01\x12H\n" +
+	"\x11WatchOspfDatabase\x12\x14.communication.Query\x1a\x1b.communication.OSPFDatabase0\x01\x12I\n" +
+	"\bWatchRib\x12\x14.communication.Query\x1a%.communication.RoutingInformationBase0\x01B0Z.github.com/frr-mad/frr-mad/src/backend/pkg;pkgb\x06proto3"
 
 var (
 	file_protocol_proto_rawDescOnce sync.Once
@@ -7781,265 +10322,392 @@ func file_protocol_proto_rawDescGZIP() []byte {
 	return file_protocol_proto_rawDescData
 }
 
-var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
+var file_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
 var file_protocol_proto_goTypes = []any{
-	(*Message)(nil),                // 0: communication.Message
-	(*Command)(nil),                // 1: communication.Command
-	(*Response)(nil),               // 2: communication.Response
-	(*PeerInterfaceMap)(nil),       // 3: communication.PeerInterfaceMap
-	(*ResponseValue)(nil),          // 4: communication.ResponseValue
-	(*NetworkConfig)(nil),          // 5: communication.NetworkConfig
-	(*OSPFArea)(nil),               // 6: communication.OSPFArea
-	(*OSPFInterfaceConfig)(nil),    // 7: communication.OSPFInterfaceConfig
-	(*FullFRRData)(nil),            // 8: communication.FullFRRData
-	(*StaticFRRConfiguration)(nil), // 9: communication.StaticFRRConfiguration
-	(*Interface)(nil),              // 10: communication.Interface
-	(*StaticRoute)(nil),            // 11: communication.StaticRoute
-	(*OSPFConfig)(nil),             // 12: communication.OSPFConfig
-	(*Redistribution)(nil),         // 13: communication.Redistribution
-	(*Area)(nil),                   // 14: communication.Area
-	(*RouteMap)(nil),               // 15: communication.RouteMap
-	(*AccessList)(nil),             // 16: communication.AccessList
-	(*AccessListItem)(nil),         // 17: communication.AccessListItem
-	(*InterfaceIPPrefix)(nil),      // 18: communication.InterfaceIPPrefix
-	(*IPPrefix)(nil),               // 19: communication.IPPrefix
-	(*SystemMetrics)(nil),          // 20: communication.SystemMetrics
-	(*GeneralOspfInformation)(nil), // 21: communication.GeneralOspfInformation
-	(*GeneralInfoOspfArea)(nil),    // 22: communication.GeneralInfoOspfArea
-	(*FRRRouterData)(nil),          // 23: communication.FRRRouterData
-	(*OSPFRouterData)(nil),         // 24: communication.OSPFRouterData
-	(*OSPFRouterArea)(nil),         // 25: communication.OSPFRouterArea
-	(*OSPFRouterLSA)(nil),          // 26: communication.OSPFRouterLSA
-	(*OSPFRouterLSALink)(nil),      // 27: communication.OSPFRouterLSALink
-	(*OSPFNetworkData)(nil),        // 28: communication.OSPFNetworkData
-	(*NetAreaState)(nil),           // 29: communication.NetAreaState
-	(*NetworkLSA)(nil),             // 30: communication.NetworkLSA
-	(*AttachedRouter)(nil),         // 31: communication.AttachedRouter
-	(*OSPFSummaryData)(nil),        // 32: communication.OSPFSummaryData
-	(*SummaryAreaState)(nil),       // 33: communication.SummaryAreaState
-	(*SummaryLSA)(nil),             // 34: communication.SummaryLSA
-	(*OSPFAsbrSummaryData)(nil),    // 35: communication.OSPFAsbrSummaryData
-	(*OSPFExternalData)(nil),       // 36: communication.OSPFExternalData
-	(*ExternalLSA)(nil),            // 37: communication.ExternalLSA
-	(*OSPFNssaExternalData)(nil),   // 38: communication.OSPFNssaExternalData
-	(*NssaExternalArea)(nil),       // 39: communication.NssaExternalArea
-	(*NssaExternalLSA)(nil),        // 40: communication.NssaExternalLSA
-	(*OSPFDatabase)(nil),           // 41: communication.OSPFDatabase
-	(*OSPFDatabaseArea)(nil),       // 42: communication.OSPFDatabaseArea
-	(*BaseLSA)(nil),                // 43: communication.BaseLSA
-	(*RouterDataLSA)(nil),          // 44: communication.RouterDataLSA
-	(*NetworkDataLSA)(nil),         // 45: communication.NetworkDataLSA
-	(*SummaryDataLSA)(nil),         // 46: communication.SummaryDataLSA
-	(*ASBRSummaryLSA)(nil),         // 47: communication.ASBRSummaryLSA
-	(*NSSAExternalLSAData)(nil),    // 48: communication.NSSAExternalLSAData
-	(*ASExternalLSA)(nil),          // 49: communication.ASExternalLSA
-	(*OSPFExternalAll)(nil),        // 50: communication.OSPFExternalAll
-	(*ASExternalLinkState)(nil),    // 51: communication.ASExternalLinkState
-	(*OSPFNssaExternalAll)(nil),    // 52: communication.OSPFNssaExternalAll
-	(*OSPFNeighbors)(nil),          // 53: communication.OSPFNeighbors
-	(*NeighborList)(nil),           // 54: communication.NeighborList
-	(*Neighbor)(nil),               // 55: communication.Neighbor
-	(*InterfaceList)(nil),          // 56: communication.InterfaceList
-	(*SingleInterface)(nil),        // 57: communication.SingleInterface
-	(*IpAddress)(nil),              // 58: communication.IpAddress
-	(*EvpnMh)(nil),                 // 59: communication.EvpnMh
-	(*RoutingInformationBase)(nil), // 60: communication.RoutingInformationBase
-	(*RouteEntry)(nil),             // 61: communication.RouteEntry
-	(*Route)(nil),                  // 62: communication.Route
-	(*Nexthop)(nil),                // 63: communication.Nexthop
-	(*RibFibSummaryRoutes)(nil),    // 64: communication.RibFibSummaryRoutes
-	(*RouteSummary)(nil),           // 65: communication.RouteSummary
-	(*AnomalyAnalysis)(nil),        // 66: communication.AnomalyAnalysis
-	(*AnomalyDetection)(nil),       // 67: communication.AnomalyDetection
-	(*Advertisement)(nil),          // 68: communication.Advertisement
-	(*AccessListAnalyzer)(nil),     // 69: communication.AccessListAnalyzer
-	(*ACLEntry)(nil),               // 70: communication.ACLEntry
-	(*StaticList)(nil),             // 71: communication.StaticList
-	(*IntraAreaLsa)(nil),           // 72: communication.IntraAreaLsa
-	(*InterAreaLsa)(nil),           // 73: communication.InterAreaLsa
-	(*AreaAnalyzer)(nil),           // 74: communication.AreaAnalyzer
-	(*RibPrefixes)(nil),            // 75: communication.RibPrefixes
-	(*ParsedAnalyzerData)(nil),     // 76: communication.ParsedAnalyzerData
-	(*OspfRouterInfo)(nil),         // 77: communication.OspfRouterInfo
-	(*AreaLinkStates)(nil),         // 78: communication.AreaLinkStates
-	(*RouterLSA)(nil),              // 79: communication.RouterLSA
-	(*RouterLink)(nil),             // 80: communication.RouterLink
-	nil,                            // 81: communication.Message.ParamsEntry
-	nil,                            // 82: communication.Command.ParamsEntry
-	nil,                            // 83: communication.PeerInterfaceMap.PeerInterfaceToAddressEntry
-	nil,                            // 84: communication.StaticFRRConfiguration.RouteMapEntry
-	nil,                            // 85: communication.StaticFRRConfiguration.AccessListEntry
-	nil,                            // 86: communication.GeneralOspfInformation.AreasEntry
-	nil,                            // 87: communication.OSPFRouterData.RouterStatesEntry
-	nil,                            // 88: communication.OSPFRouterArea.LsaEntriesEntry
-	nil,                            // 89: communication.OSPFRouterLSA.RouterLinksEntry
-	nil,                            // 90: communication.OSPFNetworkData.NetStatesEntry
-	nil,                            // 91: communication.NetAreaState.LsaEntriesEntry
-	nil,                            // 92: communication.NetworkLSA.AttachedRoutersEntry
-	nil,                            // 93: communication.OSPFSummaryData.NetStatesEntry
-	nil,                            // 94: communication.OSPFSummaryData.SummaryStatesEntry
-	nil,                            // 95: communication.SummaryAreaState.LsaEntriesEntry
-	nil,                            // 96: communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry
-	nil,                            // 97: communication.OSPFExternalData.AsExternalLinkStatesEntry
-	nil,                            // 98: communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry
-	nil,                            // 99: communication.NssaExternalArea.DataEntry
-	nil,                            // 100: communication.OSPFDatabase.AreasEntry
-	nil,                            // 101: communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry
-	nil,                            // 102: communication.OSPFNeighbors.NeighborsEntry
-	nil,                            // 103: communication.InterfaceList.InterfacesEntry
-	nil,                            // 104: communication.RoutingInformationBase.RoutesEntry
-	nil,                            // 105: communication.OspfRouterInfo.RouterLinkStatesEntry
-	nil,                            // 106: communication.AreaLinkStates.RouterLsasEntry
-	nil,                            // 107: communication.RouterLSA.RouterLinksEntry
+	(ProtocolVersion)(0),           // 0: communication.ProtocolVersion
+	(AnomalySource)(0),             // 1: communication.AnomalySource
+	(*Message)(nil),                // 2: communication.Message
+	(*Command)(nil),                // 3: communication.Command
+	(*Response)(nil),               // 4: communication.Response
+	(*Page)(nil),                   // 5: communication.Page
+	(*PeerInterfaceMap)(nil),       // 6: communication.PeerInterfaceMap
+	(*ResponseValue)(nil),          // 7: communication.ResponseValue
+	(*Hello)(nil),                  // 8: communication.Hello
+	(*ServiceCommands)(nil),        // 9: communication.ServiceCommands
+	(*NetworkConfig)(nil),          // 10: communication.NetworkConfig
+	(*OSPFArea)(nil),               // 11: communication.OSPFArea
+	(*OSPFInterfaceConfig)(nil),    // 12: communication.OSPFInterfaceConfig
+	(*FullFRRData)(nil),            // 13: communication.FullFRRData
+	(*StaticFRRConfiguration)(nil), // 14: communication.StaticFRRConfiguration
+	(*Interface)(nil),              // 15: communication.Interface
+	(*StaticRoute)(nil),            // 16: communication.StaticRoute
+	(*OSPFConfig)(nil),             // 17: communication.OSPFConfig
+	(*DefaultInformation)(nil),     // 18: communication.DefaultInformation
+	(*Redistribution)(nil),         // 19: communication.Redistribution
+	(*Area)(nil),                   // 20: communication.Area
+	(*AreaRange)(nil),              // 21: communication.AreaRange
+	(*RouteMap)(nil),               // 22: communication.RouteMap
+	(*AccessList)(nil),             // 23: communication.AccessList
+	(*AccessListItem)(nil),         // 24: communication.AccessListItem
+	(*PrefixList)(nil),             // 25: communication.PrefixList
+	(*PrefixListItem)(nil),         // 26: communication.PrefixListItem
+	(*InterfaceIPPrefix)(nil),      // 27: communication.InterfaceIPPrefix
+	(*IPPrefix)(nil),               // 28: communication.IPPrefix
+	(*SystemMetrics)(nil),          // 29: communication.SystemMetrics
+	(*GeneralOspfInformation)(nil), // 30: communication.GeneralOspfInformation
+	(*GeneralInfoOspfArea)(nil),    // 31: communication.GeneralInfoOspfArea
+	(*FRRRouterData)(nil),          // 32: communication.FRRRouterData
+	(*OSPFRouterData)(nil),         // 33: communication.OSPFRouterData
+	(*OSPFRouterArea)(nil),         // 34: communication.OSPFRouterArea
+	(*OSPFRouterLSA)(nil),          // 35: communication.OSPFRouterLSA
+	(*OSPFRouterLSALink)(nil),      // 36: communication.OSPFRouterLSALink
+	(*OSPFNetworkData)(nil),        // 37: communication.OSPFNetworkData
+	(*NetAreaState)(nil),           // 38: communication.NetAreaState
+	(*NetworkLSA)(nil),             // 39: communication.NetworkLSA
+	(*AttachedRouter)(nil),         // 40: communication.AttachedRouter
+	(*OSPFSummaryData)(nil),        // 41: communication.OSPFSummaryData
+	(*SummaryAreaState)(nil),       // 42: communication.SummaryAreaState
+	(*SummaryLSA)(nil),             // 43: communication.SummaryLSA
+	(*OSPFAsbrSummaryData)(nil),    // 44: communication.OSPFAsbrSummaryData
+	(*OSPFExternalData)(nil),       // 45: communication.OSPFExternalData
+	(*ExternalLSA)(nil),            // 46: communication.ExternalLSA
+	(*OSPFNssaExternalData)(nil),   // 47: communication.OSPFNssaExternalData
+	(*NssaExternalArea)(nil),       // 48: communication.NssaExternalArea
+	(*NssaExternalLSA)(nil),        // 49: communication.NssaExternalLSA
+	(*OSPFDatabase)(nil),           // 50: communication.OSPFDatabase
+	(*OSPFDatabaseArea)(nil),       // 51: communication.OSPFDatabaseArea
+	(*BaseLSA)(nil),                // 52: communication.BaseLSA
+	(*RouterDataLSA)(nil),          // 53: communication.RouterDataLSA
+	(*NetworkDataLSA)(nil),         // 54: communication.NetworkDataLSA
+	(*SummaryDataLSA)(nil),         // 55: communication.SummaryDataLSA
+	(*ASBRSummaryLSA)(nil),         // 56: communication.ASBRSummaryLSA
+	(*NSSAExternalLSAData)(nil),    // 57: communication.NSSAExternalLSAData
+	(*ASExternalLSA)(nil),          // 58: communication.ASExternalLSA
+	(*OSPFExternalAll)(nil),        // 59: communication.OSPFExternalAll
+	(*ASExternalLinkState)(nil),    // 60: communication.ASExternalLinkState
+	(*OSPFNssaExternalAll)(nil),    // 61: communication.OSPFNssaExternalAll
+	(*OSPFNeighbors)(nil),          // 62: communication.OSPFNeighbors
+	(*NeighborList)(nil),           // 63: communication.NeighborList
+	(*Neighbor)(nil),               // 64: communication.Neighbor
+	(*InterfaceList)(nil),          // 65: communication.InterfaceList
+	(*SingleInterface)(nil),        // 66: communication.SingleInterface
+	(*IpAddress)(nil),              // 67: communication.IpAddress
+	(*EvpnMh)(nil),                 // 68: communication.EvpnMh
+	(*RoutingInformationBase)(nil), // 69: communication.RoutingInformationBase
+	(*RouteEntry)(nil),             // 70: communication.RouteEntry
+	(*Route)(nil),                  // 71: communication.Route
+	(*Nexthop)(nil),                // 72: communication.Nexthop
+	(*RibFibSummaryRoutes)(nil),    // 73: communication.RibFibSummaryRoutes
+	(*RouteSummary)(nil),           // 74: communication.RouteSummary
+	(*AnomalyAnalysis)(nil),        // 75: communication.AnomalyAnalysis
+	(*AreaAnomalies)(nil),          // 76: communication.AreaAnomalies
+	(*AreaAnomaliesList)(nil),      // 77: communication.AreaAnomaliesList
+	(*CheckResult)(nil),            // 78: communication.CheckResult
+	(*CheckResultList)(nil),        // 79: communication.CheckResultList
+	(*Acknowledgement)(nil),        // 80: communication.Acknowledgement
+	(*AnomalyLifecycle)(nil),       // 81: communication.AnomalyLifecycle
+	(*AnomalyLifecycleList)(nil),   // 82: communication.AnomalyLifecycleList
+	(*LintFinding)(nil),            // 83: communication.LintFinding
+	(*LintResult)(nil),             // 84: communication.LintResult
+	(*AnomalyDetection)(nil),       // 85: communication.AnomalyDetection
+	(*Advertisement)(nil),          // 86: communication.Advertisement
+	(*AccessListAnalyzer)(nil),     // 87: communication.AccessListAnalyzer
+	(*ACLEntry)(nil),               // 88: communication.ACLEntry
+	(*StaticList)(nil),             // 89: communication.StaticList
+	(*IntraAreaLsa)(nil),           // 90: communication.IntraAreaLsa
+	(*InterAreaLsa)(nil),           // 91: communication.InterAreaLsa
+	(*AreaAnalyzer)(nil),           // 92: communication.AreaAnalyzer
+	(*RibPrefixes)(nil),            // 93: communication.RibPrefixes
+	(*ParsedAnalyzerData)(nil),     // 94: communication.ParsedAnalyzerData
+	(*OspfRouterInfo)(nil),         // 95: communication.OspfRouterInfo
+	(*AreaLinkStates)(nil),         // 96: communication.AreaLinkStates
+	(*RouterLSA)(nil),              // 97: communication.RouterLSA
+	(*RouterLink)(nil),             // 98: communication.RouterLink
+	(*HealthStatus)(nil),           // 99: communication.HealthStatus
+	(*ComponentHealth)(nil),        // 100: communication.ComponentHealth
+	(*CommandHealth)(nil),          // 101: communication.CommandHealth
+	(*SocketClients)(nil),          // 102: communication.SocketClients
+	(*Query)(nil),                  // 103: communication.Query
+	(*AnomaliesRequest)(nil),       // 104: communication.AnomaliesRequest
+	(*AcknowledgeRequest)(nil),     // 105: communication.AcknowledgeRequest
+	nil,                            // 106: communication.Message.ParamsEntry
+	nil,                            // 107: communication.Command.ParamsEntry
+	nil,                            // 108: communication.PeerInterfaceMap.PeerInterfaceToAddressEntry
+	nil,                            // 109: communication.StaticFRRConfiguration.RouteMapEntry
+	nil,                            // 110: communication.StaticFRRConfiguration.AccessListEntry
+	nil,                            // 111: communication.StaticFRRConfiguration.PrefixListEntry
+	nil,                            // 112: communication.GeneralOspfInformation.AreasEntry
+	nil,                            // 113: communication.OSPFRouterData.RouterStatesEntry
+	nil,                            // 114: communication.OSPFRouterArea.LsaEntriesEntry
+	nil,                            // 115: communication.OSPFRouterLSA.RouterLinksEntry
+	nil,                            // 116: communication.OSPFNetworkData.NetStatesEntry
+	nil,                            // 117: communication.NetAreaState.LsaEntriesEntry
+	nil,                            // 118: communication.NetworkLSA.AttachedRoutersEntry
+	nil,                            // 119: communication.OSPFSummaryData.NetStatesEntry
+	nil,                            // 120: communication.OSPFSummaryData.SummaryStatesEntry
+	nil,                            // 121: communication.SummaryAreaState.LsaEntriesEntry
+	nil,                            // 122: communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry
+	nil,                            // 123: communication.OSPFExternalData.AsExternalLinkStatesEntry
+	nil,                            // 124: communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry
+	nil,                            // 125: communication.NssaExternalArea.DataEntry
+	nil,                            // 126: communication.OSPFDatabase.AreasEntry
+	nil,                            // 127: communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry
+	nil,                            // 128: communication.OSPFNeighbors.NeighborsEntry
+	nil,                            // 129: communication.InterfaceList.InterfacesEntry
+	nil,                            // 130: communication.RoutingInformationBase.RoutesEntry
+	nil,                            // 131: communication.AnomalyAnalysis.AcknowledgementsEntry
+	nil,                            // 132: communication.AreaAnomalies.SourcesEntry
+	nil,                            // 133: communication.OspfRouterInfo.RouterLinkStatesEntry
+	nil,                            // 134: communication.AreaLinkStates.RouterLsasEntry
+	nil,                            // 135: communication.RouterLSA.RouterLinksEntry
+	(*emptypb.Empty)(nil),          // 136: google.protobuf.Empty
 }
 var file_protocol_proto_depIdxs = []int32{
-	81,  // 0: communication.Message.params:type_name -> communication.Message.ParamsEntry
-	82,  // 1: communication.Command.params:type_name -> communication.Command.ParamsEntry
-	4,   // 2: communication.Response.data:type_name -> communication.ResponseValue
-	83,  // 3: communication.PeerInterfaceMap.peer_interface_to_address:type_name -> communication.PeerInterfaceMap.PeerInterfaceToAddressEntry
-	76,  // 4: communication.ResponseValue.parsed_analyzer_data:type_name -> communication.ParsedAnalyzerData
-	67,  // 5: communication.ResponseValue.anomaly:type_name -> communication.AnomalyDetection
-	3,   // 6: communication.ResponseValue.peer_interface_to_address:type_name -> communication.PeerInterfaceMap
-	21,  // 7: communication.ResponseValue.general_ospf_information:type_name -> communication.GeneralOspfInformation
-	41,  // 8: communication.ResponseValue.ospf_database:type_name -> communication.OSPFDatabase
-	24,  // 9: communication.ResponseValue.ospf_router_data:type_name -> communication.OSPFRouterData
-	28,  // 10: communication.ResponseValue.ospf_network_data:type_name -> communication.OSPFNetworkData
-	32,  // 11: communication.ResponseValue.ospf_summary_data:type_name -> communication.OSPFSummaryData
-	35,  // 12: communication.ResponseValue.ospf_asbr_summary_data:type_name -> communication.OSPFAsbrSummaryData
-	36,  // 13: communication.ResponseValue.ospf_external_data:type_name -> communication.OSPFExternalData
-	38,  // 14: communication.ResponseValue.ospf_nssa_external_data:type_name -> communication.OSPFNssaExternalData
-	50,  // 15: communication.ResponseValue.ospf_external_all:type_name -> communication.OSPFExternalAll
-	52,  // 16: communication.ResponseValue.ospf_nssa_external_all:type_name -> communication.OSPFNssaExternalAll
-	53,  // 17: communication.ResponseValue.ospf_neighbors:type_name -> communication.OSPFNeighbors
-	56,  // 18: communication.ResponseValue.interfaces:type_name -> communication.InterfaceList
-	60,  // 19: communication.ResponseValue.routing_information_base:type_name -> communication.RoutingInformationBase
-	64,  // 20: communication.ResponseValue.rib_fib_summary_routes:type_name -> communication.RibFibSummaryRoutes
-	9,   // 21: communication.ResponseValue.static_frr_configuration:type_name -> communication.StaticFRRConfiguration
-	20,  // 22: communication.ResponseValue.system_metrics:type_name -> communication.SystemMetrics
-	23,  // 23: communication.ResponseValue.frr_router_data:type_name -> communication.FRRRouterData
-	6,   // 24: communication.NetworkConfig.areas:type_name -> communication.OSPFArea
-	7,   // 25: communication.NetworkConfig.interfaces:type_name -> communication.OSPFInterfaceConfig
-	41,  // 26: communication.FullFRRData.ospf_database:type_name -> communication.OSPFDatabase
-	24,  // 27: communication.FullFRRData.ospf_router_data:type_name -> communication.OSPFRouterData
-	21,  // 28: communication.FullFRRData.general_ospf_information:type_name -> communication.GeneralOspfInformation
-	24,  // 29: communication.FullFRRData.ospf_router_data_all:type_name -> communication.OSPFRouterData
-	28,  // 30: communication.FullFRRData.ospf_network_data:type_name -> communication.OSPFNetworkData
-	28,  // 31: communication.FullFRRData.ospf_network_data_all:type_name -> communication.OSPFNetworkData
-	32,  // 32: communication.FullFRRData.ospf_summary_data:type_name -> communication.OSPFSummaryData
-	32,  // 33: communication.FullFRRData.ospf_summary_data_all:type_name -> communication.OSPFSummaryData
-	35,  // 34: communication.FullFRRData.ospf_asbr_summary_data:type_name -> communication.OSPFAsbrSummaryData
-	36,  // 35: communication.FullFRRData.ospf_external_data:type_name -> communication.OSPFExternalData
-	38,  // 36: communication.FullFRRData.ospf_nssa_external_data:type_name -> communication.OSPFNssaExternalData
-	50,  // 37: communication.FullFRRData.ospf_external_all:type_name -> communication.OSPFExternalAll
-	52,  // 38: communication.FullFRRData.ospf_nssa_external_all:type_name -> communication.OSPFNssaExternalAll
-	53,  // 39: communication.FullFRRData.ospf_neighbors:type_name -> communication.OSPFNeighbors
-	56,  // 40: communication.FullFRRData.interfaces:type_name -> communication.InterfaceList
-	60,  // 41: communication.FullFRRData.routing_information_base:type_name -> communication.RoutingInformationBase
-	64,  // 42: communication.FullFRRData.rib_fib_summary_routes:type_name -> communication.RibFibSummaryRoutes
-	9,   // 43: communication.FullFRRData.static_frr_configuration:type_name -> communication.StaticFRRConfiguration
-	20,  // 44: communication.FullFRRData.system_metrics:type_name -> communication.SystemMetrics
-	23,  // 45: communication.FullFRRData.frr_router_data:type_name -> communication.FRRRouterData
-	10,  // 46: communication.StaticFRRConfiguration.interfaces:type_name -> communication.Interface
-	11,  // 47: communication.StaticFRRConfiguration.static_routes:type_name -> communication.StaticRoute
-	12,  // 48: communication.StaticFRRConfiguration.ospf_config:type_name -> communication.OSPFConfig
-	84,  // 49: communication.StaticFRRConfiguration.route_map:type_name -> communication.StaticFRRConfiguration.RouteMapEntry
-	85,  // 50: communication.StaticFRRConfiguration.access_list:type_name -> communication.StaticFRRConfiguration.AccessListEntry
-	18,  // 51: communication.Interface.interface_ip_prefixes:type_name -> communication.InterfaceIPPrefix
-	19,  // 52: communication.StaticRoute.ip_prefix:type_name -> communication.IPPrefix
-	13,  // 53: communication.OSPFConfig.redistribution:type_name -> communication.Redistribution
-	14,  // 54: communication.OSPFConfig.area:type_name -> communication.Area
-	17,  // 55: communication.AccessList.access_list_items:type_name -> communication.AccessListItem
-	19,  // 56: communication.AccessListItem.ip_prefix:type_name -> communication.IPPrefix
-	19,  // 57: communication.InterfaceIPPrefix.ip_prefix:type_name -> communication.IPPrefix
-	19,  // 58: communication.InterfaceIPPrefix.peer_ip_prefix:type_name -> communication.IPPrefix
-	86,  // 59: communication.GeneralOspfInformation.areas:type_name -> communication.GeneralOspfInformation.AreasEntry
-	87,  // 60: communication.OSPFRouterData.router_states:type_name -> communication.OSPFRouterData.RouterStatesEntry
-	88,  // 61: communication.OSPFRouterArea.lsa_entries:type_name -> communication.OSPFRouterArea.LsaEntriesEntry
-	89,  // 62: communication.OSPFRouterLSA.router_links:type_name -> communication.OSPFRouterLSA.RouterLinksEntry
-	90,  // 63: communication.OSPFNetworkData.net_states:type_name -> communication.OSPFNetworkData.NetStatesEntry
-	91,  // 64: communication.NetAreaState.lsa_entries:type_name -> communication.NetAreaState.LsaEntriesEntry
-	92,  // 65: communication.NetworkLSA.attached_routers:type_name -> communication.NetworkLSA.AttachedRoutersEntry
-	93,  // 66: communication.OSPFSummaryData.net_states:type_name -> communication.OSPFSummaryData.NetStatesEntry
-	94,  // 67: communication.OSPFSummaryData.summary_states:type_name -> communication.OSPFSummaryData.SummaryStatesEntry
-	95,  // 68: communication.SummaryAreaState.lsa_entries:type_name -> communication.SummaryAreaState.LsaEntriesEntry
-	96,  // 69: communication.OSPFAsbrSummaryData.asbr_summary_states:type_name -> communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry
-	97,  // 70: communication.OSPFExternalData.as_external_link_states:type_name -> communication.OSPFExternalData.AsExternalLinkStatesEntry
-	98,  // 71: communication.OSPFNssaExternalData.nssa_external_link_states:type_name -> communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry
-	99,  // 72: communication.NssaExternalArea.data:type_name -> communication.NssaExternalArea.DataEntry
-	100, // 73: communication.OSPFDatabase.areas:type_name -> communication.OSPFDatabase.AreasEntry
-	49,  // 74: communication.OSPFDatabase.as_external_link_states:type_name -> communication.ASExternalLSA
-	44,  // 75: communication.OSPFDatabaseArea.router_link_states:type_name -> communication.RouterDataLSA
-	45,  // 76: communication.OSPFDatabaseArea.network_link_states:type_name -> communication.NetworkDataLSA
-	46,  // 77: communication.OSPFDatabaseArea.summary_link_states:type_name -> communication.SummaryDataLSA
-	47,  // 78: communication.OSPFDatabaseArea.asbr_summary_link_states:type_name -> communication.ASBRSummaryLSA
-	48,  // 79: communication.OSPFDatabaseArea.nssa_external_link_states:type_name -> communication.NSSAExternalLSAData
-	43,  // 80: communication.RouterDataLSA.base:type_name -> communication.BaseLSA
-	43,  // 81: communication.NetworkDataLSA.base:type_name -> communication.BaseLSA
-	43,  // 82: communication.SummaryDataLSA.base:type_name -> communication.BaseLSA
-	43,  // 83: communication.ASBRSummaryLSA.base:type_name -> communication.BaseLSA
-	43,  // 84: communication.NSSAExternalLSAData.base:type_name -> communication.BaseLSA
-	43,  // 85: communication.ASExternalLSA.base:type_name -> communication.BaseLSA
-	51,  // 86: communication.OSPFExternalAll.as_external_link_states:type_name -> communication.ASExternalLinkState
-	101, // 87: communication.OSPFNssaExternalAll.nssa_external_all_link_states:type_name -> communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry
-	102, // 88: communication.OSPFNeighbors.neighbors:type_name -> communication.OSPFNeighbors.NeighborsEntry
-	55,  // 89: communication.NeighborList.neighbors:type_name -> communication.Neighbor
-	103, // 90: communication.InterfaceList.interfaces:type_name -> communication.InterfaceList.InterfacesEntry
-	58,  // 91: communication.SingleInterface.ip_addresses:type_name -> communication.IpAddress
-	59,  // 92: communication.SingleInterface.evpn_mh:type_name -> communication.EvpnMh
-	104, // 93: communication.RoutingInformationBase.routes:type_name -> communication.RoutingInformationBase.RoutesEntry
-	62,  // 94: communication.RouteEntry.routes:type_name -> communication.Route
-	63,  // 95: communication.Route.nexthops:type_name -> communication.Nexthop
-	65,  // 96: communication.RibFibSummaryRoutes.route_summaries:type_name -> communication.RouteSummary
-	67,  // 97: communication.AnomalyAnalysis.router_anomaly:type_name -> communication.AnomalyDetection
-	67,  // 98: communication.AnomalyAnalysis.external_anomaly:type_name -> communication.AnomalyDetection
-	67,  // 99: communication.AnomalyAnalysis.nssa_external_anomaly:type_name -> communication.AnomalyDetection
-	67,  // 100: communication.AnomalyAnalysis.lsdb_to_rib_anomaly:type_name -> communication.AnomalyDetection
-	67,  // 101: communication.AnomalyAnalysis.rib_to_fib_anomaly:type_name -> communication.AnomalyDetection
-	68,  // 102: communication.AnomalyDetection.superfluous_entries:type_name -> communication.Advertisement
-	68,  // 103: communication.AnomalyDetection.missing_entries:type_name -> communication.Advertisement
-	68,  // 104: communication.AnomalyDetection.duplicate_entries:type_name -> communication.Advertisement
-	70,  // 105: communication.AccessListAnalyzer.acl_entry:type_name -> communication.ACLEntry
-	74,  // 106: communication.IntraAreaLsa.areas:type_name -> communication.AreaAnalyzer
-	74,  // 107: communication.InterAreaLsa.areas:type_name -> communication.AreaAnalyzer
-	68,  // 108: communication.AreaAnalyzer.links:type_name -> communication.Advertisement
-	72,  // 109: communication.ParsedAnalyzerData.should_router_lsdb:type_name -> communication.IntraAreaLsa
-	73,  // 110: communication.ParsedAnalyzerData.should_external_lsdb:type_name -> communication.InterAreaLsa
-	73,  // 111: communication.ParsedAnalyzerData.should_nssa_external_lsdb:type_name -> communication.InterAreaLsa
-	3,   // 112: communication.ParsedAnalyzerData.p2p_map:type_name -> communication.PeerInterfaceMap
-	105, // 113: communication.OspfRouterInfo.router_link_states:type_name -> communication.OspfRouterInfo.RouterLinkStatesEntry
-	106, // 114: communication.AreaLinkStates.router_lsas:type_name -> communication.AreaLinkStates.RouterLsasEntry
-	107, // 115: communication.RouterLSA.router_links:type_name -> communication.RouterLSA.RouterLinksEntry
-	4,   // 116: communication.Message.ParamsEntry.value:type_name -> communication.ResponseValue
-	4,   // 117: communication.Command.ParamsEntry.value:type_name -> communication.ResponseValue
-	15,  // 118: communication.StaticFRRConfiguration.RouteMapEntry.value:type_name -> communication.RouteMap
-	16,  // 119: communication.StaticFRRConfiguration.AccessListEntry.value:type_name -> communication.AccessList
-	22,  // 120: communication.GeneralOspfInformation.AreasEntry.value:type_name -> communication.GeneralInfoOspfArea
-	25,  // 121: communication.OSPFRouterData.RouterStatesEntry.value:type_name -> communication.OSPFRouterArea
-	26,  // 122: communication.OSPFRouterArea.LsaEntriesEntry.value:type_name -> communication.OSPFRouterLSA
-	27,  // 123: communication.OSPFRouterLSA.RouterLinksEntry.value:type_name -> communication.OSPFRouterLSALink
-	29,  // 124: communication.OSPFNetworkData.NetStatesEntry.value:type_name -> communication.NetAreaState
-	30,  // 125: communication.NetAreaState.LsaEntriesEntry.value:type_name -> communication.NetworkLSA
-	31,  // 126: communication.NetworkLSA.AttachedRoutersEntry.value:type_name -> communication.AttachedRouter
-	29,  // 127: communication.OSPFSummaryData.NetStatesEntry.value:type_name -> communication.NetAreaState
-	33,  // 128: communication.OSPFSummaryData.SummaryStatesEntry.value:type_name -> communication.SummaryAreaState
-	34,  // 129: communication.SummaryAreaState.LsaEntriesEntry.value:type_name -> communication.SummaryLSA
-	33,  // 130: communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry.value:type_name -> communication.SummaryAreaState
-	37,  // 131: communication.OSPFExternalData.AsExternalLinkStatesEntry.value:type_name -> communication.ExternalLSA
-	39,  // 132: communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry.value:type_name -> communication.NssaExternalArea
-	40,  // 133: communication.NssaExternalArea.DataEntry.value:type_name -> communication.NssaExternalLSA
-	42,  // 134: communication.OSPFDatabase.AreasEntry.value:type_name -> communication.OSPFDatabaseArea
-	39,  // 135: communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry.value:type_name -> communication.NssaExternalArea
-	54,  // 136: communication.OSPFNeighbors.NeighborsEntry.value:type_name -> communication.NeighborList
-	57,  // 137: communication.InterfaceList.InterfacesEntry.value:type_name -> communication.SingleInterface
-	61,  // 138: communication.RoutingInformationBase.RoutesEntry.value:type_name -> communication.RouteEntry
-	78,  // 139: communication.OspfRouterInfo.RouterLinkStatesEntry.value:type_name -> communication.AreaLinkStates
-	79,  // 140: communication.AreaLinkStates.RouterLsasEntry.value:type_name -> communication.RouterLSA
-	80,  // 141: communication.RouterLSA.RouterLinksEntry.value:type_name -> communication.RouterLink
-	142, // [142:142] is the sub-list for method output_type
-	142, // [142:142] is the sub-list for method input_type
-	142, // [142:142] is the sub-list for extension type_name
-	142, // [142:142] is the sub-list for extension extendee
-	0,   // [0:142] is the sub-list for field type_name
+	106, // 0: communication.Message.params:type_name -> communication.Message.ParamsEntry
+	107, // 1: communication.Command.params:type_name -> communication.Command.ParamsEntry
+	7,   // 2: communication.Response.data:type_name -> communication.ResponseValue
+	5,   // 3: communication.Response.page:type_name -> communication.Page
+	108, // 4: communication.PeerInterfaceMap.peer_interface_to_address:type_name -> communication.PeerInterfaceMap.PeerInterfaceToAddressEntry
+	94,  // 5: communication.ResponseValue.parsed_analyzer_data:type_name -> communication.ParsedAnalyzerData
+	85,  // 6: communication.ResponseValue.anomaly:type_name -> communication.AnomalyDetection
+	6,   // 7: communication.ResponseValue.peer_interface_to_address:type_name -> communication.PeerInterfaceMap
+	30,  // 8: communication.ResponseValue.general_ospf_information:type_name -> communication.GeneralOspfInformation
+	50,  // 9: communication.ResponseValue.ospf_database:type_name -> communication.OSPFDatabase
+	33,  // 10: communication.ResponseValue.ospf_router_data:type_name -> communication.OSPFRouterData
+	37,  // 11: communication.ResponseValue.ospf_network_data:type_name -> communication.OSPFNetworkData
+	41,  // 12: communication.ResponseValue.ospf_summary_data:type_name -> communication.OSPFSummaryData
+	44,  // 13: communication.ResponseValue.ospf_asbr_summary_data:type_name -> communication.OSPFAsbrSummaryData
+	45,  // 14: communication.ResponseValue.ospf_external_data:type_name -> communication.OSPFExternalData
+	47,  // 15: communication.ResponseValue.ospf_nssa_external_data:type_name -> communication.OSPFNssaExternalData
+	59,  // 16: communication.ResponseValue.ospf_external_all:type_name -> communication.OSPFExternalAll
+	61,  // 17: communication.ResponseValue.ospf_nssa_external_all:type_name -> communication.OSPFNssaExternalAll
+	62,  // 18: communication.ResponseValue.ospf_neighbors:type_name -> communication.OSPFNeighbors
+	65,  // 19: communication.ResponseValue.interfaces:type_name -> communication.InterfaceList
+	69,  // 20: communication.ResponseValue.routing_information_base:type_name -> communication.RoutingInformationBase
+	73,  // 21: communication.ResponseValue.rib_fib_summary_routes:type_name -> communication.RibFibSummaryRoutes
+	14,  // 22: communication.ResponseValue.static_frr_configuration:type_name -> communication.StaticFRRConfiguration
+	29,  // 23: communication.ResponseValue.system_metrics:type_name -> communication.SystemMetrics
+	32,  // 24: communication.ResponseValue.frr_router_data:type_name -> communication.FRRRouterData
+	82,  // 25: communication.ResponseValue.anomaly_lifecycle:type_name -> communication.AnomalyLifecycleList
+	84,  // 26: communication.ResponseValue.lint_result:type_name -> communication.LintResult
+	79,  // 27: communication.ResponseValue.check_results:type_name -> communication.CheckResultList
+	77,  // 28: communication.ResponseValue.area_anomalies:type_name -> communication.AreaAnomaliesList
+	8,   // 29: communication.ResponseValue.hello:type_name -> communication.Hello
+	99,  // 30: communication.ResponseValue.health_status:type_name -> communication.HealthStatus
+	9,   // 31: communication.Hello.services:type_name -> communication.ServiceCommands
+	11,  // 32: communication.NetworkConfig.areas:type_name -> communication.OSPFArea
+	12,  // 33: communication.NetworkConfig.interfaces:type_name -> communication.OSPFInterfaceConfig
+	50,  // 34: communication.FullFRRData.ospf_database:type_name -> communication.OSPFDatabase
+	33,  // 35: communication.FullFRRData.ospf_router_data:type_name -> communication.OSPFRouterData
+	30,  // 36: communication.FullFRRData.general_ospf_information:type_name -> communication.GeneralOspfInformation
+	33,  // 37: communication.FullFRRData.ospf_router_data_all:type_name -> communication.OSPFRouterData
+	37,  // 38: communication.FullFRRData.ospf_network_data:type_name -> communication.OSPFNetworkData
+	37,  // 39: communication.FullFRRData.ospf_network_data_all:type_name -> communication.OSPFNetworkData
+	41,  // 40: communication.FullFRRData.ospf_summary_data:type_name -> communication.OSPFSummaryData
+	41,  // 41: communication.FullFRRData.ospf_summary_data_all:type_name -> communication.OSPFSummaryData
+	44,  // 42: communication.FullFRRData.ospf_asbr_summary_data:type_name -> communication.OSPFAsbrSummaryData
+	45,  // 43: communication.FullFRRData.ospf_external_data:type_name -> communication.OSPFExternalData
+	47,  // 44: communication.FullFRRData.ospf_nssa_external_data:type_name -> communication.OSPFNssaExternalData
+	59,  // 45: communication.FullFRRData.ospf_external_all:type_name -> communication.OSPFExternalAll
+	61,  // 46: communication.FullFRRData.ospf_nssa_external_all:type_name -> communication.OSPFNssaExternalAll
+	62,  // 47: communication.FullFRRData.ospf_neighbors:type_name -> communication.OSPFNeighbors
+	65,  // 48: communication.FullFRRData.interfaces:type_name -> communication.InterfaceList
+	69,  // 49: communication.FullFRRData.routing_information_base:type_name -> communication.RoutingInformationBase
+	73,  // 50: communication.FullFRRData.rib_fib_summary_routes:type_name -> communication.RibFibSummaryRoutes
+	14,  // 51: communication.FullFRRData.static_frr_configuration:type_name -> communication.StaticFRRConfiguration
+	29,  // 52: communication.FullFRRData.system_metrics:type_name -> communication.SystemMetrics
+	32,  // 53: communication.FullFRRData.frr_router_data:type_name -> communication.FRRRouterData
+	15,  // 54: communication.StaticFRRConfiguration.interfaces:type_name -> communication.Interface
+	16,  // 55: communication.StaticFRRConfiguration.static_routes:type_name -> communication.StaticRoute
+	17,  // 56: communication.StaticFRRConfiguration.ospf_config:type_name -> communication.OSPFConfig
+	109, // 57: communication.StaticFRRConfiguration.route_map:type_name -> communication.StaticFRRConfiguration.RouteMapEntry
+	110, // 58: communication.StaticFRRConfiguration.access_list:type_name -> communication.StaticFRRConfiguration.AccessListEntry
+	111, // 59: communication.StaticFRRConfiguration.prefix_list:type_name -> communication.StaticFRRConfiguration.PrefixListEntry
+	27,  // 60: communication.Interface.interface_ip_prefixes:type_name -> communication.InterfaceIPPrefix
+	28,  // 61: communication.StaticRoute.ip_prefix:type_name -> communication.IPPrefix
+	19,  // 62: communication.OSPFConfig.redistribution:type_name -> communication.Redistribution
+	20,  // 63: communication.OSPFConfig.area:type_name -> communication.Area
+	21,  // 64: communication.OSPFConfig.area_range:type_name -> communication.AreaRange
+	18,  // 65: communication.OSPFConfig.default_information:type_name -> communication.DefaultInformation
+	28,  // 66: communication.AreaRange.ip_prefix:type_name -> communication.IPPrefix
+	24,  // 67: communication.AccessList.access_list_items:type_name -> communication.AccessListItem
+	28,  // 68: communication.AccessListItem.ip_prefix:type_name -> communication.IPPrefix
+	26,  // 69: communication.PrefixList.prefix_list_items:type_name -> communication.PrefixListItem
+	28,  // 70: communication.PrefixListItem.ip_prefix:type_name -> communication.IPPrefix
+	28,  // 71: communication.InterfaceIPPrefix.ip_prefix:type_name -> communication.IPPrefix
+	28,  // 72: communication.InterfaceIPPrefix.peer_ip_prefix:type_name -> communication.IPPrefix
+	112, // 73: communication.GeneralOspfInformation.areas:type_name -> communication.GeneralOspfInformation.AreasEntry
+	113, // 74: communication.OSPFRouterData.router_states:type_name -> communication.OSPFRouterData.RouterStatesEntry
+	114, // 75: communication.OSPFRouterArea.lsa_entries:type_name -> communication.OSPFRouterArea.LsaEntriesEntry
+	115, // 76: communication.OSPFRouterLSA.router_links:type_name -> communication.OSPFRouterLSA.RouterLinksEntry
+	116, // 77: communication.OSPFNetworkData.net_states:type_name -> communication.OSPFNetworkData.NetStatesEntry
+	117, // 78: communication.NetAreaState.lsa_entries:type_name -> communication.NetAreaState.LsaEntriesEntry
+	118, // 79: communication.NetworkLSA.attached_routers:type_name -> communication.NetworkLSA.AttachedRoutersEntry
+	119, // 80: communication.OSPFSummaryData.net_states:type_name -> communication.OSPFSummaryData.NetStatesEntry
+	120, // 81: communication.OSPFSummaryData.summary_states:type_name -> communication.OSPFSummaryData.SummaryStatesEntry
+	121, // 82: communication.SummaryAreaState.lsa_entries:type_name -> communication.SummaryAreaState.LsaEntriesEntry
+	122, // 83: communication.OSPFAsbrSummaryData.asbr_summary_states:type_name -> communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry
+	123, // 84: communication.OSPFExternalData.as_external_link_states:type_name -> communication.OSPFExternalData.AsExternalLinkStatesEntry
+	124, // 85: communication.OSPFNssaExternalData.nssa_external_link_states:type_name -> communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry
+	125, // 86: communication.NssaExternalArea.data:type_name -> communication.NssaExternalArea.DataEntry
+	126, // 87: communication.OSPFDatabase.areas:type_name -> communication.OSPFDatabase.AreasEntry
+	58,  // 88: communication.OSPFDatabase.as_external_link_states:type_name -> communication.ASExternalLSA
+	53,  // 89: communication.OSPFDatabaseArea.router_link_states:type_name -> communication.RouterDataLSA
+	54,  // 90: communication.OSPFDatabaseArea.network_link_states:type_name -> communication.NetworkDataLSA
+	55,  // 91: communication.OSPFDatabaseArea.summary_link_states:type_name -> communication.SummaryDataLSA
+	56,  // 92: communication.OSPFDatabaseArea.asbr_summary_link_states:type_name -> communication.ASBRSummaryLSA
+	57,  // 93: communication.OSPFDatabaseArea.nssa_external_link_states:type_name -> communication.NSSAExternalLSAData
+	52,  // 94: communication.RouterDataLSA.base:type_name -> communication.BaseLSA
+	52,  // 95: communication.NetworkDataLSA.base:type_name -> communication.BaseLSA
+	52,  // 96: communication.SummaryDataLSA.base:type_name -> communication.BaseLSA
+	52,  // 97: communication.ASBRSummaryLSA.base:type_name -> communication.BaseLSA
+	52,  // 98: communication.NSSAExternalLSAData.base:type_name -> communication.BaseLSA
+	52,  // 99: communication.ASExternalLSA.base:type_name -> communication.BaseLSA
+	60,  // 100: communication.OSPFExternalAll.as_external_link_states:type_name -> communication.ASExternalLinkState
+	127, // 101: communication.OSPFNssaExternalAll.nssa_external_all_link_states:type_name -> communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry
+	128, // 102: communication.OSPFNeighbors.neighbors:type_name -> communication.OSPFNeighbors.NeighborsEntry
+	64,  // 103: communication.NeighborList.neighbors:type_name -> communication.Neighbor
+	129, // 104: communication.InterfaceList.interfaces:type_name -> communication.InterfaceList.InterfacesEntry
+	67,  // 105: communication.SingleInterface.ip_addresses:type_name -> communication.IpAddress
+	68,  // 106: communication.SingleInterface.evpn_mh:type_name -> communication.EvpnMh
+	130, // 107: communication.RoutingInformationBase.routes:type_name -> communication.RoutingInformationBase.RoutesEntry
+	71,  // 108: communication.RouteEntry.routes:type_name -> communication.Route
+	72,  // 109: communication.Route.nexthops:type_name -> communication.Nexthop
+	74,  // 110: communication.RibFibSummaryRoutes.route_summaries:type_name -> communication.RouteSummary
+	85,  // 111: communication.AnomalyAnalysis.router_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 112: communication.AnomalyAnalysis.external_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 113: communication.AnomalyAnalysis.nssa_external_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 114: communication.AnomalyAnalysis.lsdb_to_rib_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 115: communication.AnomalyAnalysis.rib_to_fib_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 116: communication.AnomalyAnalysis.summary_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 117: communication.AnomalyAnalysis.asbr_summary_anomaly:type_name -> communication.AnomalyDetection
+	85,  // 118: communication.AnomalyAnalysis.ecmp_anomaly:type_name -> communication.AnomalyDetection
+	81,  // 119: communication.AnomalyAnalysis.lifecycle:type_name -> communication.AnomalyLifecycle
+	131, // 120: communication.AnomalyAnalysis.acknowledgements:type_name -> communication.AnomalyAnalysis.AcknowledgementsEntry
+	85,  // 121: communication.AnomalyAnalysis.intent_anomaly:type_name -> communication.AnomalyDetection
+	78,  // 122: communication.AnomalyAnalysis.check_results:type_name -> communication.CheckResult
+	76,  // 123: communication.AnomalyAnalysis.area_anomalies:type_name -> communication.AreaAnomalies
+	132, // 124: communication.AreaAnomalies.sources:type_name -> communication.AreaAnomalies.SourcesEntry
+	76,  // 125: communication.AreaAnomaliesList.areas:type_name -> communication.AreaAnomalies
+	85,  // 126: communication.CheckResult.findings:type_name -> communication.AnomalyDetection
+	78,  // 127: communication.CheckResultList.check_results:type_name -> communication.CheckResult
+	81,  // 128: communication.AnomalyLifecycleList.anomalies:type_name -> communication.AnomalyLifecycle
+	83,  // 129: communication.LintResult.findings:type_name -> communication.LintFinding
+	86,  // 130: communication.AnomalyDetection.superfluous_entries:type_name -> communication.Advertisement
+	86,  // 131: communication.AnomalyDetection.missing_entries:type_name -> communication.Advertisement
+	86,  // 132: communication.AnomalyDetection.duplicate_entries:type_name -> communication.Advertisement
+	86,  // 133: communication.AnomalyDetection.misconfigured_entries:type_name -> communication.Advertisement
+	86,  // 134: communication.AnomalyDetection.explained_entries:type_name -> communication.Advertisement
+	88,  // 135: communication.AccessListAnalyzer.acl_entry:type_name -> communication.ACLEntry
+	92,  // 136: communication.IntraAreaLsa.areas:type_name -> communication.AreaAnalyzer
+	92,  // 137: communication.InterAreaLsa.areas:type_name -> communication.AreaAnalyzer
+	86,  // 138: communication.AreaAnalyzer.links:type_name -> communication.Advertisement
+	90,  // 139: communication.ParsedAnalyzerData.should_router_lsdb:type_name -> communication.IntraAreaLsa
+	91,  // 140: communication.ParsedAnalyzerData.should_external_lsdb:type_name -> communication.InterAreaLsa
+	91,  // 141: communication.ParsedAnalyzerData.should_nssa_external_lsdb:type_name -> communication.InterAreaLsa
+	6,   // 142: communication.ParsedAnalyzerData.p2p_map:type_name -> communication.PeerInterfaceMap
+	91,  // 143: communication.ParsedAnalyzerData.should_summary_lsdb:type_name -> communication.InterAreaLsa
+	91,  // 144: communication.ParsedAnalyzerData.should_asbr_summary_lsdb:type_name -> communication.InterAreaLsa
+	133, // 145: communication.OspfRouterInfo.router_link_states:type_name -> communication.OspfRouterInfo.RouterLinkStatesEntry
+	134, // 146: communication.AreaLinkStates.router_lsas:type_name -> communication.AreaLinkStates.RouterLsasEntry
+	135, // 147: communication.RouterLSA.router_links:type_name -> communication.RouterLSA.RouterLinksEntry
+	100, // 148: communication.HealthStatus.components:type_name -> communication.ComponentHealth
+	101, // 149: communication.HealthStatus.commands:type_name -> communication.CommandHealth
+	102, // 150: communication.HealthStatus.socket_clients:type_name -> communication.SocketClients
+	1,   // 151: communication.AnomaliesRequest.source:type_name -> communication.AnomalySource
+	7,   // 152: communication.Message.ParamsEntry.value:type_name -> communication.ResponseValue
+	7,   // 153: communication.Command.ParamsEntry.value:type_name -> communication.ResponseValue
+	22,  // 154: communication.StaticFRRConfiguration.RouteMapEntry.value:type_name -> communication.RouteMap
+	23,  // 155: communication.StaticFRRConfiguration.AccessListEntry.value:type_name -> communication.AccessList
+	25,  // 156: communication.StaticFRRConfiguration.PrefixListEntry.value:type_name -> communication.PrefixList
+	31,  // 157: communication.GeneralOspfInformation.AreasEntry.value:type_name -> communication.GeneralInfoOspfArea
+	34,  // 158: communication.OSPFRouterData.RouterStatesEntry.value:type_name -> communication.OSPFRouterArea
+	35,  // 159: communication.OSPFRouterArea.LsaEntriesEntry.value:type_name -> communication.OSPFRouterLSA
+	36,  // 160: communication.OSPFRouterLSA.RouterLinksEntry.value:type_name -> communication.OSPFRouterLSALink
+	38,  // 161: communication.OSPFNetworkData.NetStatesEntry.value:type_name -> communication.NetAreaState
+	39,  // 162: communication.NetAreaState.LsaEntriesEntry.value:type_name -> communication.NetworkLSA
+	40,  // 163: communication.NetworkLSA.AttachedRoutersEntry.value:type_name -> communication.AttachedRouter
+	38,  // 164: communication.OSPFSummaryData.NetStatesEntry.value:type_name -> communication.NetAreaState
+	42,  // 165: communication.OSPFSummaryData.SummaryStatesEntry.value:type_name -> communication.SummaryAreaState
+	43,  // 166: communication.SummaryAreaState.LsaEntriesEntry.value:type_name -> communication.SummaryLSA
+	42,  // 167: communication.OSPFAsbrSummaryData.AsbrSummaryStatesEntry.value:type_name -> communication.SummaryAreaState
+	46,  // 168: communication.OSPFExternalData.AsExternalLinkStatesEntry.value:type_name -> communication.ExternalLSA
+	48,  // 169: communication.OSPFNssaExternalData.NssaExternalLinkStatesEntry.value:type_name -> communication.NssaExternalArea
+	49,  // 170: communication.NssaExternalArea.DataEntry.value:type_name -> communication.NssaExternalLSA
+	51,  // 171: communication.OSPFDatabase.AreasEntry.value:type_name -> communication.OSPFDatabaseArea
+	48,  // 172: communication.OSPFNssaExternalAll.NssaExternalAllLinkStatesEntry.value:type_name -> communication.NssaExternalArea
+	63,  // 173: communication.OSPFNeighbors.NeighborsEntry.value:type_name -> communication.NeighborList
+	66,  // 174: communication.InterfaceList.InterfacesEntry.value:type_name -> communication.SingleInterface
+	70,  // 175: communication.RoutingInformationBase.RoutesEntry.value:type_name -> communication.RouteEntry
+	80,  // 176: communication.AnomalyAnalysis.AcknowledgementsEntry.value:type_name -> communication.Acknowledgement
+	85,  // 177: communication.AreaAnomalies.SourcesEntry.value:type_name -> communication.AnomalyDetection
+	96,  // 178: communication.OspfRouterInfo.RouterLinkStatesEntry.value:type_name -> communication.AreaLinkStates
+	97,  // 179: communication.AreaLinkStates.RouterLsasEntry.value:type_name -> communication.RouterLSA
+	98,  // 180: communication.RouterLSA.RouterLinksEntry.value:type_name -> communication.RouterLink
+	136, // 181: communication.FrrMad.GetHello:input_type -> google.protobuf.Empty
+	136, // 182: communication.FrrMad.GetStatus:input_type -> google.protobuf.Empty
+	136, // 183: communication.FrrMad.GetSystemMetrics:input_type -> google.protobuf.Empty
+	136, // 184: communication.FrrMad.GetRouterData:input_type -> google.protobuf.Empty
+	103, // 185: communication.FrrMad.GetRib:input_type -> communication.Query
+	103, // 186: communication.FrrMad.GetRibFibSummary:input_type -> communication.Query
+	103, // 187: communication.FrrMad.GetStaticConfiguration:input_type -> communication.Query
+	103, // 188: communication.FrrMad.GetOspfDatabase:input_type -> communication.Query
+	103, // 189: communication.FrrMad.GetGeneralOspfInformation:input_type -> communication.Query
+	103, // 190: communication.FrrMad.GetOspfRouterData:input_type -> communication.Query
+	103, // 191: communication.FrrMad.GetOspfNetworkData:input_type -> communication.Query
+	103, // 192: communication.FrrMad.GetOspfSummaryData:input_type -> communication.Query
+	103, // 193: communication.FrrMad.GetOspfAsbrSummaryData:input_type -> communication.Query
+	103, // 194: communication.FrrMad.GetOspfExternalData:input_type -> communication.Query
+	103, // 195: communication.FrrMad.GetOspfNssaExternalData:input_type -> communication.Query
+	103, // 196: communication.FrrMad.GetOspfNeighbors:input_type -> communication.Query
+	103, // 197: communication.FrrMad.GetInterfaces:input_type -> communication.Query
+	104, // 198: communication.FrrMad.GetAnomalies:input_type -> communication.AnomaliesRequest
+	136, // 199: communication.FrrMad.GetAreaAnomalies:input_type -> google.protobuf.Empty
+	136, // 200: communication.FrrMad.GetAnomalyLifecycle:input_type -> google.protobuf.Empty
+	105, // 201: communication.FrrMad.AcknowledgeAnomaly:input_type -> communication.AcknowledgeRequest
+	136, // 202: communication.FrrMad.GetCheckResults:input_type -> google.protobuf.Empty
+	136, // 203: communication.FrrMad.GetLintResult:input_type -> google.protobuf.Empty
+	136, // 204: communication.FrrMad.WatchAnomalies:input_type -> google.protobuf.Empty
+	136, // 205: communication.FrrMad.WatchAnomalyLifecycle:input_type -> google.protobuf.Empty
+	103, // 206: communication.FrrMad.WatchOspfNeighbors:input_type -> communication.Query
+	103, // 207: communication.FrrMad.WatchInterfaces:input_type -> communication.Query
+	103, // 208: communication.FrrMad.WatchOspfDatabase:input_type -> communication.Query
+	103, // 209: communication.FrrMad.WatchRib:input_type -> communication.Query
+	8,   // 210: communication.FrrMad.GetHello:output_type -> communication.Hello
+	99,  // 211: communication.FrrMad.GetStatus:output_type -> communication.HealthStatus
+	29,  // 212: communication.FrrMad.GetSystemMetrics:output_type -> communication.SystemMetrics
+	32,  // 213: communication.FrrMad.GetRouterData:output_type -> communication.FRRRouterData
+	69,  // 214: communication.FrrMad.GetRib:output_type -> communication.RoutingInformationBase
+	73,  // 215: communication.FrrMad.GetRibFibSummary:output_type -> communication.RibFibSummaryRoutes
+	14,  // 216: communication.FrrMad.GetStaticConfiguration:output_type -> communication.StaticFRRConfiguration
+	50,  // 217: communication.FrrMad.GetOspfDatabase:output_type -> communication.OSPFDatabase
+	30,  // 218: communication.FrrMad.GetGeneralOspfInformation:output_type -> communication.GeneralOspfInformation
+	33,  // 219: communication.FrrMad.GetOspfRouterData:output_type -> communication.OSPFRouterData
+	37,  // 220: communication.FrrMad.GetOspfNetworkData:output_type -> communication.OSPFNetworkData
+	41,  // 221: communication.FrrMad.GetOspfSummaryData:output_type -> communication.OSPFSummaryData
+	44,  // 222: communication.FrrMad.GetOspfAsbrSummaryData:output_type -> communication.OSPFAsbrSummaryData
+	45,  // 223: communication.FrrMad.GetOspfExternalData:output_type -> communication.OSPFExternalData
+	47,  // 224: communication.FrrMad.GetOspfNssaExternalData:output_type -> communication.OSPFNssaExternalData
+	62,  // 225: communication.FrrMad.GetOspfNeighbors:output_type -> communication.OSPFNeighbors
+	65,  // 226: communication.FrrMad.GetInterfaces:output_type -> communication.InterfaceList
+	85,  // 227: communication.FrrMad.GetAnomalies:output_type -> communication.AnomalyDetection
+	77,  // 228: communication.FrrMad.GetAreaAnomalies:output_type -> communication.AreaAnomaliesList
+	82,  // 229: communication.FrrMad.GetAnomalyLifecycle:output_type -> communication.AnomalyLifecycleList
+	80,  // 230: communication.FrrMad.AcknowledgeAnomaly:output_type -> communication.Acknowledgement
+	79,  // 231: communication.FrrMad.GetCheckResults:output_type -> communication.CheckResultList
+	84,  // 232: communication.FrrMad.GetLintResult:output_type -> communication.LintResult
+	77,  // 233: communication.FrrMad.WatchAnomalies:output_type -> communication.AreaAnomaliesList
+	82,  // 234: communication.FrrMad.WatchAnomalyLifecycle:output_type -> communication.AnomalyLifecycleList
+	62,  // 235: communication.FrrMad.WatchOspfNeighbors:output_type -> communication.OSPFNeighbors
+	65,  // 236: communication.FrrMad.WatchInterfaces:output_type -> communication.InterfaceList
+	50,  // 237: communication.FrrMad.WatchOspfDatabase:output_type -> communication.OSPFDatabase
+	69,  // 238: communication.FrrMad.WatchRib:output_type -> communication.RoutingInformationBase
+	210, // [210:239] is the sub-list for method output_type
+	181, // [181:210] is the sub-list for method input_type
+	181, // [181:181] is the sub-list for extension type_name
+	181, // [181:181] is the sub-list for extension extendee
+	0,   // [0:181] is the sub-list for field type_name
 }
 
 func init() { file_protocol_proto_init() }
@@ -8047,7 +10715,7 @@ func file_protocol_proto_init() {
 	if File_protocol_proto != nil {
 		return
 	}
-	file_protocol_proto_msgTypes[4].OneofWrappers = []any{
+	file_protocol_proto_msgTypes[5].OneofWrappers = []any{
 		(*ResponseValue_StringValue)(nil),
 		(*ResponseValue_ParsedAnalyzerData)(nil),
 		(*ResponseValue_Anomaly)(nil),
@@ -8069,24 +10737,31 @@ func file_protocol_proto_init() {
 		(*ResponseValue_StaticFrrConfiguration)(nil),
 		(*ResponseValue_SystemMetrics)(nil),
 		(*ResponseValue_FrrRouterData)(nil),
-	}
-	file_protocol_proto_msgTypes[17].OneofWrappers = []any{
+		(*ResponseValue_AnomalyLifecycle)(nil),
+		(*ResponseValue_LintResult)(nil),
+		(*ResponseValue_CheckResults)(nil),
+		(*ResponseValue_AreaAnomalies)(nil),
+		(*ResponseValue_Hello)(nil),
+		(*ResponseValue_HealthStatus)(nil),
+	}
+	file_protocol_proto_msgTypes[22].OneofWrappers = []any{
 		(*AccessListItem_IpPrefix)(nil),
 		(*AccessListItem_Any)(nil),
 	}
-	file_protocol_proto_msgTypes[80].OneofWrappers = []any{}
+	file_protocol_proto_msgTypes[96].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protocol_proto_rawDesc), len(file_protocol_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   108,
+			NumEnums:      2,
+			NumMessages:   134,
 			NumExtensions: 0,
-			NumServices:   0,
+			NumServices:   1,
 		},
 		GoTypes:           file_protocol_proto_goTypes,
 		DependencyIndexes: file_protocol_proto_depIdxs,
+		EnumInfos:         file_protocol_proto_enumTypes,
 		MessageInfos:      file_protocol_proto_msgTypes,
 	}.Build()
 	File_protocol_proto = out.File
@@ -0,0 +1,1221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.30.2
+// source: protocol.proto
+
+package pkg
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FrrMad_GetHello_FullMethodName                  = "/communication.FrrMad/GetHello"
+	FrrMad_GetStatus_FullMethodName                 = "/communication.FrrMad/GetStatus"
+	FrrMad_GetSystemMetrics_FullMethodName          = "/communication.FrrMad/GetSystemMetrics"
+	FrrMad_GetRouterData_FullMethodName             = "/communication.FrrMad/GetRouterData"
+	FrrMad_GetRib_FullMethodName                    = "/communication.FrrMad/GetRib"
+	FrrMad_GetRibFibSummary_FullMethodName          = "/communication.FrrMad/GetRibFibSummary"
+	FrrMad_GetStaticConfiguration_FullMethodName    = "/communication.FrrMad/GetStaticConfiguration"
+	FrrMad_GetOspfDatabase_FullMethodName           = "/communication.FrrMad/GetOspfDatabase"
+	FrrMad_GetGeneralOspfInformation_FullMethodName = "/communication.FrrMad/GetGeneralOspfInformation"
+	FrrMad_GetOspfRouterData_FullMethodName         = "/communication.FrrMad/GetOspfRouterData"
+	FrrMad_GetOspfNetworkData_FullMethodName        = "/communication.FrrMad/GetOspfNetworkData"
+	FrrMad_GetOspfSummaryData_FullMethodName        = "/communication.FrrMad/GetOspfSummaryData"
+	FrrMad_GetOspfAsbrSummaryData_FullMethodName    = "/communication.FrrMad/GetOspfAsbrSummaryData"
+	FrrMad_GetOspfExternalData_FullMethodName       = "/communication.FrrMad/GetOspfExternalData"
+	FrrMad_GetOspfNssaExternalData_FullMethodName   = "/communication.FrrMad/GetOspfNssaExternalData"
+	FrrMad_GetOspfNeighbors_FullMethodName          = "/communication.FrrMad/GetOspfNeighbors"
+	FrrMad_GetInterfaces_FullMethodName             = "/communication.FrrMad/GetInterfaces"
+	FrrMad_GetAnomalies_FullMethodName              = "/communication.FrrMad/GetAnomalies"
+	FrrMad_GetAreaAnomalies_FullMethodName          = "/communication.FrrMad/GetAreaAnomalies"
+	FrrMad_GetAnomalyLifecycle_FullMethodName       = "/communication.FrrMad/GetAnomalyLifecycle"
+	FrrMad_AcknowledgeAnomaly_FullMethodName        = "/communication.FrrMad/AcknowledgeAnomaly"
+	FrrMad_GetCheckResults_FullMethodName           = "/communication.FrrMad/GetCheckResults"
+	FrrMad_GetLintResult_FullMethodName             = "/communication.FrrMad/GetLintResult"
+	FrrMad_WatchAnomalies_FullMethodName            = "/communication.FrrMad/WatchAnomalies"
+	FrrMad_WatchAnomalyLifecycle_FullMethodName     = "/communication.FrrMad/WatchAnomalyLifecycle"
+	FrrMad_WatchOspfNeighbors_FullMethodName        = "/communication.FrrMad/WatchOspfNeighbors"
+	FrrMad_WatchInterfaces_FullMethodName           = "/communication.FrrMad/WatchInterfaces"
+	FrrMad_WatchOspfDatabase_FullMethodName         = "/communication.FrrMad/WatchOspfDatabase"
+	FrrMad_WatchRib_FullMethodName                  = "/communication.FrrMad/WatchRib"
+)
+
+// FrrMadClient is the client API for FrrMad service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FrrMad is the typed API of the daemon. It serves the same data as the length-prefixed
+// socket protocol, which stays available for existing clients.
+type FrrMadClient interface {
+	// System
+	GetHello(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Hello, error)
+	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error)
+	GetSystemMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SystemMetrics, error)
+	// FRR
+	GetRouterData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FRRRouterData, error)
+	GetRib(ctx context.Context, in *Query, opts ...grpc.CallOption) (*RoutingInformationBase, error)
+	GetRibFibSummary(ctx context.Context, in *Query, opts ...grpc.CallOption) (*RibFibSummaryRoutes, error)
+	GetStaticConfiguration(ctx context.Context, in *Query, opts ...grpc.CallOption) (*StaticFRRConfiguration, error)
+	// OSPF
+	GetOspfDatabase(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFDatabase, error)
+	GetGeneralOspfInformation(ctx context.Context, in *Query, opts ...grpc.CallOption) (*GeneralOspfInformation, error)
+	GetOspfRouterData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFRouterData, error)
+	GetOspfNetworkData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNetworkData, error)
+	GetOspfSummaryData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFSummaryData, error)
+	GetOspfAsbrSummaryData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFAsbrSummaryData, error)
+	GetOspfExternalData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFExternalData, error)
+	GetOspfNssaExternalData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNssaExternalData, error)
+	GetOspfNeighbors(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNeighbors, error)
+	GetInterfaces(ctx context.Context, in *Query, opts ...grpc.CallOption) (*InterfaceList, error)
+	// Analysis
+	GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*AnomalyDetection, error)
+	GetAreaAnomalies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AreaAnomaliesList, error)
+	GetAnomalyLifecycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AnomalyLifecycleList, error)
+	AcknowledgeAnomaly(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*Acknowledgement, error)
+	GetCheckResults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckResultList, error)
+	GetLintResult(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LintResult, error)
+	// Watch streams the current state and afterwards every change
+	WatchAnomalies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AreaAnomaliesList], error)
+	WatchAnomalyLifecycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnomalyLifecycleList], error)
+	WatchOspfNeighbors(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OSPFNeighbors], error)
+	WatchInterfaces(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceList], error)
+	WatchOspfDatabase(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OSPFDatabase], error)
+	WatchRib(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoutingInformationBase], error)
+}
+
+type frrMadClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFrrMadClient(cc grpc.ClientConnInterface) FrrMadClient {
+	return &frrMadClient{cc}
+}
+
+func (c *frrMadClient) GetHello(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Hello, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hello)
+	err := c.cc.Invoke(ctx, FrrMad_GetHello_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthStatus)
+	err := c.cc.Invoke(ctx, FrrMad_GetStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetSystemMetrics(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SystemMetrics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemMetrics)
+	err := c.cc.Invoke(ctx, FrrMad_GetSystemMetrics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetRouterData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FRRRouterData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FRRRouterData)
+	err := c.cc.Invoke(ctx, FrrMad_GetRouterData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetRib(ctx context.Context, in *Query, opts ...grpc.CallOption) (*RoutingInformationBase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RoutingInformationBase)
+	err := c.cc.Invoke(ctx, FrrMad_GetRib_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetRibFibSummary(ctx context.Context, in *Query, opts ...grpc.CallOption) (*RibFibSummaryRoutes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RibFibSummaryRoutes)
+	err := c.cc.Invoke(ctx, FrrMad_GetRibFibSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetStaticConfiguration(ctx context.Context, in *Query, opts ...grpc.CallOption) (*StaticFRRConfiguration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StaticFRRConfiguration)
+	err := c.cc.Invoke(ctx, FrrMad_GetStaticConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfDatabase(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFDatabase, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFDatabase)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetGeneralOspfInformation(ctx context.Context, in *Query, opts ...grpc.CallOption) (*GeneralOspfInformation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralOspfInformation)
+	err := c.cc.Invoke(ctx, FrrMad_GetGeneralOspfInformation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfRouterData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFRouterData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFRouterData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfRouterData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfNetworkData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNetworkData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFNetworkData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfNetworkData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfSummaryData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFSummaryData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFSummaryData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfSummaryData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfAsbrSummaryData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFAsbrSummaryData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFAsbrSummaryData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfAsbrSummaryData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfExternalData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFExternalData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFExternalData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfExternalData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfNssaExternalData(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNssaExternalData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFNssaExternalData)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfNssaExternalData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetOspfNeighbors(ctx context.Context, in *Query, opts ...grpc.CallOption) (*OSPFNeighbors, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OSPFNeighbors)
+	err := c.cc.Invoke(ctx, FrrMad_GetOspfNeighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetInterfaces(ctx context.Context, in *Query, opts ...grpc.CallOption) (*InterfaceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InterfaceList)
+	err := c.cc.Invoke(ctx, FrrMad_GetInterfaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetAnomalies(ctx context.Context, in *AnomaliesRequest, opts ...grpc.CallOption) (*AnomalyDetection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyDetection)
+	err := c.cc.Invoke(ctx, FrrMad_GetAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetAreaAnomalies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AreaAnomaliesList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AreaAnomaliesList)
+	err := c.cc.Invoke(ctx, FrrMad_GetAreaAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetAnomalyLifecycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AnomalyLifecycleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyLifecycleList)
+	err := c.cc.Invoke(ctx, FrrMad_GetAnomalyLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) AcknowledgeAnomaly(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*Acknowledgement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Acknowledgement)
+	err := c.cc.Invoke(ctx, FrrMad_AcknowledgeAnomaly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetCheckResults(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CheckResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckResultList)
+	err := c.cc.Invoke(ctx, FrrMad_GetCheckResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) GetLintResult(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LintResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LintResult)
+	err := c.cc.Invoke(ctx, FrrMad_GetLintResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *frrMadClient) WatchAnomalies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AreaAnomaliesList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[0], FrrMad_WatchAnomalies_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, AreaAnomaliesList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchAnomaliesClient = grpc.ServerStreamingClient[AreaAnomaliesList]
+
+func (c *frrMadClient) WatchAnomalyLifecycle(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AnomalyLifecycleList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[1], FrrMad_WatchAnomalyLifecycle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, AnomalyLifecycleList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchAnomalyLifecycleClient = grpc.ServerStreamingClient[AnomalyLifecycleList]
+
+func (c *frrMadClient) WatchOspfNeighbors(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OSPFNeighbors], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[2], FrrMad_WatchOspfNeighbors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Query, OSPFNeighbors]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchOspfNeighborsClient = grpc.ServerStreamingClient[OSPFNeighbors]
+
+func (c *frrMadClient) WatchInterfaces(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceList], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[3], FrrMad_WatchInterfaces_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Query, InterfaceList]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchInterfacesClient = grpc.ServerStreamingClient[InterfaceList]
+
+func (c *frrMadClient) WatchOspfDatabase(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OSPFDatabase], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[4], FrrMad_WatchOspfDatabase_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Query, OSPFDatabase]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchOspfDatabaseClient = grpc.ServerStreamingClient[OSPFDatabase]
+
+func (c *frrMadClient) WatchRib(ctx context.Context, in *Query, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RoutingInformationBase], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FrrMad_ServiceDesc.Streams[5], FrrMad_WatchRib_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Query, RoutingInformationBase]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchRibClient = grpc.ServerStreamingClient[RoutingInformationBase]
+
+// FrrMadServer is the server API for FrrMad service.
+// All implementations must embed UnimplementedFrrMadServer
+// for forward compatibility.
+//
+// FrrMad is the typed API of the daemon. It serves the same data as the length-prefixed
+// socket protocol, which stays available for existing clients.
+type FrrMadServer interface {
+	// System
+	GetHello(context.Context, *emptypb.Empty) (*Hello, error)
+	GetStatus(context.Context, *emptypb.Empty) (*HealthStatus, error)
+	GetSystemMetrics(context.Context, *emptypb.Empty) (*SystemMetrics, error)
+	// FRR
+	GetRouterData(context.Context, *emptypb.Empty) (*FRRRouterData, error)
+	GetRib(context.Context, *Query) (*RoutingInformationBase, error)
+	GetRibFibSummary(context.Context, *Query) (*RibFibSummaryRoutes, error)
+	GetStaticConfiguration(context.Context, *Query) (*StaticFRRConfiguration, error)
+	// OSPF
+	GetOspfDatabase(context.Context, *Query) (*OSPFDatabase, error)
+	GetGeneralOspfInformation(context.Context, *Query) (*GeneralOspfInformation, error)
+	GetOspfRouterData(context.Context, *Query) (*OSPFRouterData, error)
+	GetOspfNetworkData(context.Context, *Query) (*OSPFNetworkData, error)
+	GetOspfSummaryData(context.Context, *Query) (*OSPFSummaryData, error)
+	GetOspfAsbrSummaryData(context.Context, *Query) (*OSPFAsbrSummaryData, error)
+	GetOspfExternalData(context.Context, *Query) (*OSPFExternalData, error)
+	GetOspfNssaExternalData(context.Context, *Query) (*OSPFNssaExternalData, error)
+	GetOspfNeighbors(context.Context, *Query) (*OSPFNeighbors, error)
+	GetInterfaces(context.Context, *Query) (*InterfaceList, error)
+	// Analysis
+	GetAnomalies(context.Context, *AnomaliesRequest) (*AnomalyDetection, error)
+	GetAreaAnomalies(context.Context, *emptypb.Empty) (*AreaAnomaliesList, error)
+	GetAnomalyLifecycle(context.Context, *emptypb.Empty) (*AnomalyLifecycleList, error)
+	AcknowledgeAnomaly(context.Context, *AcknowledgeRequest) (*Acknowledgement, error)
+	GetCheckResults(context.Context, *emptypb.Empty) (*CheckResultList, error)
+	GetLintResult(context.Context, *emptypb.Empty) (*LintResult, error)
+	// Watch streams the current state and afterwards every change
+	WatchAnomalies(*emptypb.Empty, grpc.ServerStreamingServer[AreaAnomaliesList]) error
+	WatchAnomalyLifecycle(*emptypb.Empty, grpc.ServerStreamingServer[AnomalyLifecycleList]) error
+	WatchOspfNeighbors(*Query, grpc.ServerStreamingServer[OSPFNeighbors]) error
+	WatchInterfaces(*Query, grpc.ServerStreamingServer[InterfaceList]) error
+	WatchOspfDatabase(*Query, grpc.ServerStreamingServer[OSPFDatabase]) error
+	WatchRib(*Query, grpc.ServerStreamingServer[RoutingInformationBase]) error
+	mustEmbedUnimplementedFrrMadServer()
+}
+
+// UnimplementedFrrMadServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFrrMadServer struct{}
+
+func (UnimplementedFrrMadServer) GetHello(context.Context, *emptypb.Empty) (*Hello, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHello not implemented")
+}
+func (UnimplementedFrrMadServer) GetStatus(context.Context, *emptypb.Empty) (*HealthStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedFrrMadServer) GetSystemMetrics(context.Context, *emptypb.Empty) (*SystemMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemMetrics not implemented")
+}
+func (UnimplementedFrrMadServer) GetRouterData(context.Context, *emptypb.Empty) (*FRRRouterData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRouterData not implemented")
+}
+func (UnimplementedFrrMadServer) GetRib(context.Context, *Query) (*RoutingInformationBase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRib not implemented")
+}
+func (UnimplementedFrrMadServer) GetRibFibSummary(context.Context, *Query) (*RibFibSummaryRoutes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRibFibSummary not implemented")
+}
+func (UnimplementedFrrMadServer) GetStaticConfiguration(context.Context, *Query) (*StaticFRRConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaticConfiguration not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfDatabase(context.Context, *Query) (*OSPFDatabase, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfDatabase not implemented")
+}
+func (UnimplementedFrrMadServer) GetGeneralOspfInformation(context.Context, *Query) (*GeneralOspfInformation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeneralOspfInformation not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfRouterData(context.Context, *Query) (*OSPFRouterData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfRouterData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfNetworkData(context.Context, *Query) (*OSPFNetworkData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfNetworkData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfSummaryData(context.Context, *Query) (*OSPFSummaryData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfSummaryData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfAsbrSummaryData(context.Context, *Query) (*OSPFAsbrSummaryData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfAsbrSummaryData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfExternalData(context.Context, *Query) (*OSPFExternalData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfExternalData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfNssaExternalData(context.Context, *Query) (*OSPFNssaExternalData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfNssaExternalData not implemented")
+}
+func (UnimplementedFrrMadServer) GetOspfNeighbors(context.Context, *Query) (*OSPFNeighbors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOspfNeighbors not implemented")
+}
+func (UnimplementedFrrMadServer) GetInterfaces(context.Context, *Query) (*InterfaceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterfaces not implemented")
+}
+func (UnimplementedFrrMadServer) GetAnomalies(context.Context, *AnomaliesRequest) (*AnomalyDetection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalies not implemented")
+}
+func (UnimplementedFrrMadServer) GetAreaAnomalies(context.Context, *emptypb.Empty) (*AreaAnomaliesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAreaAnomalies not implemented")
+}
+func (UnimplementedFrrMadServer) GetAnomalyLifecycle(context.Context, *emptypb.Empty) (*AnomalyLifecycleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalyLifecycle not implemented")
+}
+func (UnimplementedFrrMadServer) AcknowledgeAnomaly(context.Context, *AcknowledgeRequest) (*Acknowledgement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAnomaly not implemented")
+}
+func (UnimplementedFrrMadServer) GetCheckResults(context.Context, *emptypb.Empty) (*CheckResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckResults not implemented")
+}
+func (UnimplementedFrrMadServer) GetLintResult(context.Context, *emptypb.Empty) (*LintResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLintResult not implemented")
+}
+func (UnimplementedFrrMadServer) WatchAnomalies(*emptypb.Empty, grpc.ServerStreamingServer[AreaAnomaliesList]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAnomalies not implemented")
+}
+func (UnimplementedFrrMadServer) WatchAnomalyLifecycle(*emptypb.Empty, grpc.ServerStreamingServer[AnomalyLifecycleList]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAnomalyLifecycle not implemented")
+}
+func (UnimplementedFrrMadServer) WatchOspfNeighbors(*Query, grpc.ServerStreamingServer[OSPFNeighbors]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOspfNeighbors not implemented")
+}
+func (UnimplementedFrrMadServer) WatchInterfaces(*Query, grpc.ServerStreamingServer[InterfaceList]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInterfaces not implemented")
+}
+func (UnimplementedFrrMadServer) WatchOspfDatabase(*Query, grpc.ServerStreamingServer[OSPFDatabase]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOspfDatabase not implemented")
+}
+func (UnimplementedFrrMadServer) WatchRib(*Query, grpc.ServerStreamingServer[RoutingInformationBase]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRib not implemented")
+}
+func (UnimplementedFrrMadServer) mustEmbedUnimplementedFrrMadServer() {}
+func (UnimplementedFrrMadServer) testEmbeddedByValue()                {}
+
+// UnsafeFrrMadServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FrrMadServer will
+// result in compilation errors.
+type UnsafeFrrMadServer interface {
+	mustEmbedUnimplementedFrrMadServer()
+}
+
+func RegisterFrrMadServer(s grpc.ServiceRegistrar, srv FrrMadServer) {
+	// If the following call pancis, it indicates UnimplementedFrrMadServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FrrMad_ServiceDesc, srv)
+}
+
+func _FrrMad_GetHello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetHello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetHello_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetHello(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetSystemMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetSystemMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetSystemMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetSystemMetrics(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetRouterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetRouterData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetRouterData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetRouterData(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetRib_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetRib(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetRib_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetRib(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetRibFibSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetRibFibSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetRibFibSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetRibFibSummary(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetStaticConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetStaticConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetStaticConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetStaticConfiguration(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfDatabase(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetGeneralOspfInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetGeneralOspfInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetGeneralOspfInformation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetGeneralOspfInformation(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfRouterData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfRouterData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfRouterData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfRouterData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfNetworkData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfNetworkData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfNetworkData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfNetworkData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfSummaryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfSummaryData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfSummaryData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfSummaryData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfAsbrSummaryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfAsbrSummaryData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfAsbrSummaryData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfAsbrSummaryData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfExternalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfExternalData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfExternalData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfExternalData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfNssaExternalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfNssaExternalData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfNssaExternalData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfNssaExternalData(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetOspfNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetOspfNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetOspfNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetOspfNeighbors(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetInterfaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetInterfaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetInterfaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetInterfaces(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetAnomalies(ctx, req.(*AnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetAreaAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetAreaAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetAreaAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetAreaAnomalies(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetAnomalyLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetAnomalyLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetAnomalyLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetAnomalyLifecycle(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_AcknowledgeAnomaly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).AcknowledgeAnomaly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_AcknowledgeAnomaly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).AcknowledgeAnomaly(ctx, req.(*AcknowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetCheckResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetCheckResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetCheckResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetCheckResults(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_GetLintResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FrrMadServer).GetLintResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FrrMad_GetLintResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FrrMadServer).GetLintResult(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FrrMad_WatchAnomalies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchAnomalies(m, &grpc.GenericServerStream[emptypb.Empty, AreaAnomaliesList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchAnomaliesServer = grpc.ServerStreamingServer[AreaAnomaliesList]
+
+func _FrrMad_WatchAnomalyLifecycle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchAnomalyLifecycle(m, &grpc.GenericServerStream[emptypb.Empty, AnomalyLifecycleList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchAnomalyLifecycleServer = grpc.ServerStreamingServer[AnomalyLifecycleList]
+
+func _FrrMad_WatchOspfNeighbors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchOspfNeighbors(m, &grpc.GenericServerStream[Query, OSPFNeighbors]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchOspfNeighborsServer = grpc.ServerStreamingServer[OSPFNeighbors]
+
+func _FrrMad_WatchInterfaces_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchInterfaces(m, &grpc.GenericServerStream[Query, InterfaceList]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchInterfacesServer = grpc.ServerStreamingServer[InterfaceList]
+
+func _FrrMad_WatchOspfDatabase_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchOspfDatabase(m, &grpc.GenericServerStream[Query, OSPFDatabase]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchOspfDatabaseServer = grpc.ServerStreamingServer[OSPFDatabase]
+
+func _FrrMad_WatchRib_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FrrMadServer).WatchRib(m, &grpc.GenericServerStream[Query, RoutingInformationBase]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FrrMad_WatchRibServer = grpc.ServerStreamingServer[RoutingInformationBase]
+
+// FrrMad_ServiceDesc is the grpc.ServiceDesc for FrrMad service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FrrMad_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "communication.FrrMad",
+	HandlerType: (*FrrMadServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHello",
+			Handler:    _FrrMad_GetHello_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _FrrMad_GetStatus_Handler,
+		},
+		{
+			MethodName: "GetSystemMetrics",
+			Handler:    _FrrMad_GetSystemMetrics_Handler,
+		},
+		{
+			MethodName: "GetRouterData",
+			Handler:    _FrrMad_GetRouterData_Handler,
+		},
+		{
+			MethodName: "GetRib",
+			Handler:    _FrrMad_GetRib_Handler,
+		},
+		{
+			MethodName: "GetRibFibSummary",
+			Handler:    _FrrMad_GetRibFibSummary_Handler,
+		},
+		{
+			MethodName: "GetStaticConfiguration",
+			Handler:    _FrrMad_GetStaticConfiguration_Handler,
+		},
+		{
+			MethodName: "GetOspfDatabase",
+			Handler:    _FrrMad_GetOspfDatabase_Handler,
+		},
+		{
+			MethodName: "GetGeneralOspfInformation",
+			Handler:    _FrrMad_GetGeneralOspfInformation_Handler,
+		},
+		{
+			MethodName: "GetOspfRouterData",
+			Handler:    _FrrMad_GetOspfRouterData_Handler,
+		},
+		{
+			MethodName: "GetOspfNetworkData",
+			Handler:    _FrrMad_GetOspfNetworkData_Handler,
+		},
+		{
+			MethodName: "GetOspfSummaryData",
+			Handler:    _FrrMad_GetOspfSummaryData_Handler,
+		},
+		{
+			MethodName: "GetOspfAsbrSummaryData",
+			Handler:    _FrrMad_GetOspfAsbrSummaryData_Handler,
+		},
+		{
+			MethodName: "GetOspfExternalData",
+			Handler:    _FrrMad_GetOspfExternalData_Handler,
+		},
+		{
+			MethodName: "GetOspfNssaExternalData",
+			Handler:    _FrrMad_GetOspfNssaExternalData_Handler,
+		},
+		{
+			MethodName: "GetOspfNeighbors",
+			Handler:    _FrrMad_GetOspfNeighbors_Handler,
+		},
+		{
+			MethodName: "GetInterfaces",
+			Handler:    _FrrMad_GetInterfaces_Handler,
+		},
+		{
+			MethodName: "GetAnomalies",
+			Handler:    _FrrMad_GetAnomalies_Handler,
+		},
+		{
+			MethodName: "GetAreaAnomalies",
+			Handler:    _FrrMad_GetAreaAnomalies_Handler,
+		},
+		{
+			MethodName: "GetAnomalyLifecycle",
+			Handler:    _FrrMad_GetAnomalyLifecycle_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAnomaly",
+			Handler:    _FrrMad_AcknowledgeAnomaly_Handler,
+		},
+		{
+			MethodName: "GetCheckResults",
+			Handler:    _FrrMad_GetCheckResults_Handler,
+		},
+		{
+			MethodName: "GetLintResult",
+			Handler:    _FrrMad_GetLintResult_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAnomalies",
+			Handler:       _FrrMad_WatchAnomalies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchAnomalyLifecycle",
+			Handler:       _FrrMad_WatchAnomalyLifecycle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOspfNeighbors",
+			Handler:       _FrrMad_WatchOspfNeighbors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchInterfaces",
+			Handler:       _FrrMad_WatchInterfaces_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchOspfDatabase",
+			Handler:       _FrrMad_WatchOspfDatabase_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRib",
+			Handler:       _FrrMad_WatchRib_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protocol.proto",
+}
@@ -0,0 +1,32 @@
+package aggregator_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCapturedData(t *testing.T) {
+	fullFrrData, missing, err := aggregator.LoadCapturedData("./mock-files/r101.conf", "./mock-files/capture")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "r101", fullFrrData.StaticFrrConfiguration.Hostname)
+	assert.Equal(t, "r101", fullFrrData.FrrRouterData.RouterName)
+	assert.Equal(t, "65.0.1.1", fullFrrData.GeneralOspfInformation.RouterId)
+	assert.Equal(t, int32(4), fullFrrData.GeneralOspfInformation.MaximumPaths)
+	assert.Len(t, fullFrrData.OspfNeighbors.Neighbors["65.0.1.2"].Neighbors, 1)
+	assert.Len(t, fullFrrData.RoutingInformationBase.Routes["10.0.12.0/24"].Routes, 2)
+
+	assert.Contains(t, missing, "ospf_router_self.json")
+	assert.NotContains(t, missing, "rib.json")
+	assert.Len(t, missing, len(aggregator.CaptureFiles)-3)
+}
+
+func TestLoadCapturedDataErrors(t *testing.T) {
+	_, _, err := aggregator.LoadCapturedData("./mock-files/missing.conf", "./mock-files/capture")
+	assert.Error(t, err)
+
+	_, _, err = aggregator.LoadCapturedData("./mock-files/r101.conf", "./mock-files/missing")
+	assert.Error(t, err)
+}
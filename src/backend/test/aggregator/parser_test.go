@@ -686,3 +686,207 @@ func TestParseConfigEdgeCases(t *testing.T) {
 		t.Fatal("Expected non-nil config for malformed file")
 	}
 }
+
+func TestParseAreaRangeConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "abr.conf")
+
+	configContent := `router ospf
+ ospf router-id 65.0.1.1
+ area 0.0.0.1 range 10.1.0.0/16
+ area 0.0.0.1 range 10.2.0.0/16 not-advertise
+ area 0.0.0.1 range 10.3.1.0/22 cost 50
+ area 0.0.0.2 stub no-summary
+ area 0.0.0.3 nssa translate-always
+exit
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write area range config file: %v", err)
+	}
+
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		t.Fatalf("ParseStaticFRRConfig failed for area range config: %v", err)
+	}
+
+	ranges := config.OspfConfig.AreaRange
+	if len(ranges) != 3 {
+		t.Fatalf("Expected 3 area ranges, got %d", len(ranges))
+	}
+	if ranges[0].Area != "0.0.0.1" || ranges[0].IpPrefix.IpAddress != "10.1.0.0" || ranges[0].IpPrefix.PrefixLength != 16 {
+		t.Errorf("Unexpected first area range: %v", ranges[0])
+	}
+	if ranges[0].NotAdvertise || ranges[0].HasCost {
+		t.Errorf("Expected plain advertise range without cost, got %v", ranges[0])
+	}
+	if !ranges[1].NotAdvertise {
+		t.Errorf("Expected second area range to be not-advertise")
+	}
+	if !ranges[2].HasCost || ranges[2].Cost != 50 {
+		t.Errorf("Expected third area range to have cost 50, got %v", ranges[2])
+	}
+	if ranges[2].IpPrefix.IpAddress != "10.3.0.0" {
+		t.Errorf("Expected range prefix to be normalized to 10.3.0.0, got %s", ranges[2].IpPrefix.IpAddress)
+	}
+
+	// range lines must not show up as areas
+	if len(config.OspfConfig.Area) != 2 {
+		t.Fatalf("Expected 2 areas, got %d", len(config.OspfConfig.Area))
+	}
+	if config.OspfConfig.Area[0].Type != "stub" || !config.OspfConfig.Area[0].NoSummary {
+		t.Errorf("Expected area 0.0.0.2 to be a stub no-summary area, got %v", config.OspfConfig.Area[0])
+	}
+	if config.OspfConfig.Area[1].Type != "nssa" || config.OspfConfig.Area[1].NoSummary {
+		t.Errorf("Expected area 0.0.0.3 to be a nssa area with summaries, got %v", config.OspfConfig.Area[1])
+	}
+	if config.OspfConfig.Area[1].NssaTranslatorRole != "translate-always" {
+		t.Errorf("Expected area 0.0.0.3 translator role to be 'translate-always', got '%s'", config.OspfConfig.Area[1].NssaTranslatorRole)
+	}
+}
+
+func TestParseRedistributeMetricConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "redistribute.conf")
+
+	configContent := `route-map lanroutes permit 10
+ match ip address localsite
+ set metric 150
+ set metric-type type-1
+ set tag 42
+exit
+router ospf
+ ospf router-id 65.0.1.1
+ redistribute connected metric 30 metric-type 1
+ redistribute static route-map lanroutes
+ default-metric 40
+exit
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write redistribute config file: %v", err)
+	}
+
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		t.Fatalf("ParseStaticFRRConfig failed for redistribute config: %v", err)
+	}
+
+	redistribution := config.OspfConfig.Redistribution
+	if len(redistribution) != 2 {
+		t.Fatalf("Expected 2 redistributions, got %d", len(redistribution))
+	}
+	if redistribution[0].Type != "connected" || redistribution[0].Metric != "1" {
+		t.Errorf("Expected connected redistribution with metric-type 1, got %v", redistribution[0])
+	}
+	if !redistribution[0].HasMetricValue || redistribution[0].MetricValue != 30 {
+		t.Errorf("Expected connected redistribution metric 30, got %v", redistribution[0])
+	}
+	if redistribution[1].HasMetricValue || redistribution[1].RouteMap != "lanroutes" {
+		t.Errorf("Expected static redistribution with route-map and without metric, got %v", redistribution[1])
+	}
+	if !config.OspfConfig.HasDefaultMetric || config.OspfConfig.DefaultMetric != 40 {
+		t.Errorf("Expected default-metric 40, got %v", config.OspfConfig.DefaultMetric)
+	}
+
+	routeMap, exists := config.RouteMap["lanroutes"]
+	if !exists {
+		t.Fatalf("Expected route-map 'lanroutes' to exist")
+	}
+	if routeMap.AccessList != "localsite" {
+		t.Errorf("Expected route-map to match access-list 'localsite', got '%s'", routeMap.AccessList)
+	}
+	if !routeMap.HasSetMetric || routeMap.SetMetric != 150 {
+		t.Errorf("Expected route-map set metric 150, got %v", routeMap.SetMetric)
+	}
+	if routeMap.SetMetricType != "type-1" {
+		t.Errorf("Expected route-map set metric-type 'type-1', got '%s'", routeMap.SetMetricType)
+	}
+	if !routeMap.HasSetTag || routeMap.SetTag != 42 {
+		t.Errorf("Expected route-map set tag 42, got %v", routeMap.SetTag)
+	}
+}
+
+func TestParseDefaultInformationConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "default.conf")
+
+	configContent := `router ospf
+ ospf router-id 65.0.1.1
+ default-information originate always metric 100 metric-type 1 route-map defaultonly
+ area 0.0.0.3 nssa default-information-originate
+exit
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write default-information config file: %v", err)
+	}
+
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		t.Fatalf("ParseStaticFRRConfig failed for default-information config: %v", err)
+	}
+
+	defaultInformation := config.OspfConfig.DefaultInformation
+	if defaultInformation == nil || !defaultInformation.Originate || !defaultInformation.Always {
+		t.Fatalf("Expected default-information originate always, got %v", defaultInformation)
+	}
+	if !defaultInformation.HasMetric || defaultInformation.Metric != 100 {
+		t.Errorf("Expected default-information metric 100, got %v", defaultInformation.Metric)
+	}
+	if defaultInformation.MetricType != "1" {
+		t.Errorf("Expected default-information metric-type '1', got '%s'", defaultInformation.MetricType)
+	}
+	if defaultInformation.RouteMap != "defaultonly" {
+		t.Errorf("Expected default-information route-map 'defaultonly', got '%s'", defaultInformation.RouteMap)
+	}
+
+	if len(config.OspfConfig.Area) != 1 || !config.OspfConfig.Area[0].NssaDefaultOriginate {
+		t.Errorf("Expected area 0.0.0.3 to originate a NSSA default route, got %v", config.OspfConfig.Area)
+	}
+}
+
+func TestParsePrefixListConfig(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "prefix-list.conf")
+
+	configContent := `ip prefix-list lan seq 5 permit 10.0.0.0/8 ge 16 le 24
+ip prefix-list lan seq 10 deny any
+!
+route-map lan-only permit 10
+ match ip address prefix-list lan
+exit
+`
+	if err := os.WriteFile(configPath, []byte(configContent), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		t.Fatalf("ParseStaticFRRConfig failed: %v", err)
+	}
+
+	prefixList, ok := config.PrefixList["lan"]
+	if !ok {
+		t.Fatal("Expected prefix-list lan")
+	}
+	if len(prefixList.PrefixListItems) != 2 {
+		t.Fatalf("Expected 2 prefix-list items, got %d", len(prefixList.PrefixListItems))
+	}
+
+	first := prefixList.PrefixListItems[0]
+	if first.Sequence != 5 || first.AccessControl != "permit" || first.Ge != 16 || first.Le != 24 {
+		t.Errorf("Unexpected first prefix-list item: %v", first)
+	}
+	if first.GetIpPrefix().GetIpAddress() != "10.0.0.0" || first.GetIpPrefix().GetPrefixLength() != 8 {
+		t.Errorf("Expected prefix 10.0.0.0/8, got %v", first.GetIpPrefix())
+	}
+	if second := prefixList.PrefixListItems[1]; !second.Any || second.AccessControl != "deny" {
+		t.Errorf("Expected the second item to deny any, got %v", second)
+	}
+
+	routeMap, ok := config.RouteMap["lan-only"]
+	if !ok {
+		t.Fatal("Expected route-map lan-only")
+	}
+	if routeMap.Match != "ip address prefix-list" || routeMap.PrefixList != "lan" || routeMap.AccessList != "" {
+		t.Errorf("Expected the route-map to match prefix-list lan, got %v", routeMap)
+	}
+}
@@ -0,0 +1,81 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// multiAreaCheck reports a fixed set of anomalies in several areas
+type multiAreaCheck struct{}
+
+func (c *multiAreaCheck) Name() string        { return "MultiArea" }
+func (c *multiAreaCheck) Description() string { return "Reports anomalies in several areas" }
+func (c *multiAreaCheck) Inputs() []string    { return nil }
+
+func (c *multiAreaCheck) Run(ctx *analyzer.CheckContext) (*frrProto.AnomalyDetection, error) {
+	return &frrProto.AnomalyDetection{
+		MissingEntries: []*frrProto.Advertisement{
+			{LinkStateId: "10.10.0.0", PrefixLength: "24", OspfArea: "0.0.0.10"},
+			{LinkStateId: "10.2.0.0", PrefixLength: "24", OspfArea: "0.0.0.2"},
+		},
+		SuperfluousEntries: []*frrProto.Advertisement{
+			{LinkStateId: "10.2.1.0", PrefixLength: "24", OspfArea: "0.0.0.2"},
+		},
+	}, nil
+}
+
+func getAreaAnomaliesByName(ana *analyzer.Analyzer) map[string]*frrProto.AreaAnomalies {
+	result := map[string]*frrProto.AreaAnomalies{}
+	for _, area := range ana.AnalysisResult.AreaAnomalies {
+		result[area.Area] = area
+	}
+	return result
+}
+
+func TestAnomalyArea(t *testing.T) {
+	assert.Equal(t, "0.0.0.1", analyzer.AnomalyArea(&frrProto.Advertisement{OspfArea: "0.0.0.1"}))
+	assert.Equal(t, analyzer.NoArea, analyzer.AnomalyArea(&frrProto.Advertisement{LinkStateId: "192.168.1.0"}))
+}
+
+func TestAreaAnomalies(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+	assert.NoError(t, ana.Checks.Register(&multiAreaCheck{}))
+	ana.Suppressions = []configs.SuppressionRule{{Prefix: "10.2.1.0/24"}}
+
+	ana.AnomalyAnalysis()
+
+	var order []string
+	for _, area := range ana.AnalysisResult.AreaAnomalies {
+		order = append(order, area.Area)
+	}
+	assert.Equal(t, []string{"0.0.0.0", "0.0.0.2", "0.0.0.10", analyzer.NoArea}, order, "areas are ordered numerically, none last")
+
+	areas := getAreaAnomaliesByName(ana)
+
+	// router links of the config are missing from the empty LSDB in the backbone
+	backbone := areas["0.0.0.0"]
+	if assert.Contains(t, backbone.Sources, "RouterAnomaly") {
+		assert.True(t, backbone.Sources["RouterAnomaly"].HasUnAdvertisedPrefixes)
+		assert.Equal(t, uint32(len(backbone.Sources["RouterAnomaly"].MissingEntries)), backbone.Total)
+	}
+
+	area2 := areas["0.0.0.2"]
+	assert.Equal(t, uint32(2), area2.Total)
+	assert.Equal(t, uint32(1), area2.Actionable, "the suppressed anomaly is not actionable")
+	assert.Equal(t, uint32(1), area2.Critical)
+	if assert.Contains(t, area2.Sources, "MultiArea") {
+		assert.Len(t, area2.Sources["MultiArea"].MissingEntries, 1)
+		assert.Len(t, area2.Sources["MultiArea"].SuperfluousEntries, 1)
+		assert.True(t, area2.Sources["MultiArea"].HasOverAdvertisedPrefixes)
+	}
+
+	assert.Equal(t, uint32(1), areas["0.0.0.10"].Total)
+
+	// the static route without area is an AS external anomaly
+	assert.Contains(t, areas[analyzer.NoArea].Sources, "ExternalAnomaly")
+}
@@ -0,0 +1,168 @@
+package analyzer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// loopbackCheck reports every loopback prefix of the configuration as missing
+type loopbackCheck struct {
+	err    error
+	panic  bool
+	params map[string]string
+}
+
+func (c *loopbackCheck) Name() string        { return "Loopback" }
+func (c *loopbackCheck) Description() string { return "Reports loopback prefixes" }
+func (c *loopbackCheck) Inputs() []string    { return []string{"StaticFrrConfiguration"} }
+
+func (c *loopbackCheck) Run(ctx *analyzer.CheckContext) (*frrProto.AnomalyDetection, error) {
+	c.params = ctx.Params
+	if c.panic {
+		panic("loopback check crashed")
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	result := &frrProto.AnomalyDetection{}
+	for _, iface := range ctx.Metrics.GetStaticFrrConfiguration().GetInterfaces() {
+		if iface.Name != "lo" {
+			continue
+		}
+		for _, prefix := range iface.InterfaceIpPrefixes {
+			result.MissingEntries = append(result.MissingEntries, &frrProto.Advertisement{
+				LinkStateId:  prefix.IpPrefix.IpAddress,
+				PrefixLength: "32",
+				Reason:       "loopback",
+			})
+		}
+	}
+	return result, nil
+}
+
+func getCheckResultsByName(ana *analyzer.Analyzer) map[string]*frrProto.CheckResult {
+	result := map[string]*frrProto.CheckResult{}
+	for _, check := range ana.AnalysisResult.CheckResults {
+		result[check.Name] = check
+	}
+	return result
+}
+
+func TestBuiltinChecks(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	assert.Equal(t, []string{
+		"RouterAnomaly", "ExternalAnomaly", "NssaExternalAnomaly", "SummaryAnomaly",
+		"AsbrSummaryAnomaly", "LsdbToRib", "Ecmp", "IntentAnomaly",
+	}, ana.Checks.Names())
+	assert.Len(t, ana.AnalysisResult.CheckResults, 8, "the checks are published before the first cycle")
+
+	ana.AnomalyAnalysis()
+
+	assert.Len(t, ana.AnalysisResult.CheckResults, 8)
+	for _, check := range ana.AnalysisResult.CheckResults {
+		assert.True(t, check.Enabled, check.Name)
+		assert.True(t, check.Builtin, check.Name)
+		assert.Empty(t, check.Error, check.Name)
+		assert.NotEmpty(t, check.Inputs, check.Name)
+	}
+
+	// the compatibility field of a built-in check aliases its findings
+	assert.Same(t, ana.AnalysisResult.RouterAnomaly, getCheckResultsByName(ana)["RouterAnomaly"].Findings)
+}
+
+func TestRegisterCheck(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	check := &loopbackCheck{}
+	assert.NoError(t, ana.Checks.Register(check))
+	assert.Error(t, ana.Checks.Register(&loopbackCheck{}), "duplicate names are rejected")
+	assert.NoError(t, ana.Checks.Configure("loopback", true, map[string]string{"mode": "strict"}))
+
+	ana.AnomalyAnalysis()
+
+	result := getCheckResultsByName(ana)["Loopback"]
+	if assert.NotNil(t, result) {
+		assert.False(t, result.Builtin)
+		assert.Equal(t, "Reports loopback prefixes", result.Description)
+		assert.True(t, result.Findings.HasUnAdvertisedPrefixes, "flags are set from the entries")
+		assert.Len(t, result.Findings.MissingEntries, 1)
+		assert.Equal(t, analyzer.SeverityCritical, result.Findings.MissingEntries[0].Severity)
+	}
+	assert.Equal(t, map[string]string{"mode": "strict"}, check.params)
+
+	// findings of a registered check take part in the lifecycle
+	lifecycle := getLifecycleByID(ana)
+	assert.Contains(t, lifecycle, "Loopback:unadvertised:65.0.1.1/32:")
+	assert.True(t, ana.HasActionableAnomalies())
+}
+
+func TestConfigureCheck(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+	assert.NoError(t, ana.Checks.Register(&loopbackCheck{}))
+
+	assert.Error(t, ana.Checks.Configure("unknown", false, nil))
+
+	ana.AnalysisResult.EcmpAnomaly.HasMisconfiguredPrefixes = true
+	ana.AnalysisResult.EcmpAnomaly.MisconfiguredEntries = []*frrProto.Advertisement{{LinkStateId: "10.0.0.0"}}
+	assert.NoError(t, ana.Checks.Configure("ecmp", false, nil))
+	assert.NoError(t, ana.Checks.Configure("Loopback", false, nil))
+
+	ana.AnomalyAnalysis()
+
+	results := getCheckResultsByName(ana)
+	assert.False(t, results["Ecmp"].Enabled)
+	assert.Zero(t, results["Ecmp"].DurationMicroseconds)
+	assert.False(t, ana.AnalysisResult.EcmpAnomaly.HasMisconfiguredPrefixes, "a disabled check clears earlier findings")
+	assert.Empty(t, ana.AnalysisResult.EcmpAnomaly.MisconfiguredEntries)
+	assert.False(t, results["Loopback"].Enabled)
+	assert.NotContains(t, getLifecycleByID(ana), "Loopback:unadvertised:65.0.1.1/32:")
+}
+
+func TestFailingCheck(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+	assert.NoError(t, ana.Checks.Register(&loopbackCheck{err: errors.New("no loopback")}))
+
+	ana.AnomalyAnalysis()
+
+	result := getCheckResultsByName(ana)["Loopback"]
+	assert.Equal(t, "no loopback", result.Error)
+	assert.Empty(t, result.Findings.MissingEntries)
+	assert.Empty(t, getCheckResultsByName(ana)["RouterAnomaly"].Error, "a failing check does not affect the others")
+}
+
+func TestPanickingCheck(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+	assert.NoError(t, ana.Checks.Register(&loopbackCheck{panic: true}))
+
+	assert.NotPanics(t, ana.AnomalyAnalysis)
+
+	result := getCheckResultsByName(ana)["Loopback"]
+	assert.Equal(t, "check panicked: loopback check crashed", result.Error)
+	assert.Empty(t, result.Findings.MissingEntries)
+	assert.Len(t, ana.AnalysisResult.CheckResults, 9, "the cycle completes after a panicking check")
+}
+
+func TestSkippedCheckClearsFindings(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	// findings of a cycle in which the router was still attached to an NSSA
+	ana.AnalysisResult.NssaExternalAnomaly.HasUnAdvertisedPrefixes = true
+	ana.AnalysisResult.NssaExternalAnomaly.MissingEntries = []*frrProto.Advertisement{{LinkStateId: "192.168.10.0"}}
+
+	ana.AnomalyAnalysis()
+
+	assert.False(t, ana.AnalysisResult.NssaExternalAnomaly.HasUnAdvertisedPrefixes)
+	assert.Empty(t, ana.AnalysisResult.NssaExternalAnomaly.MissingEntries)
+}
@@ -0,0 +1,175 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func getDefaultRouteFRRdata(defaultInformation *frrProto.DefaultInformation) *frrProto.FullFRRData {
+	metrics := getExternalAttributeFRRdata()
+	metrics.StaticFrrConfiguration.OspfConfig.DefaultInformation = defaultInformation
+	metrics.OspfExternalAll = &frrProto.OSPFExternalAll{
+		RouterId: "65.0.1.1",
+	}
+	return metrics
+}
+
+func addDefaultRouteLsa(metrics *frrProto.FullFRRData, advertisingRouter string, metricType string, metric int32) {
+	lsa := &frrProto.ExternalLSA{
+		LinkStateId: "0.0.0.0", AdvertisingRouter: advertisingRouter, NetworkMask: 0,
+		MetricType: metricType, Metric: metric, ForwardAddress: "0.0.0.0",
+	}
+	if advertisingRouter == metrics.StaticFrrConfiguration.OspfConfig.RouterId {
+		metrics.OspfExternalData.AsExternalLinkStates["0.0.0.0"] = lsa
+	}
+	metrics.OspfExternalAll.AsExternalLinkStates = append(metrics.OspfExternalAll.AsExternalLinkStates, &frrProto.ASExternalLinkState{
+		LinkStateId: "0.0.0.0", AdvertisingRouter: advertisingRouter, NetworkMask: 0,
+		MetricType: metricType, Metric: metric, ForwardAddress: "0.0.0.0",
+	})
+}
+
+func addRibDefaultRoute(metrics *frrProto.FullFRRData, protocol string) {
+	metrics.RoutingInformationBase.Routes["0.0.0.0/0"] = &frrProto.RouteEntry{Routes: []*frrProto.Route{
+		{Prefix: "0.0.0.0/0", PrefixLen: 0, Protocol: protocol, Selected: true},
+	}}
+}
+
+func runDefaultRouteAnalysis(metrics *frrProto.FullFRRData) *frrProto.AnomalyDetection {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	result := &frrProto.AnomalyDetection{}
+	ana.DefaultRouteAnalysis(result)
+
+	return result
+}
+
+func TestDefaultRouteAlways(t *testing.T) {
+	metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{Originate: true, Always: true})
+	addDefaultRouteLsa(metrics, "65.0.1.1", "E2", 1)
+
+	result := runDefaultRouteAnalysis(metrics)
+	assert.Empty(t, result.MissingEntries)
+	assert.Empty(t, result.SuperfluousEntries)
+	assert.Empty(t, result.MisconfiguredEntries)
+	assert.Empty(t, result.DuplicateEntries)
+
+	// the default route must not show up as attribute mismatch of redistribute static
+	_, appLogger, anomalyLogger := getMockData()
+	assert.Empty(t, analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger).ExternalAttributeAnalysis())
+
+	t.Run("OtherOriginator", func(t *testing.T) {
+		addDefaultRouteLsa(metrics, "65.0.1.2", "E2", 1)
+		result := runDefaultRouteAnalysis(metrics)
+		assert.Equal(t, map[string][]string{
+			"0.0.0.0/0": {"default route also originated by 65.0.1.2"},
+		}, getReasonsByPrefix(result.DuplicateEntries))
+	})
+
+	t.Run("NotOriginated", func(t *testing.T) {
+		metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{Originate: true, Always: true})
+		result := runDefaultRouteAnalysis(metrics)
+		assert.Equal(t, map[string][]string{
+			"0.0.0.0/0": {"default-information originate always configured, but no default route is originated"},
+		}, getReasonsByPrefix(result.MissingEntries))
+	})
+}
+
+func TestDefaultRouteFromRib(t *testing.T) {
+	t.Run("NoRibDefault", func(t *testing.T) {
+		metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{Originate: true})
+		assert.Empty(t, runDefaultRouteAnalysis(metrics).MissingEntries)
+
+		addDefaultRouteLsa(metrics, "65.0.1.1", "E2", 10)
+		assert.Equal(t, map[string][]string{
+			"0.0.0.0/0": {"default route originated, but no default route exists in the RIB"},
+		}, getReasonsByPrefix(runDefaultRouteAnalysis(metrics).SuperfluousEntries))
+	})
+
+	t.Run("OspfLearnedDefaultIgnored", func(t *testing.T) {
+		metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{Originate: true})
+		addRibDefaultRoute(metrics, "ospf")
+		assert.Empty(t, runDefaultRouteAnalysis(metrics).MissingEntries)
+	})
+
+	t.Run("StaticDefault", func(t *testing.T) {
+		metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{Originate: true})
+		addRibDefaultRoute(metrics, "static")
+		assert.Equal(t, map[string][]string{
+			"0.0.0.0/0": {"default-information originate configured, but no default route is originated"},
+		}, getReasonsByPrefix(runDefaultRouteAnalysis(metrics).MissingEntries))
+
+		addDefaultRouteLsa(metrics, "65.0.1.1", "E2", 10)
+		result := runDefaultRouteAnalysis(metrics)
+		assert.Empty(t, result.MissingEntries)
+		assert.Empty(t, result.MisconfiguredEntries)
+	})
+}
+
+func TestDefaultRouteWithoutOriginate(t *testing.T) {
+	metrics := getDefaultRouteFRRdata(nil)
+	addDefaultRouteLsa(metrics, "65.0.1.1", "E2", 10)
+
+	assert.Equal(t, map[string][]string{
+		"0.0.0.0/0": {"default route originated without default-information originate"},
+	}, getReasonsByPrefix(runDefaultRouteAnalysis(metrics).SuperfluousEntries))
+}
+
+func TestDefaultRouteAttributes(t *testing.T) {
+	metrics := getDefaultRouteFRRdata(&frrProto.DefaultInformation{
+		Originate:  true,
+		Always:     true,
+		HasMetric:  true,
+		Metric:     100,
+		MetricType: "1",
+	})
+	addDefaultRouteLsa(metrics, "65.0.1.1", "E2", 1)
+
+	assert.Equal(t, map[string][]string{
+		"0.0.0.0/0": {"default-information originate always: metric-type E2, expected E1, metric 1, expected 100"},
+	}, getReasonsByPrefix(runDefaultRouteAnalysis(metrics).MisconfiguredEntries))
+}
+
+func TestNssaDefaultRoute(t *testing.T) {
+	metrics := getDefaultRouteFRRdata(nil)
+	metrics.StaticFrrConfiguration.OspfConfig.Area = []*frrProto.Area{
+		{Name: "0.0.0.3", Type: "nssa", NssaDefaultOriginate: true},
+	}
+	metrics.OspfNssaExternalData = &frrProto.OSPFNssaExternalData{
+		RouterId:               "65.0.1.1",
+		NssaExternalLinkStates: map[string]*frrProto.NssaExternalArea{},
+	}
+
+	runNssaDefaultRouteAnalysis := func() *frrProto.AnomalyDetection {
+		_, appLogger, anomalyLogger := getMockData()
+		ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+		result := &frrProto.AnomalyDetection{}
+		ana.NssaDefaultRouteAnalysis(result)
+		return result
+	}
+
+	// internal NSSA router without a default route in the RIB
+	assert.Empty(t, runNssaDefaultRouteAnalysis().MissingEntries)
+
+	addRibDefaultRoute(metrics, "kernel")
+	result := runNssaDefaultRouteAnalysis()
+	assert.Equal(t, map[string][]string{
+		"0.0.0.0/0": {"nssa default-information-originate configured, but no Type-7 default route is originated"},
+	}, getReasonsByPrefix(result.MissingEntries))
+	assert.Equal(t, "0.0.0.3", result.MissingEntries[0].OspfArea)
+
+	metrics.OspfNssaExternalData.NssaExternalLinkStates["0.0.0.3"] = &frrProto.NssaExternalArea{
+		Data: map[string]*frrProto.NssaExternalLSA{
+			"0.0.0.0": {LinkStateId: "0.0.0.0", AdvertisingRouter: "65.0.1.1", NetworkMask: 0},
+		},
+	}
+	assert.Empty(t, runNssaDefaultRouteAnalysis().MissingEntries)
+
+	metrics.StaticFrrConfiguration.OspfConfig.Area[0].NssaDefaultOriginate = false
+	assert.Equal(t, map[string][]string{
+		"0.0.0.0/0": {"Type-7 default route originated without nssa default-information-originate"},
+	}, getReasonsByPrefix(runNssaDefaultRouteAnalysis().SuperfluousEntries))
+}
@@ -0,0 +1,194 @@
+package analyzer_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func p2pLink(neighborRouterId, interfaceAddress string, metric int32) *frrProto.OSPFRouterLSALink {
+	return &frrProto.OSPFRouterLSALink{
+		LinkType:               "another Router (point-to-point)",
+		NeighborRouterId:       neighborRouterId,
+		RouterInterfaceAddress: interfaceAddress,
+		Tos0Metric:             metric,
+	}
+}
+
+func stubLink(networkAddress, networkMask string, metric int32) *frrProto.OSPFRouterLSALink {
+	return &frrProto.OSPFRouterLSALink{
+		LinkType:       "Stub Network",
+		NetworkAddress: networkAddress,
+		NetworkMask:    networkMask,
+		Tos0Metric:     metric,
+	}
+}
+
+func transitLink(designatedRouterAddress, interfaceAddress string, metric int32) *frrProto.OSPFRouterLSALink {
+	return &frrProto.OSPFRouterLSALink{
+		LinkType:                "a Transit Network",
+		DesignatedRouterAddress: designatedRouterAddress,
+		RouterInterfaceAddress:  interfaceAddress,
+		Tos0Metric:              metric,
+	}
+}
+
+func ospfRoute(prefix string, prefixLen, metric int32, nexthops ...*frrProto.Nexthop) *frrProto.RouteEntry {
+	return &frrProto.RouteEntry{Routes: []*frrProto.Route{
+		{Prefix: prefix, PrefixLen: prefixLen, Protocol: "ospf", Selected: true, Metric: metric, Nexthops: nexthops},
+	}}
+}
+
+// getEcmpFRRdata returns r1 (1.1.1.1) reaching 10.4.0.0/24 on r4 over r2 and r3 with equal
+// cost 21, and 10.5.0.0/24 on r5 over a shared transit network with cost 11
+func getEcmpFRRdata() *frrProto.FullFRRData {
+	return &frrProto.FullFRRData{
+		StaticFrrConfiguration: &frrProto.StaticFRRConfiguration{
+			Hostname:   "r1",
+			OspfConfig: &frrProto.OSPFConfig{RouterId: "1.1.1.1"},
+		},
+		GeneralOspfInformation: &frrProto.GeneralOspfInformation{
+			RouterId:     "1.1.1.1",
+			MaximumPaths: 64,
+		},
+		OspfRouterDataAll: &frrProto.OSPFRouterData{
+			RouterId: "1.1.1.1",
+			RouterStates: map[string]*frrProto.OSPFRouterArea{
+				"0.0.0.0": {LsaEntries: map[string]*frrProto.OSPFRouterLSA{
+					"1.1.1.1": {AdvertisingRouter: "1.1.1.1", RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+						"link0": p2pLink("2.2.2.2", "10.0.12.1", 10),
+						"link1": stubLink("10.0.12.0", "255.255.255.0", 10),
+						"link2": p2pLink("3.3.3.3", "10.0.13.1", 10),
+						"link3": stubLink("10.0.13.0", "255.255.255.0", 10),
+						"link4": transitLink("10.0.50.1", "10.0.50.1", 10),
+					}},
+					"2.2.2.2": {AdvertisingRouter: "2.2.2.2", RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+						"link0": p2pLink("1.1.1.1", "10.0.12.2", 10),
+						"link1": p2pLink("4.4.4.4", "10.0.24.2", 10),
+					}},
+					"3.3.3.3": {AdvertisingRouter: "3.3.3.3", RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+						"link0": p2pLink("1.1.1.1", "10.0.13.3", 10),
+						"link1": p2pLink("4.4.4.4", "10.0.34.3", 10),
+					}},
+					"4.4.4.4": {AdvertisingRouter: "4.4.4.4", RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+						"link0": p2pLink("2.2.2.2", "10.0.24.4", 10),
+						"link1": p2pLink("3.3.3.3", "10.0.34.4", 10),
+						"link2": stubLink("10.4.0.0", "255.255.255.0", 1),
+					}},
+					"5.5.5.5": {AdvertisingRouter: "5.5.5.5", RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+						"link0": transitLink("10.0.50.1", "10.0.50.5", 10),
+						"link1": stubLink("10.5.0.0", "255.255.255.0", 1),
+					}},
+				}},
+			},
+		},
+		OspfNetworkDataAll: &frrProto.OSPFNetworkData{
+			RouterId: "1.1.1.1",
+			NetStates: map[string]*frrProto.NetAreaState{
+				"0.0.0.0": {LsaEntries: map[string]*frrProto.NetworkLSA{
+					"10.0.50.1": {
+						LinkStateId:       "10.0.50.1",
+						AdvertisingRouter: "1.1.1.1",
+						NetworkMask:       24,
+						AttachedRouters: map[string]*frrProto.AttachedRouter{
+							"1.1.1.1": {AttachedRouterId: "1.1.1.1"},
+							"5.5.5.5": {AttachedRouterId: "5.5.5.5"},
+						},
+					},
+				}},
+			},
+		},
+		RoutingInformationBase: &frrProto.RoutingInformationBase{
+			Routes: map[string]*frrProto.RouteEntry{
+				"10.4.0.0/24": ospfRoute("10.4.0.0", 24, 21,
+					&frrProto.Nexthop{Ip: "10.0.12.2", InterfaceName: "eth1", Active: true, Fib: true},
+					&frrProto.Nexthop{Ip: "10.0.13.3", InterfaceName: "eth2", Active: true, Fib: true},
+				),
+				"10.5.0.0/24": ospfRoute("10.5.0.0", 24, 11,
+					&frrProto.Nexthop{Ip: "10.0.50.5", InterfaceName: "eth3", Active: true, Fib: true},
+				),
+			},
+		},
+	}
+}
+
+func runEcmpAnalysis(metrics *frrProto.FullFRRData) *frrProto.AnomalyDetection {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+	ana.EcmpAnalysis()
+
+	return ana.AnalysisResult.EcmpAnomaly
+}
+
+func TestEcmpEqualCostPaths(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	paths := analyzer.InitAnalyzer(getEcmpFRRdata(), appLogger, anomalyLogger).GetLsdbEqualCostPaths()
+
+	getNextHops := func(prefix string) []string {
+		var hops []string
+		for hop := range paths[prefix].NextHops {
+			hops = append(hops, hop)
+		}
+		sort.Strings(hops)
+		return hops
+	}
+
+	assert.Len(t, paths, 2, "directly connected prefixes must not be part of the cost view")
+
+	if assert.Contains(t, paths, "10.4.0.0/24") {
+		assert.Equal(t, int32(21), paths["10.4.0.0/24"].Cost)
+		assert.Equal(t, []string{"2.2.2.2@10.0.12.1", "3.3.3.3@10.0.13.1"}, getNextHops("10.4.0.0/24"))
+	}
+	if assert.Contains(t, paths, "10.5.0.0/24") {
+		assert.Equal(t, int32(11), paths["10.5.0.0/24"].Cost)
+		assert.Equal(t, []string{"5.5.5.5@10.0.50.1"}, getNextHops("10.5.0.0/24"))
+	}
+}
+
+func TestEcmpAnalysisHappy(t *testing.T) {
+	result := runEcmpAnalysis(getEcmpFRRdata())
+
+	assert.False(t, result.HasUnAdvertisedPrefixes)
+	assert.False(t, result.HasMisconfiguredPrefixes)
+	assert.Empty(t, result.MissingEntries)
+	assert.Empty(t, result.MisconfiguredEntries)
+}
+
+func TestEcmpAnalysisSingleNextHop(t *testing.T) {
+	metrics := getEcmpFRRdata()
+	metrics.RoutingInformationBase.Routes["10.4.0.0/24"] = ospfRoute("10.4.0.0", 24, 21,
+		&frrProto.Nexthop{Ip: "10.0.12.2", InterfaceName: "eth1", Active: true, Fib: true},
+	)
+
+	result := runEcmpAnalysis(metrics)
+	assert.True(t, result.HasUnAdvertisedPrefixes)
+	assert.Equal(t, map[string][]string{
+		"10.4.0.0/24": {"2 equal-cost paths with cost 21 (maximum-paths 64), but 1 next-hop(s) installed"},
+	}, getReasonsByPrefix(result.MissingEntries))
+
+	t.Run("CappedByMaximumPaths", func(t *testing.T) {
+		metrics.GeneralOspfInformation.MaximumPaths = 1
+		assert.Empty(t, runEcmpAnalysis(metrics).MissingEntries)
+	})
+}
+
+func TestEcmpAnalysisInactiveNextHops(t *testing.T) {
+	metrics := getEcmpFRRdata()
+	metrics.RoutingInformationBase.Routes["10.4.0.0/24"].Routes[0].Nexthops[1].Active = false
+	metrics.RoutingInformationBase.Routes["10.5.0.0/24"].Routes[0].Nexthops[0].Fib = false
+
+	result := runEcmpAnalysis(metrics)
+	assert.True(t, result.HasMisconfiguredPrefixes)
+	assert.Equal(t, map[string][]string{
+		"10.4.0.0/24": {"next-hop 10.0.13.3 via eth2 is inactive"},
+		"10.5.0.0/24": {"next-hop 10.0.50.5 via eth3 is not installed in the FIB"},
+	}, getReasonsByPrefix(result.MisconfiguredEntries))
+
+	// the inactive next-hop also leaves the ECMP route with a single path
+	assert.Equal(t, map[string][]string{
+		"10.4.0.0/24": {"2 equal-cost paths with cost 21 (maximum-paths 64), but 1 next-hop(s) installed"},
+	}, getReasonsByPrefix(result.MissingEntries))
+}
@@ -0,0 +1,142 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func getExternalAttributeFRRdata() *frrProto.FullFRRData {
+	return &frrProto.FullFRRData{
+		StaticFrrConfiguration: &frrProto.StaticFRRConfiguration{
+			Hostname: "r101",
+			Interfaces: []*frrProto.Interface{
+				{
+					Name: "eth1",
+					Area: "0.0.0.0",
+					InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+						{IpPrefix: &frrProto.IPPrefix{IpAddress: "10.0.12.1", PrefixLength: 24}, Ospf: true},
+					},
+				},
+				{
+					Name: "eth2",
+					InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+						{IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.1.1", PrefixLength: 24}},
+					},
+				},
+			},
+			StaticRoutes: []*frrProto.StaticRoute{
+				{IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.11.0", PrefixLength: 24}, NextHop: "192.168.1.254"},
+				{IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.12.0", PrefixLength: 24}, NextHop: "10.0.12.2"},
+				{IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.13.0", PrefixLength: 24}, NextHop: "192.168.1.254"},
+			},
+			OspfConfig: &frrProto.OSPFConfig{
+				RouterId: "65.0.1.1",
+				Redistribution: []*frrProto.Redistribution{
+					{Type: "static"},
+					{Type: "connected", Metric: "1", HasMetricValue: true, MetricValue: 30},
+				},
+			},
+		},
+		RoutingInformationBase: &frrProto.RoutingInformationBase{
+			Routes: map[string]*frrProto.RouteEntry{
+				"192.168.1.0/24": {Routes: []*frrProto.Route{
+					{Prefix: "192.168.1.0/24", PrefixLen: 24, Protocol: "connected", Selected: true},
+				}},
+			},
+		},
+		OspfExternalData: &frrProto.OSPFExternalData{
+			RouterId: "65.0.1.1",
+			AsExternalLinkStates: map[string]*frrProto.ExternalLSA{
+				"192.168.1.0": {
+					LinkStateId: "192.168.1.0", AdvertisingRouter: "65.0.1.1", NetworkMask: 24,
+					MetricType: "E1", Metric: 30, ForwardAddress: "0.0.0.0",
+				},
+				"192.168.11.0": {
+					LinkStateId: "192.168.11.0", AdvertisingRouter: "65.0.1.1", NetworkMask: 24,
+					MetricType: "E2", Metric: 20, ForwardAddress: "0.0.0.0",
+				},
+				"192.168.12.0": {
+					LinkStateId: "192.168.12.0", AdvertisingRouter: "65.0.1.1", NetworkMask: 24,
+					MetricType: "E2", Metric: 20, ForwardAddress: "10.0.12.2",
+				},
+				"192.168.13.0": {
+					LinkStateId: "192.168.13.0", AdvertisingRouter: "65.0.1.1", NetworkMask: 24,
+					MetricType: "E2", Metric: 20, ForwardAddress: "0.0.0.0",
+				},
+			},
+		},
+	}
+}
+
+func runExternalAttributeAnalysis(metrics *frrProto.FullFRRData) []*frrProto.Advertisement {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	return ana.ExternalAttributeAnalysis()
+}
+
+func TestExternalAttributeAnalysisHappy(t *testing.T) {
+	assert.Empty(t, runExternalAttributeAnalysis(getExternalAttributeFRRdata()))
+}
+
+func TestExternalAttributeAnalysisUnhappy(t *testing.T) {
+	metrics := getExternalAttributeFRRdata()
+	lsdb := metrics.OspfExternalData.AsExternalLinkStates
+	lsdb["192.168.1.0"].MetricType = "E2"
+	lsdb["192.168.11.0"].Metric = 100
+	lsdb["192.168.12.0"].ForwardAddress = "0.0.0.0"
+	lsdb["192.168.13.0"].ExternalRouteTag = 7
+
+	assert.Equal(t, map[string][]string{
+		"192.168.1.0/24":  {"redistribute connected: metric-type E2, expected E1"},
+		"192.168.11.0/24": {"redistribute static: metric 100, expected 20"},
+		"192.168.12.0/24": {"redistribute static: forwarding address 0.0.0.0, expected 10.0.12.2"},
+		"192.168.13.0/24": {"redistribute static: tag 7, expected 0"},
+	}, getReasonsByPrefix(runExternalAttributeAnalysis(metrics)))
+}
+
+func TestExternalAttributeAnalysisRouteMap(t *testing.T) {
+	metrics := getExternalAttributeFRRdata()
+	config := metrics.StaticFrrConfiguration
+	config.OspfConfig.HasDefaultMetric = true
+	config.OspfConfig.DefaultMetric = 50
+	config.OspfConfig.Redistribution[0].RouteMap = "lanroutes"
+	config.RouteMap = map[string]*frrProto.RouteMap{
+		"lanroutes": {
+			Permit:        true,
+			Sequence:      "10",
+			Match:         "ip address",
+			AccessList:    "localsite",
+			SetMetricType: "type-1",
+			HasSetTag:     true,
+			SetTag:        42,
+		},
+	}
+	config.AccessList = map[string]*frrProto.AccessList{
+		"localsite": {AccessListItems: []*frrProto.AccessListItem{
+			{
+				Sequence:      10,
+				AccessControl: "permit",
+				Destination: &frrProto.AccessListItem_IpPrefix{
+					IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.11.0", PrefixLength: 24},
+				},
+			},
+		}},
+	}
+
+	lsdb := metrics.OspfExternalData.AsExternalLinkStates
+	lsdb["192.168.11.0"].MetricType = "E1"
+	lsdb["192.168.11.0"].Metric = 50
+	lsdb["192.168.11.0"].ExternalRouteTag = 42
+
+	// 192.168.12.0 and 192.168.13.0 are denied by the route-map and therefore not checked
+	assert.Empty(t, runExternalAttributeAnalysis(metrics))
+
+	lsdb["192.168.11.0"].MetricType = "E2"
+	assert.Equal(t, map[string][]string{
+		"192.168.11.0/24": {"redistribute static: metric-type E2, expected E1"},
+	}, getReasonsByPrefix(runExternalAttributeAnalysis(metrics)))
+}
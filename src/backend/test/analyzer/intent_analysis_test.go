@@ -0,0 +1,129 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func getIntentFRRdata() *frrProto.FullFRRData {
+	return &frrProto.FullFRRData{
+		StaticFrrConfiguration: &frrProto.StaticFRRConfiguration{
+			Hostname: "r101",
+			Interfaces: []*frrProto.Interface{
+				{Name: "eth1", Area: "0.0.0.0"},
+				{Name: "eth2", Area: "0.0.0.1"},
+			},
+		},
+		GeneralOspfInformation: &frrProto.GeneralOspfInformation{
+			LsaExternalCounter: 150,
+			Areas: map[string]*frrProto.GeneralInfoOspfArea{
+				"0.0.0.1": {LsaRouterNumber: 5},
+			},
+		},
+		RoutingInformationBase: &frrProto.RoutingInformationBase{
+			Routes: map[string]*frrProto.RouteEntry{
+				"10.20.0.0/16": {Routes: []*frrProto.Route{
+					{Prefix: "10.20.0.0/16", PrefixLen: 16, Protocol: "ospf", Selected: true},
+				}},
+				"10.21.0.0/16": {Routes: []*frrProto.Route{
+					{Prefix: "10.21.0.0/16", PrefixLen: 16, Protocol: "ospf"},
+					{Prefix: "10.21.0.0/16", PrefixLen: 16, Protocol: "static", Selected: true},
+				}},
+			},
+		},
+		OspfSummaryDataAll: &frrProto.OSPFSummaryData{
+			SummaryStates: map[string]*frrProto.SummaryAreaState{
+				"0.0.0.1": {LsaEntries: map[string]*frrProto.SummaryLSA{
+					"10.20.0.0": {LinkStateId: "10.20.0.0", NetworkMask: 16, AdvertisingRouter: "65.0.2.2"},
+				}},
+			},
+		},
+		OspfExternalAll: &frrProto.OSPFExternalAll{
+			AsExternalLinkStates: []*frrProto.ASExternalLinkState{
+				{LinkStateId: "192.168.0.0", NetworkMask: 16, AdvertisingRouter: "65.0.3.3"},
+			},
+		},
+		OspfNeighbors: &frrProto.OSPFNeighbors{
+			Neighbors: map[string]*frrProto.NeighborList{
+				"65.0.1.2": {Neighbors: []*frrProto.Neighbor{{State: "Full/DR", IfaceName: "eth1:10.0.12.1"}}},
+				"65.0.1.3": {Neighbors: []*frrProto.Neighbor{{State: "Init/DROther", IfaceName: "eth2:10.0.13.1"}}},
+			},
+		},
+	}
+}
+
+func getIntent() *configs.Intent {
+	return &configs.Intent{
+		Prefixes: configs.IntentPrefixes{
+			MustHave: []configs.IntentPrefix{
+				{Prefix: "10.20.0.0/16", Area: "0.0.0.1"},
+				{Prefix: "10.21.0.0/16"},
+				{Prefix: "10.22.0.0/16", Area: "0.0.0.1"},
+			},
+			MustNotHave: []configs.IntentPrefix{
+				{Prefix: "192.168.0.0/16", LsaType: "external"},
+				{Prefix: "172.16.0.0/12"},
+			},
+		},
+		Neighbors: []configs.IntentNeighbor{
+			{RouterId: "65.0.1.2", Interface: "eth1"},
+			{RouterId: "65.0.1.3"},
+			{RouterId: "65.0.1.4"},
+		},
+		Areas: []configs.IntentArea{
+			{Interface: "eth1", Area: "0.0.0.0"},
+			{Interface: "eth2", Area: "0.0.0.0"},
+		},
+		LsaLimits: []configs.IntentLsaLimit{
+			{LsaType: "external", Max: 100},
+			{LsaType: "router", Area: "0.0.0.1", Max: 20},
+		},
+	}
+}
+
+func TestIntentAnalysisWithoutIntent(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getIntentFRRdata(), appLogger, anomalyLogger)
+
+	ana.IntentAnalysis()
+
+	assert.False(t, hasAnyAnomaly(ana.AnalysisResult.IntentAnomaly))
+}
+
+func TestIntentAnalysis(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getIntentFRRdata(), appLogger, anomalyLogger)
+	ana.Intent = getIntent()
+
+	ana.IntentAnalysis()
+	result := ana.AnalysisResult.IntentAnomaly
+
+	missing := getReasonsByPrefix(result.MissingEntries)
+	assert.Len(t, result.MissingEntries, 4)
+	assert.Equal(t, []string{"intent: 10.21.0.0/16 must be reachable via ospf, but is served by static"}, missing["10.21.0.0/16"])
+	assert.Equal(t, []string{"intent: 10.22.0.0/16 must be in the LSDB of area 0.0.0.1, but no LSA is found"}, missing["10.22.0.0/16"])
+	assert.Equal(t, []string{"intent: neighbor 65.0.1.3 expected in state Full, but is Init/DROther on eth2"}, missing["65.0.1.3/"])
+	assert.Equal(t, []string{"intent: neighbor 65.0.1.4 expected, but no adjacency exists"}, missing["65.0.1.4/"])
+
+	superfluous := getReasonsByPrefix(result.SuperfluousEntries)
+	assert.Len(t, result.SuperfluousEntries, 2)
+	assert.Equal(t, []string{"intent: 192.168.0.0/16 must never be in the LSDB as external LSA, but is advertised by 65.0.3.3"}, superfluous["192.168.0.0/16"])
+	assert.Equal(t, []string{"intent: at most 100 external LSAs, but 150 are in the LSDB"}, superfluous["external/"])
+
+	misconfigured := getReasonsByPrefix(result.MisconfiguredEntries)
+	assert.Len(t, result.MisconfiguredEntries, 1)
+	assert.Equal(t, []string{"intent: eth2 must be in area 0.0.0.0, but is in area 0.0.0.1"}, misconfigured["eth2/"])
+
+	assert.True(t, result.HasUnAdvertisedPrefixes)
+	assert.True(t, result.HasOverAdvertisedPrefixes)
+	assert.True(t, result.HasMisconfiguredPrefixes)
+	assert.False(t, result.HasDuplicatePrefixes)
+}
+
+func hasAnyAnomaly(a *frrProto.AnomalyDetection) bool {
+	return a.HasOverAdvertisedPrefixes || a.HasUnAdvertisedPrefixes || a.HasDuplicatePrefixes || a.HasMisconfiguredPrefixes
+}
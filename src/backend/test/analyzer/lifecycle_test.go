@@ -0,0 +1,132 @@
+package analyzer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func setRouterMissingEntries(ana *analyzer.Analyzer, entries ...*frrProto.Advertisement) {
+	ana.AnalysisResult.RouterAnomaly.HasUnAdvertisedPrefixes = len(entries) > 0
+	ana.AnalysisResult.RouterAnomaly.MissingEntries = entries
+}
+
+func getLifecycleByID(ana *analyzer.Analyzer) map[string]*frrProto.AnomalyLifecycle {
+	result := map[string]*frrProto.AnomalyLifecycle{}
+	for _, lifecycle := range ana.AnalysisResult.Lifecycle {
+		result[lifecycle.Id] = lifecycle
+	}
+	return result
+}
+
+func TestAnomalyID(t *testing.T) {
+	entry := &frrProto.Advertisement{
+		InterfaceAddress: "10.0.12.0",
+		PrefixLength:     "24",
+		OspfArea:         "0.0.0.0",
+	}
+	assert.Equal(t, "RouterAnomaly:unadvertised:10.0.12.0/24:0.0.0.0", analyzer.AnomalyID("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, entry))
+
+	entry = &frrProto.Advertisement{LinkStateId: "65.0.1.1"}
+	assert.Equal(t, "AsbrSummaryAnomaly:overadvertised:65.0.1.1:", analyzer.AnomalyID("AsbrSummaryAnomaly", analyzer.AnomalyTypeOverAdvertised, entry))
+}
+
+func TestAnomalyLifecycleFlap(t *testing.T) {
+	metrics, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	entry := &frrProto.Advertisement{InterfaceAddress: "10.0.12.0", PrefixLength: "24", OspfArea: "0.0.0.0"}
+	id := analyzer.AnomalyID("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, entry)
+	start := time.Unix(1700000000, 0)
+
+	setRouterMissingEntries(ana, entry)
+	ana.UpdateLifecycle(start)
+	ana.UpdateLifecycle(start.Add(5 * time.Second))
+
+	lifecycle := getLifecycleByID(ana)[id]
+	if assert.NotNil(t, lifecycle) {
+		assert.True(t, lifecycle.Active)
+		assert.True(t, lifecycle.Confirmed)
+		assert.Equal(t, start.Unix(), lifecycle.FirstSeen)
+		assert.Equal(t, start.Unix()+5, lifecycle.LastSeen)
+		assert.Equal(t, int64(5), lifecycle.DurationSeconds)
+		assert.Equal(t, uint32(2), lifecycle.ConsecutiveCycles)
+		assert.Equal(t, uint32(0), lifecycle.FlapCount)
+	}
+
+	// resolved
+	setRouterMissingEntries(ana)
+	ana.UpdateLifecycle(start.Add(10 * time.Second))
+
+	lifecycle = getLifecycleByID(ana)[id]
+	if assert.NotNil(t, lifecycle) {
+		assert.False(t, lifecycle.Active)
+		assert.Equal(t, start.Unix()+10, lifecycle.ResolvedAt)
+		assert.Equal(t, int64(10), lifecycle.DurationSeconds)
+		assert.Equal(t, uint32(0), lifecycle.ConsecutiveCycles)
+	}
+
+	// reappears
+	setRouterMissingEntries(ana, entry)
+	ana.UpdateLifecycle(start.Add(15 * time.Second))
+
+	lifecycle = getLifecycleByID(ana)[id]
+	if assert.NotNil(t, lifecycle) {
+		assert.True(t, lifecycle.Active)
+		assert.Equal(t, int64(0), lifecycle.ResolvedAt)
+		assert.Equal(t, start.Unix(), lifecycle.FirstSeen)
+		assert.Equal(t, start.Unix()+15, lifecycle.ActiveSince)
+		assert.Equal(t, uint32(1), lifecycle.FlapCount)
+		assert.Equal(t, uint32(1), lifecycle.ConsecutiveCycles)
+	}
+
+	// resolved entries are dropped after the retention period
+	setRouterMissingEntries(ana)
+	ana.UpdateLifecycle(start.Add(20 * time.Second))
+	ana.UpdateLifecycle(start.Add(25*time.Hour + 20*time.Second))
+	assert.Empty(t, ana.AnalysisResult.Lifecycle)
+}
+
+func TestAnomalyLifecycleHoldDown(t *testing.T) {
+	metrics, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+	ana.HoldDownCycles = 3
+
+	entry := &frrProto.Advertisement{InterfaceAddress: "10.0.12.0", PrefixLength: "24", OspfArea: "0.0.0.0"}
+	id := analyzer.AnomalyID("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, entry)
+	start := time.Unix(1700000000, 0)
+
+	for i := 0; i < 2; i++ {
+		setRouterMissingEntries(ana, entry)
+		ana.UpdateLifecycle(start.Add(time.Duration(i*5) * time.Second))
+
+		assert.False(t, ana.AnalysisResult.RouterAnomaly.HasUnAdvertisedPrefixes)
+		assert.Empty(t, ana.AnalysisResult.RouterAnomaly.MissingEntries)
+		assert.False(t, getLifecycleByID(ana)[id].Confirmed)
+	}
+
+	setRouterMissingEntries(ana, entry)
+	ana.UpdateLifecycle(start.Add(10 * time.Second))
+
+	assert.True(t, ana.AnalysisResult.RouterAnomaly.HasUnAdvertisedPrefixes)
+	assert.Len(t, ana.AnalysisResult.RouterAnomaly.MissingEntries, 1)
+	assert.True(t, getLifecycleByID(ana)[id].Confirmed)
+
+	// a transient anomaly which resolves within the hold-down is never reported
+	blip := &frrProto.Advertisement{InterfaceAddress: "10.0.13.0", PrefixLength: "24", OspfArea: "0.0.0.0"}
+	setRouterMissingEntries(ana, entry, blip)
+	ana.UpdateLifecycle(start.Add(15 * time.Second))
+	assert.Len(t, ana.AnalysisResult.RouterAnomaly.MissingEntries, 1)
+
+	setRouterMissingEntries(ana, entry)
+	ana.UpdateLifecycle(start.Add(20 * time.Second))
+
+	blipLifecycle := getLifecycleByID(ana)[analyzer.AnomalyID("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, blip)]
+	if assert.NotNil(t, blipLifecycle) {
+		assert.False(t, blipLifecycle.Active)
+		assert.False(t, blipLifecycle.Confirmed)
+	}
+}
@@ -0,0 +1,72 @@
+package analyzer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCycleListener(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	cycles := make(chan struct{}, 10)
+	ana.AddCycleListener(func() {
+		select {
+		case cycles <- struct{}{}:
+		default:
+		}
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- analyzer.RunAnalyzer(ctx, ana, 10*time.Millisecond)
+	}()
+
+	select {
+	case <-cycles:
+	case <-time.After(2 * time.Second):
+		t.Fatal("listener was not called after an analysis cycle")
+	}
+
+	cancel()
+	select {
+	case err := <-stopped:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("analyzer did not stop after the context was canceled")
+	}
+}
+
+func TestReconfigureRunningAnalyzer(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	suppressions := make(chan int, 10)
+	ana.AddCycleListener(func() {
+		select {
+		case suppressions <- len(ana.Suppressions):
+		default:
+		}
+	})
+	ana.Reconfigure(func(a *analyzer.Analyzer) {
+		a.Suppressions = []configs.SuppressionRule{{Prefix: "10.0.0.0/8"}}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go analyzer.RunAnalyzer(ctx, ana, time.Hour)
+
+	// the first cycle only runs once the shorter interval was applied
+	ana.SetPollInterval(10 * time.Millisecond)
+	select {
+	case count := <-suppressions:
+		assert.Equal(t, 1, count, "the change is applied before the cycle")
+	case <-time.After(2 * time.Second):
+		t.Fatal("changed poll interval was not applied")
+	}
+}
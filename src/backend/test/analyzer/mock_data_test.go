@@ -2743,3 +2743,304 @@ func getR201FRRdata() *frrProto.FullFRRData {
 
 	return metrics
 }
+
+// getAbrFRRdata returns an ABR attached to the backbone, a normal area 0.0.0.1
+// with two ranges and a totally stubby area 0.0.0.2
+func getAbrFRRdata() *frrProto.FullFRRData {
+	ospfInterface := func(name, address, area string) *frrProto.Interface {
+		return &frrProto.Interface{
+			Name: name,
+			InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+				{
+					IpPrefix: &frrProto.IPPrefix{
+						IpAddress:    address,
+						PrefixLength: 24,
+					},
+					Ospf:     true,
+					OspfArea: area,
+				},
+			},
+			Area: area,
+		}
+	}
+
+	staticFRRConfiguration := &frrProto.StaticFRRConfiguration{
+		Hostname: "r150",
+		Interfaces: []*frrProto.Interface{
+			ospfInterface("eth1", "10.0.50.1", "0.0.0.0"),
+			ospfInterface("eth2", "10.1.1.1", "0.0.0.1"),
+			ospfInterface("eth3", "10.1.2.1", "0.0.0.1"),
+			ospfInterface("eth4", "10.2.0.1", "0.0.0.1"),
+			ospfInterface("eth5", "10.5.0.1", "0.0.0.2"),
+		},
+		OspfConfig: &frrProto.OSPFConfig{
+			RouterId: "65.0.1.50",
+			Area: []*frrProto.Area{
+				{
+					Name:      "0.0.0.2",
+					Type:      "stub",
+					NoSummary: true,
+				},
+			},
+			AreaRange: []*frrProto.AreaRange{
+				{
+					Area:     "0.0.0.1",
+					IpPrefix: &frrProto.IPPrefix{IpAddress: "10.1.0.0", PrefixLength: 16},
+				},
+				{
+					Area:         "0.0.0.1",
+					IpPrefix:     &frrProto.IPPrefix{IpAddress: "10.2.0.0", PrefixLength: 16},
+					NotAdvertise: true,
+				},
+			},
+		},
+	}
+
+	ospfRoute := func(prefix string, prefixLength, metric int32) *frrProto.RouteEntry {
+		return &frrProto.RouteEntry{
+			Routes: []*frrProto.Route{
+				{
+					Prefix:    prefix,
+					PrefixLen: prefixLength,
+					Protocol:  "ospf",
+					Metric:    metric,
+				},
+			},
+		}
+	}
+
+	rib := &frrProto.RoutingInformationBase{
+		Routes: map[string]*frrProto.RouteEntry{
+			"10.0.50.0/24": ospfRoute("10.0.50.0/24", 24, 10),
+			"10.1.1.0/24":  ospfRoute("10.1.1.0/24", 24, 10),
+			"10.1.2.0/24":  ospfRoute("10.1.2.0/24", 24, 20),
+			"10.2.0.0/24":  ospfRoute("10.2.0.0/24", 24, 10),
+			"10.5.0.0/24":  ospfRoute("10.5.0.0/24", 24, 10),
+		},
+	}
+
+	ospfRouterDataAll := &frrProto.OSPFRouterData{
+		RouterId: "65.0.1.50",
+		RouterStates: map[string]*frrProto.OSPFRouterArea{
+			"0.0.0.0": {
+				LsaEntries: map[string]*frrProto.OSPFRouterLSA{
+					"65.0.1.50": {
+						LinkStateId:       "65.0.1.50",
+						AdvertisingRouter: "65.0.1.50",
+					},
+				},
+			},
+			"0.0.0.1": {
+				LsaEntries: map[string]*frrProto.OSPFRouterLSA{
+					"65.0.1.50": {
+						LinkStateId:       "65.0.1.50",
+						AdvertisingRouter: "65.0.1.50",
+						RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+							"link0": {
+								LinkType:               "another Router (point-to-point)",
+								NeighborRouterId:       "65.0.1.60",
+								RouterInterfaceAddress: "10.1.2.1",
+								Tos0Metric:             15,
+							},
+						},
+					},
+					"65.0.1.60": {
+						LinkStateId:       "65.0.1.60",
+						AdvertisingRouter: "65.0.1.60",
+						Asbr:              true,
+						RouterLinks: map[string]*frrProto.OSPFRouterLSALink{
+							"link0": {
+								LinkType:               "another Router (point-to-point)",
+								NeighborRouterId:       "65.0.1.50",
+								RouterInterfaceAddress: "10.1.2.2",
+								Tos0Metric:             15,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	metrics := &frrProto.FullFRRData{
+		GeneralOspfInformation: &frrProto.GeneralOspfInformation{
+			AbrType: "Alternative Cisco",
+			Areas: map[string]*frrProto.GeneralInfoOspfArea{
+				"0.0.0.0": {Backbone: true},
+				"0.0.0.1": {},
+				"0.0.0.2": {},
+			},
+		},
+		StaticFrrConfiguration: staticFRRConfiguration,
+		RoutingInformationBase: rib,
+		OspfRouterDataAll:      ospfRouterDataAll,
+	}
+
+	return metrics
+}
+
+type summaryLsaEntry struct {
+	area, linkStateId string
+	networkMask       int32
+	metric            int32
+}
+
+func getSummaryData(routerId string, entries []summaryLsaEntry) *frrProto.OSPFSummaryData {
+	result := &frrProto.OSPFSummaryData{
+		RouterId:      routerId,
+		SummaryStates: map[string]*frrProto.SummaryAreaState{},
+	}
+	for _, entry := range entries {
+		if result.SummaryStates[entry.area] == nil {
+			result.SummaryStates[entry.area] = &frrProto.SummaryAreaState{
+				LsaEntries: map[string]*frrProto.SummaryLSA{},
+			}
+		}
+		result.SummaryStates[entry.area].LsaEntries[entry.linkStateId] = &frrProto.SummaryLSA{
+			LsaType:           "summary-LSA",
+			LinkStateId:       entry.linkStateId,
+			AdvertisingRouter: routerId,
+			NetworkMask:       entry.networkMask,
+			Tos0Metric:        entry.metric,
+		}
+	}
+	return result
+}
+
+func getAsbrSummaryData(routerId string, entries []summaryLsaEntry) *frrProto.OSPFAsbrSummaryData {
+	summaryData := getSummaryData(routerId, entries)
+	return &frrProto.OSPFAsbrSummaryData{
+		RouterId:          routerId,
+		AsbrSummaryStates: summaryData.SummaryStates,
+	}
+}
+
+// getNssaTranslatorFRRdata returns an NSSA border router 65.0.1.50 of area 0.0.0.3,
+// in which 65.0.1.70 is the other border router and 65.0.1.20 an internal ASBR
+func getNssaTranslatorFRRdata() *frrProto.FullFRRData {
+	nssaLsa := func(prefix, forwardAddress, options string) *frrProto.NssaExternalLSA {
+		return &frrProto.NssaExternalLSA{
+			LsaType:            "NSSA-LSA",
+			LinkStateId:        prefix,
+			AdvertisingRouter:  "65.0.1.20",
+			NetworkMask:        24,
+			Options:            options,
+			MetricType:         "E2",
+			Metric:             20,
+			NssaForwardAddress: forwardAddress,
+		}
+	}
+	externalLsa := func(prefix, advertisingRouter, forwardAddress string) *frrProto.ASExternalLinkState {
+		return &frrProto.ASExternalLinkState{
+			LsaType:           "AS-external-LSA",
+			LinkStateId:       prefix,
+			AdvertisingRouter: advertisingRouter,
+			NetworkMask:       24,
+			MetricType:        "E2",
+			Metric:            20,
+			ForwardAddress:    forwardAddress,
+		}
+	}
+
+	return &frrProto.FullFRRData{
+		GeneralOspfInformation: &frrProto.GeneralOspfInformation{
+			Areas: map[string]*frrProto.GeneralInfoOspfArea{
+				"0.0.0.0": {Backbone: true},
+				"0.0.0.3": {},
+			},
+		},
+		StaticFrrConfiguration: &frrProto.StaticFRRConfiguration{
+			Hostname: "r150",
+			Interfaces: []*frrProto.Interface{
+				{
+					Name: "eth1",
+					Area: "0.0.0.0",
+					InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+						{
+							IpPrefix: &frrProto.IPPrefix{IpAddress: "10.0.50.1", PrefixLength: 24},
+							Ospf:     true,
+							OspfArea: "0.0.0.0",
+						},
+					},
+				},
+				{
+					Name: "eth2",
+					Area: "0.0.0.3",
+					InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+						{
+							IpPrefix: &frrProto.IPPrefix{IpAddress: "10.3.0.1", PrefixLength: 24},
+							Ospf:     true,
+							OspfArea: "0.0.0.3",
+						},
+					},
+				},
+			},
+			OspfConfig: &frrProto.OSPFConfig{
+				RouterId: "65.0.1.50",
+				Area: []*frrProto.Area{
+					{
+						Name: "0.0.0.3",
+						Type: "nssa",
+					},
+				},
+			},
+		},
+		OspfRouterDataAll: &frrProto.OSPFRouterData{
+			RouterId: "65.0.1.50",
+			RouterStates: map[string]*frrProto.OSPFRouterArea{
+				"0.0.0.3": {
+					LsaEntries: map[string]*frrProto.OSPFRouterLSA{
+						"65.0.1.50": {
+							LinkStateId:       "65.0.1.50",
+							AdvertisingRouter: "65.0.1.50",
+							Flags:             1,
+						},
+						"65.0.1.70": {
+							LinkStateId:       "65.0.1.70",
+							AdvertisingRouter: "65.0.1.70",
+							Flags:             1,
+						},
+						"65.0.1.20": {
+							LinkStateId:       "65.0.1.20",
+							AdvertisingRouter: "65.0.1.20",
+							Flags:             2,
+							Asbr:              true,
+						},
+					},
+				},
+			},
+		},
+		OspfNssaExternalAll: &frrProto.OSPFNssaExternalAll{
+			RouterId: "65.0.1.50",
+			NssaExternalAllLinkStates: map[string]*frrProto.NssaExternalArea{
+				"0.0.0.3": {
+					Data: map[string]*frrProto.NssaExternalLSA{
+						"192.168.10.0": nssaLsa("192.168.10.0", "10.3.0.20", "*|-|-|-|N/P|-|E|-"),
+						"192.168.11.0": nssaLsa("192.168.11.0", "10.3.0.20", "*|-|-|-|N/P|-|E|-"),
+						"192.168.12.0": nssaLsa("192.168.12.0", "10.3.0.20", "*|-|-|-|N/P|-|E|-"),
+						"192.168.13.0": nssaLsa("192.168.13.0", "10.9.9.9", "*|-|-|-|N/P|-|E|-"),
+						"192.168.14.0": nssaLsa("192.168.14.0", "0.0.0.0", "*|-|-|-|-|-|E|-"),
+					},
+				},
+			},
+		},
+		OspfExternalAll: &frrProto.OSPFExternalAll{
+			RouterId: "65.0.1.50",
+			AsExternalLinkStates: []*frrProto.ASExternalLinkState{
+				externalLsa("192.168.10.0", "65.0.1.70", "10.3.0.20"),
+				externalLsa("192.168.11.0", "65.0.1.50", "10.3.0.20"),
+				externalLsa("192.168.13.0", "65.0.1.70", "10.9.9.9"),
+			},
+		},
+		RoutingInformationBase: &frrProto.RoutingInformationBase{
+			Routes: map[string]*frrProto.RouteEntry{
+				"0.0.0.0/0": {
+					Routes: []*frrProto.Route{{Prefix: "0.0.0.0/0", Protocol: "static"}},
+				},
+				"10.3.0.0/24": {
+					Routes: []*frrProto.Route{{Prefix: "10.3.0.0/24", PrefixLen: 24, Protocol: "connected"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func runNssaTranslatorAnalysis(metrics *frrProto.FullFRRData) *frrProto.AnomalyDetection {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	result := &frrProto.AnomalyDetection{}
+	ana.NssaTranslatorAnalysis(result)
+
+	return result
+}
+
+func getReasonsByPrefix(entries []*frrProto.Advertisement) map[string][]string {
+	result := map[string][]string{}
+	for _, entry := range entries {
+		prefix := entry.LinkStateId + "/" + entry.PrefixLength
+		result[prefix] = append(result[prefix], entry.Reason)
+	}
+	return result
+}
+
+func TestNssaTranslatorElection(t *testing.T) {
+	result := runNssaTranslatorAnalysis(getNssaTranslatorFRRdata())
+
+	t.Run("MissingTranslation", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"192.168.12.0/24": {"P-bit set, but not translated to Type-5 by elected translator 65.0.1.70"},
+		}, getReasonsByPrefix(result.MissingEntries))
+	})
+
+	t.Run("WrongTranslatorAndForwardingAddress", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"192.168.11.0/24": {"Type-5 originated by 65.0.1.50 instead of elected translator 65.0.1.70"},
+			"192.168.13.0/24": {
+				"Type-7 forwarding address 10.9.9.9 is not reachable in the RIB",
+				"translated Type-5 forwarding address 10.9.9.9 is not reachable in the RIB",
+			},
+		}, getReasonsByPrefix(result.MisconfiguredEntries))
+
+		for _, entry := range result.MisconfiguredEntries {
+			assert.Equal(t, "0.0.0.3", entry.OspfArea)
+			assert.True(t, entry.PBit)
+		}
+	})
+}
+
+func TestNssaTranslatorTranslateAlways(t *testing.T) {
+	metrics := getNssaTranslatorFRRdata()
+	metrics.StaticFrrConfiguration.OspfConfig.Area[0].NssaTranslatorRole = "translate-always"
+
+	result := runNssaTranslatorAnalysis(metrics)
+
+	assert.Equal(t, map[string][]string{
+		"192.168.12.0/24": {"P-bit set, but not translated to Type-5 by elected translator 65.0.1.50"},
+	}, getReasonsByPrefix(result.MissingEntries))
+	assert.Equal(t, map[string][]string{
+		"192.168.10.0/24": {"Type-5 originated by 65.0.1.70 instead of elected translator 65.0.1.50"},
+		"192.168.13.0/24": {
+			"Type-7 forwarding address 10.9.9.9 is not reachable in the RIB",
+			"Type-5 originated by 65.0.1.70 instead of elected translator 65.0.1.50",
+		},
+	}, getReasonsByPrefix(result.MisconfiguredEntries))
+}
+
+func TestNssaTranslatorNoBorderRouter(t *testing.T) {
+	metrics := getNssaTranslatorFRRdata()
+	metrics.StaticFrrConfiguration.OspfConfig.Area[0].NssaTranslatorRole = "translate-never"
+	delete(metrics.OspfRouterDataAll.RouterStates["0.0.0.3"].LsaEntries, "65.0.1.70")
+
+	result := runNssaTranslatorAnalysis(metrics)
+
+	assert.Len(t, result.MissingEntries, 4)
+	for _, entry := range result.MissingEntries {
+		assert.Equal(t, "P-bit set, but no NSSA border router is available to translate it", entry.Reason)
+	}
+}
+
+func TestNssaTranslatorInternalRouter(t *testing.T) {
+	metrics := getNssaTranslatorFRRdata()
+	// 65.0.1.50 only keeps its NSSA interface, 65.0.1.70 stays the remote border router
+	metrics.StaticFrrConfiguration.Interfaces = metrics.StaticFrrConfiguration.Interfaces[1:]
+	metrics.OspfRouterDataAll.RouterStates["0.0.0.3"].LsaEntries["65.0.1.50"].Flags = 0
+	metrics.OspfExternalAll = nil
+
+	result := runNssaTranslatorAnalysis(metrics)
+
+	assert.Empty(t, result.MissingEntries)
+	assert.Equal(t, map[string][]string{
+		"192.168.13.0/24": {"Type-7 forwarding address 10.9.9.9 is not reachable in the RIB"},
+	}, getReasonsByPrefix(result.MisconfiguredEntries))
+}
@@ -0,0 +1,76 @@
+package analyzer_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func getReportAnalyzer() *analyzer.Analyzer {
+	metrics, appLogger, anomalyLogger := getMockData()
+	metrics.StaticFrrConfiguration = &frrProto.StaticFRRConfiguration{Hostname: "r101"}
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	ana.AnalysisResult.RouterAnomaly.HasUnAdvertisedPrefixes = true
+	ana.AnalysisResult.RouterAnomaly.MissingEntries = []*frrProto.Advertisement{
+		{InterfaceAddress: "10.0.12.0", PrefixLength: "24", OspfArea: "0.0.0.0", Severity: analyzer.SeverityCritical},
+	}
+	ana.AnalysisResult.ExternalAnomaly.HasOverAdvertisedPrefixes = true
+	ana.AnalysisResult.ExternalAnomaly.SuperfluousEntries = []*frrProto.Advertisement{
+		{LinkStateId: "192.168.0.0", PrefixLength: "16", Severity: analyzer.SeverityWarning, Suppressed: true},
+	}
+	return ana
+}
+
+func TestWriteReportText(t *testing.T) {
+	ana := getReportAnalyzer()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, ana.WriteReport(&buffer, analyzer.ReportFormatText))
+
+	report := buffer.String()
+	assert.Contains(t, report, "FRR-MAD analysis report for r101")
+	assert.Contains(t, report, "[critical] unadvertised 10.0.12.0/24 area 0.0.0.0")
+	assert.Contains(t, report, "[warning] overadvertised 192.168.0.0/16 (suppressed)")
+	assert.Contains(t, report, "2 anomalies, 1 actionable")
+	assert.True(t, ana.HasActionableAnomalies())
+}
+
+func TestWriteReportJSON(t *testing.T) {
+	ana := getReportAnalyzer()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, ana.WriteReport(&buffer, analyzer.ReportFormatJSON))
+
+	result := &frrProto.AnomalyAnalysis{}
+	assert.NoError(t, protojson.Unmarshal(buffer.Bytes(), result))
+	assert.Equal(t, "10.0.12.0", result.RouterAnomaly.MissingEntries[0].InterfaceAddress)
+}
+
+func TestWriteReportJUnit(t *testing.T) {
+	ana := getReportAnalyzer()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, ana.WriteReport(&buffer, analyzer.ReportFormatJUnit))
+
+	var suites struct {
+		Tests    int `xml:"tests,attr"`
+		Failures int `xml:"failures,attr"`
+		Suites   []struct {
+			Name    string `xml:"name,attr"`
+			Skipped int    `xml:"skipped,attr"`
+		} `xml:"testsuite"`
+	}
+	assert.NoError(t, xml.Unmarshal(buffer.Bytes(), &suites))
+	assert.Equal(t, 1, suites.Failures)
+	assert.Equal(t, 8, suites.Tests, "one test per built-in check")
+	assert.Equal(t, "ExternalAnomaly", suites.Suites[1].Name)
+	assert.Equal(t, 1, suites.Suites[1].Skipped)
+
+	assert.Error(t, ana.WriteReport(&buffer, "yaml"))
+}
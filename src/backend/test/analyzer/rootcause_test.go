@@ -0,0 +1,116 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func getReasonCodesByPrefix(entries []*frrProto.Advertisement) map[string]string {
+	result := map[string]string{}
+	for _, entry := range entries {
+		prefix := entry.InterfaceAddress
+		if prefix == "" {
+			prefix = entry.LinkStateId + "/" + entry.PrefixLength
+		}
+		result[prefix] = entry.ReasonCode
+	}
+	return result
+}
+
+func TestExplainAnomalies(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	metrics := getWhatIfData(t, "./mock-files/r101.conf")
+	metrics.Interfaces = &frrProto.InterfaceList{
+		Interfaces: map[string]*frrProto.SingleInterface{
+			"eth3": {AdministrativeStatus: "Up", OperationalStatus: "Down"},
+			"eth6": {AdministrativeStatus: "Up", OperationalStatus: "Up"},
+		},
+	}
+	metrics.OspfNeighbors = &frrProto.OSPFNeighbors{
+		Neighbors: map[string]*frrProto.NeighborList{
+			"65.0.1.7": {Neighbors: []*frrProto.Neighbor{{IfaceName: "eth7:10.0.15.1"}}},
+		},
+	}
+	metrics.StaticFrrConfiguration.AccessList["localsite"].AccessListItems = append(
+		metrics.StaticFrrConfiguration.AccessList["localsite"].AccessListItems,
+		&frrProto.AccessListItem{Sequence: 20, AccessControl: "deny", Destination: &frrProto.AccessListItem_Any{Any: true}},
+	)
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	ana.AnalysisResult.RouterAnomaly.MissingEntries = []*frrProto.Advertisement{
+		{InterfaceAddress: "10.0.13.0", PrefixLength: "24", LinkType: "unknown"},
+		{InterfaceAddress: "10.0.0.1", PrefixLength: "23", LinkType: "transit network"},
+		{InterfaceAddress: "10.0.14.1", PrefixLength: "24", LinkType: "transit network"},
+		{InterfaceAddress: "10.0.15.1", PrefixLength: "24", LinkType: "transit network"},
+	}
+	ana.AnalysisResult.RouterAnomaly.DuplicateEntries = []*frrProto.Advertisement{
+		{InterfaceAddress: "10.0.16.1", PrefixLength: "24", LinkType: "transit network"},
+	}
+	ana.AnalysisResult.ExternalAnomaly.SuperfluousEntries = []*frrProto.Advertisement{
+		{LinkStateId: "192.168.4.0", PrefixLength: "22"},
+		{LinkStateId: "192.168.1.0", PrefixLength: "24"},
+	}
+	ana.AnalysisResult.LsdbToRibAnomaly.MissingEntries = []*frrProto.Advertisement{
+		{LinkStateId: "10.0.21.0", PrefixLength: "24", RouteSelection: analyzer.RouteSelectionMissing, Reason: "no route for the prefix in the RIB"},
+	}
+	ana.AnalysisResult.LsdbToRibAnomaly.ExplainedEntries = []*frrProto.Advertisement{
+		{LinkStateId: "10.0.20.0", PrefixLength: "24", RouteSelection: analyzer.RouteSelectionShadowed, Reason: "shadowed by static with better administrative distance 1 than ospf 110"},
+	}
+	ana.AnalysisResult.IntentAnomaly.MissingEntries = []*frrProto.Advertisement{
+		{LinkStateId: "10.0.22.0", PrefixLength: "24", Reason: "intent: 10.0.22.0/24 must be in the LSDB, but no LSA is found"},
+		{LinkStateId: "10.0.23.0", PrefixLength: "24", ReasonCode: "custom", Reason: "set by the check"},
+	}
+
+	ana.ExplainAnomalies()
+
+	assert.Equal(t, map[string]string{
+		"10.0.13.0": analyzer.ReasonInterfaceDown,
+		"10.0.0.1":  analyzer.ReasonPassiveInterface,
+		"10.0.14.1": analyzer.ReasonNoNeighbor,
+		"10.0.15.1": analyzer.ReasonNotAdvertised,
+	}, getReasonCodesByPrefix(ana.AnalysisResult.RouterAnomaly.MissingEntries))
+	assert.Equal(t, "interface eth3 is operationally down", ana.AnalysisResult.RouterAnomaly.MissingEntries[0].Explanation)
+	assert.Equal(t, "interface eth4 is passive, no adjacency is formed", ana.AnalysisResult.RouterAnomaly.MissingEntries[1].Explanation)
+	assert.Equal(t, "no OSPF neighbor on interface eth6", ana.AnalysisResult.RouterAnomaly.MissingEntries[2].Explanation)
+
+	assert.Equal(t, analyzer.ReasonDuplicateLsa, ana.AnalysisResult.RouterAnomaly.DuplicateEntries[0].ReasonCode)
+
+	assert.Equal(t, map[string]string{
+		"192.168.4.0/22": analyzer.ReasonDeniedByAccessList,
+		"192.168.1.0/24": analyzer.ReasonNotExpected,
+	}, getReasonCodesByPrefix(ana.AnalysisResult.ExternalAnomaly.SuperfluousEntries))
+	assert.Equal(t, "prefix denied by access-list localsite seq 20 (route-map lanroutes of redistribute static)",
+		ana.AnalysisResult.ExternalAnomaly.SuperfluousEntries[0].Explanation)
+
+	assert.Equal(t, map[string]string{
+		"10.0.21.0/24": analyzer.ReasonNoRoute,
+	}, getReasonCodesByPrefix(ana.AnalysisResult.LsdbToRibAnomaly.MissingEntries))
+	assert.Equal(t, map[string]string{
+		"10.0.20.0/24": analyzer.ReasonShadowedRoute,
+	}, getReasonCodesByPrefix(ana.AnalysisResult.LsdbToRibAnomaly.ExplainedEntries))
+	assert.Equal(t, "shadowed by static with better administrative distance 1 than ospf 110",
+		ana.AnalysisResult.LsdbToRibAnomaly.ExplainedEntries[0].Explanation)
+
+	assert.Equal(t, map[string]string{
+		"10.0.22.0/24": analyzer.ReasonIntentViolated,
+		"10.0.23.0/24": "custom",
+	}, getReasonCodesByPrefix(ana.AnalysisResult.IntentAnomaly.MissingEntries))
+	assert.Equal(t, "set by the check", ana.AnalysisResult.IntentAnomaly.MissingEntries[1].Explanation)
+}
+
+func TestExplainAnomaliesInCycle(t *testing.T) {
+	_, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger)
+
+	ana.AnomalyAnalysis()
+
+	entries := ana.AnalysisResult.RouterAnomaly.MissingEntries
+	assert.NotEmpty(t, entries)
+	for _, entry := range entries {
+		assert.NotEmpty(t, entry.ReasonCode, entry.InterfaceAddress)
+		assert.NotEmpty(t, entry.Explanation, entry.InterfaceAddress)
+	}
+}
@@ -0,0 +1,125 @@
+package analyzer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func ribRoute(protocol string, distance int32, selected, installed bool) *frrProto.Route {
+	return &frrProto.Route{Protocol: protocol, Distance: distance, Selected: selected, Installed: installed}
+}
+
+func runRouteSelectionAnalysis(rib map[string]*frrProto.RouteEntry, prefixes ...string) *frrProto.AnomalyDetection {
+	return runRouteSelectionAnalyzer(rib, prefixes...).AnalysisResult.LsdbToRibAnomaly
+}
+
+func runRouteSelectionAnalyzer(rib map[string]*frrProto.RouteEntry, prefixes ...string) *analyzer.Analyzer {
+	_, appLogger, anomalyLogger := getMockData()
+	metrics := &frrProto.FullFRRData{
+		RoutingInformationBase: &frrProto.RoutingInformationBase{Routes: rib},
+	}
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	networkLsdb := &frrProto.IntraAreaLsa{Areas: []*frrProto.AreaAnalyzer{{AreaName: "0.0.0.0", LsaType: "network-LSA"}}}
+	for _, prefix := range prefixes {
+		networkLsdb.Areas[0].Links = append(networkLsdb.Areas[0].Links, &frrProto.Advertisement{LinkStateId: prefix})
+	}
+
+	ana.AnomalyAnalysisFIB(analyzer.GetFIB(metrics.RoutingInformationBase, appLogger), networkLsdb,
+		&frrProto.InterAreaLsa{}, &frrProto.InterAreaLsa{}, &frrProto.InterAreaLsa{})
+
+	return ana
+}
+
+func getRouteSelectionByPrefix(entries []*frrProto.Advertisement) map[string]string {
+	result := map[string]string{}
+	for _, entry := range entries {
+		result[entry.LinkStateId+"/"+entry.PrefixLength] = entry.RouteSelection
+	}
+	return result
+}
+
+func TestRouteSelectionInstalled(t *testing.T) {
+	result := runRouteSelectionAnalysis(map[string]*frrProto.RouteEntry{
+		"10.1.0.0/24": {Routes: []*frrProto.Route{ribRoute("ospf", 110, true, true)}},
+	}, "10.1.0.0/24")
+
+	assert.False(t, result.HasUnAdvertisedPrefixes)
+	assert.Empty(t, result.MissingEntries)
+}
+
+func TestRouteSelectionShadowed(t *testing.T) {
+	result := runRouteSelectionAnalysis(map[string]*frrProto.RouteEntry{
+		"10.1.0.0/24": {Routes: []*frrProto.Route{
+			ribRoute("connected", 0, true, true),
+			ribRoute("ospf", 110, false, false),
+		}},
+		"10.2.0.0/24": {Routes: []*frrProto.Route{
+			ribRoute("ospf", 110, false, false),
+			ribRoute("static", 1, true, true),
+		}},
+	}, "10.1.0.0/24", "10.2.0.0/24")
+
+	// shadowed prefixes are explained, but no anomaly
+	assert.False(t, result.HasUnAdvertisedPrefixes)
+	assert.Empty(t, result.MissingEntries)
+	assert.Equal(t, map[string]string{
+		"10.1.0.0/24": analyzer.RouteSelectionShadowed,
+		"10.2.0.0/24": analyzer.RouteSelectionShadowed,
+	}, getRouteSelectionByPrefix(result.ExplainedEntries))
+	assert.Equal(t, map[string][]string{
+		"10.1.0.0/24": {"shadowed by connected with better administrative distance 0 than ospf 110"},
+		"10.2.0.0/24": {"shadowed by static with better administrative distance 1 than ospf 110"},
+	}, getReasonsByPrefix(result.ExplainedEntries))
+}
+
+func TestRouteSelectionShadowedNotActionable(t *testing.T) {
+	ana := runRouteSelectionAnalyzer(map[string]*frrProto.RouteEntry{
+		"10.1.0.0/24": {Routes: []*frrProto.Route{
+			ribRoute("ospf", 110, false, false),
+			ribRoute("static", 1, true, true),
+		}},
+	}, "10.1.0.0/24")
+
+	now := time.Unix(1700000000, 0)
+	ana.UpdateLifecycle(now)
+	ana.ClassifyAnomalies(now)
+	ana.ExplainAnomalies()
+
+	// shadowed prefixes get no lifecycle entry and don't fail the analysis
+	assert.Empty(t, ana.AnalysisResult.Lifecycle)
+	assert.False(t, ana.HasActionableAnomalies())
+
+	explained := ana.AnalysisResult.LsdbToRibAnomaly.ExplainedEntries[0]
+	assert.Equal(t, analyzer.SeverityInfo, explained.Severity)
+	assert.Equal(t, analyzer.ReasonShadowedRoute, explained.ReasonCode)
+}
+
+func TestRouteSelectionNotSelectedAndMissing(t *testing.T) {
+	result := runRouteSelectionAnalysis(map[string]*frrProto.RouteEntry{
+		"10.1.0.0/24": {Routes: []*frrProto.Route{
+			ribRoute("ospf", 110, false, false),
+			ribRoute("bgp", 200, true, true),
+		}},
+		"10.2.0.0/24": {Routes: []*frrProto.Route{ribRoute("ospf", 110, true, false)}},
+		"10.3.0.0/24": {Routes: []*frrProto.Route{ribRoute("bgp", 20, true, true)}},
+	}, "10.1.0.0/24", "10.2.0.0/24", "10.3.0.0/24", "10.4.0.0/24")
+
+	assert.True(t, result.HasUnAdvertisedPrefixes)
+	assert.Equal(t, map[string]string{
+		"10.1.0.0/24": analyzer.RouteSelectionNotSelected,
+		"10.2.0.0/24": analyzer.RouteSelectionNotSelected,
+		"10.3.0.0/24": analyzer.RouteSelectionMissing,
+		"10.4.0.0/24": analyzer.RouteSelectionMissing,
+	}, getRouteSelectionByPrefix(result.MissingEntries))
+	assert.Equal(t, map[string][]string{
+		"10.1.0.0/24": {"OSPF route with distance 110 not selected, bgp (distance 200) is preferred"},
+		"10.2.0.0/24": {"OSPF route is selected, but not installed in the FIB"},
+		"10.3.0.0/24": {"no OSPF route in the RIB, prefix is served by bgp (distance 20)"},
+		"10.4.0.0/24": {"no route for the prefix in the RIB"},
+	}, getReasonsByPrefix(result.MissingEntries))
+}
@@ -0,0 +1,107 @@
+package analyzer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnomalySeverity(t *testing.T) {
+	entry := &frrProto.Advertisement{InterfaceAddress: "10.0.12.0", PrefixLength: "24"}
+	shadowed := &frrProto.Advertisement{InterfaceAddress: "10.0.13.0", PrefixLength: "24", RouteSelection: analyzer.RouteSelectionShadowed}
+
+	assert.Equal(t, analyzer.SeverityCritical, analyzer.AnomalySeverity("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, entry))
+	assert.Equal(t, analyzer.SeverityCritical, analyzer.AnomalySeverity("ExternalAnomaly", analyzer.AnomalyTypeDuplicate, entry))
+	assert.Equal(t, analyzer.SeverityWarning, analyzer.AnomalySeverity("RouterAnomaly", analyzer.AnomalyTypeOverAdvertised, entry))
+	assert.Equal(t, analyzer.SeverityWarning, analyzer.AnomalySeverity("ExternalAnomaly", analyzer.AnomalyTypeMisconfigured, entry))
+	assert.Equal(t, analyzer.SeverityWarning, analyzer.AnomalySeverity("Ecmp", analyzer.AnomalyTypeUnAdvertised, entry))
+	assert.Equal(t, analyzer.SeverityInfo, analyzer.AnomalySeverity("LsdbToRib", analyzer.AnomalyTypeUnAdvertised, shadowed))
+}
+
+func TestAnomalySuppressionRules(t *testing.T) {
+	metrics, appLogger, anomalyLogger := getMockData()
+	metrics.StaticFrrConfiguration = &frrProto.StaticFRRConfiguration{
+		Interfaces: []*frrProto.Interface{
+			{
+				Name: "eth2",
+				InterfaceIpPrefixes: []*frrProto.InterfaceIPPrefix{
+					{IpPrefix: &frrProto.IPPrefix{IpAddress: "192.168.1.1", PrefixLength: 24}},
+				},
+			},
+		},
+	}
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+	ana.Suppressions = []configs.SuppressionRule{
+		{Prefix: "10.10.0.0/16", Comment: "lab prefixes"},
+		{Area: "0.0.0.2", Source: "summary"},
+		{Interface: "eth2"},
+	}
+
+	byPrefix := &frrProto.Advertisement{LinkStateId: "10.10.5.0", PrefixLength: "24", OspfArea: "0.0.0.0"}
+	shorterPrefix := &frrProto.Advertisement{LinkStateId: "10.0.0.0", PrefixLength: "8", OspfArea: "0.0.0.0"}
+	byArea := &frrProto.Advertisement{LinkStateId: "10.20.0.0", PrefixLength: "16", OspfArea: "0.0.0.2"}
+	otherSource := &frrProto.Advertisement{InterfaceAddress: "10.30.0.1", PrefixLength: "24", OspfArea: "0.0.0.2"}
+	byInterface := &frrProto.Advertisement{InterfaceAddress: "192.168.1.1", PrefixLength: "24", OspfArea: "0.0.0.0"}
+
+	ana.AnalysisResult.ExternalAnomaly.MissingEntries = []*frrProto.Advertisement{byPrefix, shorterPrefix}
+	ana.AnalysisResult.SummaryAnomaly.SuperfluousEntries = []*frrProto.Advertisement{byArea}
+	ana.AnalysisResult.RouterAnomaly.MissingEntries = []*frrProto.Advertisement{otherSource, byInterface}
+
+	ana.ClassifyAnomalies(time.Now())
+
+	assert.True(t, byPrefix.Suppressed)
+	assert.Equal(t, "lab prefixes", byPrefix.SuppressionReason)
+	assert.Equal(t, analyzer.SeverityCritical, byPrefix.Severity)
+	assert.False(t, shorterPrefix.Suppressed)
+
+	assert.True(t, byArea.Suppressed)
+	assert.Equal(t, "suppressed by rule: area 0.0.0.2, source summary", byArea.SuppressionReason)
+	assert.False(t, otherSource.Suppressed)
+
+	assert.True(t, byInterface.Suppressed)
+	assert.Equal(t, "suppressed by rule: interface eth2", byInterface.SuppressionReason)
+}
+
+func TestAnomalyAcknowledgement(t *testing.T) {
+	metrics, appLogger, anomalyLogger := getMockData()
+	ana := analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger)
+
+	entry := &frrProto.Advertisement{InterfaceAddress: "10.0.12.0", PrefixLength: "24", OspfArea: "0.0.0.0"}
+	id := analyzer.AnomalyID("RouterAnomaly", analyzer.AnomalyTypeUnAdvertised, entry)
+	start := time.Unix(1700000000, 0)
+
+	setRouterMissingEntries(ana, entry)
+	ana.UpdateLifecycle(start)
+	ana.ClassifyAnomalies(start)
+	assert.False(t, entry.Acknowledged)
+
+	_, err := analyzer.AcknowledgeAnomaly(ana.AnalysisResult, "RouterAnomaly:unadvertised:10.9.9.0/24:0.0.0.0", time.Hour, "", start)
+	assert.Error(t, err)
+	_, err = analyzer.AcknowledgeAnomaly(ana.AnalysisResult, id, 0, "", start)
+	assert.Error(t, err)
+
+	ack, err := analyzer.AcknowledgeAnomaly(ana.AnalysisResult, id, time.Hour, "maintenance window", start)
+	assert.NoError(t, err)
+	assert.Equal(t, start.Add(time.Hour).Unix(), ack.ExpiresAt)
+
+	// the published anomaly is acknowledged right away, not only after the next cycle
+	assert.True(t, entry.Acknowledged)
+	assert.Equal(t, "maintenance window", entry.SuppressionReason)
+
+	ana.UpdateLifecycle(start.Add(time.Minute))
+	ana.ClassifyAnomalies(start.Add(time.Minute))
+	assert.True(t, entry.Acknowledged)
+	assert.Equal(t, "maintenance window", entry.SuppressionReason)
+	assert.True(t, getLifecycleByID(ana)[id].Acknowledged)
+
+	// acknowledgement expired
+	ana.UpdateLifecycle(start.Add(2 * time.Hour))
+	ana.ClassifyAnomalies(start.Add(2 * time.Hour))
+	assert.False(t, entry.Acknowledged)
+	assert.Empty(t, ana.AnalysisResult.Acknowledgements)
+	assert.False(t, getLifecycleByID(ana)[id].Acknowledged)
+}
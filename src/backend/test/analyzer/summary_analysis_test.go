@@ -0,0 +1,190 @@
+package analyzer_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func initAbrAnalyzer() (*analyzer.Analyzer, *frrProto.FullFRRData) {
+	_, appLogger, anomalyLogger := getMockData()
+	metrics := getAbrFRRdata()
+	return analyzer.InitAnalyzer(metrics, appLogger, anomalyLogger), metrics
+}
+
+func getPrefixesPerArea(lsdb *frrProto.InterAreaLsa) map[string][]string {
+	result := map[string][]string{}
+	for _, area := range lsdb.Areas {
+		result[area.AreaName] = []string{}
+		for _, link := range area.Links {
+			result[area.AreaName] = append(result[area.AreaName], link.LinkStateId+"/"+link.PrefixLength)
+		}
+		sort.Strings(result[area.AreaName])
+	}
+	return result
+}
+
+func getAnomalyPrefixes(entries []*frrProto.Advertisement) []string {
+	result := []string{}
+	for _, entry := range entries {
+		result = append(result, entry.OspfArea+":"+entry.LinkStateId+"/"+entry.PrefixLength)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestSummaryShouldState(t *testing.T) {
+	ana, metrics := initAbrAnalyzer()
+
+	t.Run("SummaryLsas", func(t *testing.T) {
+		shouldState := ana.GetStaticFileSummaryData(metrics.StaticFrrConfiguration)
+		assert.Equal(t, "abr", shouldState.RouterType)
+
+		expected := map[string][]string{
+			"0.0.0.0": {"10.1.0.0/16", "10.5.0.0/24"},
+			"0.0.0.1": {"10.0.50.0/24", "10.5.0.0/24"},
+			"0.0.0.2": {"0.0.0.0/0"},
+		}
+		assert.Equal(t, expected, getPrefixesPerArea(shouldState))
+
+		for _, area := range shouldState.Areas {
+			for _, link := range area.Links {
+				if link.LinkStateId == "10.1.0.0" {
+					assert.Equal(t, int32(20), link.Metric, "range cost must be the highest component cost")
+				}
+			}
+			if area.AreaName == "0.0.0.2" {
+				assert.Equal(t, "stub no-summary", area.AreaType)
+			}
+		}
+	})
+
+	t.Run("AsbrSummaryLsas", func(t *testing.T) {
+		shouldState := ana.GetStaticFileAsbrSummaryData(metrics.StaticFrrConfiguration)
+
+		expected := map[string][]string{
+			"0.0.0.0": {"65.0.1.60/32"},
+			"0.0.0.1": {},
+			"0.0.0.2": {},
+		}
+		assert.Equal(t, expected, getPrefixesPerArea(shouldState))
+		assert.Equal(t, int32(15), shouldState.Areas[0].Links[0].Metric, "the metric is the intra-area cost to the ASBR")
+	})
+
+	t.Run("InternalRouter", func(t *testing.T) {
+		config := getR101FRRdata().StaticFrrConfiguration
+		shouldState := ana.GetStaticFileSummaryData(config)
+		assert.Empty(t, shouldState.Areas)
+	})
+}
+
+func TestSummaryAnomalyHappy(t *testing.T) {
+	ana, metrics := initAbrAnalyzer()
+
+	summaryData := getSummaryData("65.0.1.50", []summaryLsaEntry{
+		{"0.0.0.0", "10.1.0.0", 16, 20},
+		{"0.0.0.0", "10.5.0.0", 24, 10},
+		{"0.0.0.1", "10.0.50.0", 24, 10},
+		{"0.0.0.1", "10.5.0.0", 24, 10},
+		// inter-area route learned over the backbone cannot be predicted, but is valid
+		{"0.0.0.1", "172.16.0.0", 24, 30},
+		{"0.0.0.2", "0.0.0.0", 0, 1},
+	})
+	asbrSummaryData := getAsbrSummaryData("65.0.1.50", []summaryLsaEntry{
+		{"0.0.0.0", "65.0.1.60", 0, 15},
+	})
+
+	shouldSummary := ana.GetStaticFileSummaryData(metrics.StaticFrrConfiguration)
+	isSummary := analyzer.GetRuntimeSummaryDataSelf(summaryData, "r150", ana.Logger)
+	ana.SummaryAnomalyAnalysis(metrics.StaticFrrConfiguration.OspfConfig.AreaRange, shouldSummary, isSummary)
+
+	shouldAsbrSummary := ana.GetStaticFileAsbrSummaryData(metrics.StaticFrrConfiguration)
+	isAsbrSummary := analyzer.GetRuntimeAsbrSummaryDataSelf(asbrSummaryData, "r150", ana.Logger)
+	ana.AsbrSummaryAnomalyAnalysis(shouldAsbrSummary, isAsbrSummary)
+
+	assert.False(t, ana.AnalysisResult.SummaryAnomaly.HasUnAdvertisedPrefixes)
+	assert.False(t, ana.AnalysisResult.SummaryAnomaly.HasOverAdvertisedPrefixes)
+	assert.False(t, ana.AnalysisResult.SummaryAnomaly.HasMisconfiguredPrefixes)
+	assert.Empty(t, ana.AnalysisResult.SummaryAnomaly.MissingEntries)
+	assert.Empty(t, ana.AnalysisResult.SummaryAnomaly.SuperfluousEntries)
+	assert.Empty(t, ana.AnalysisResult.SummaryAnomaly.MisconfiguredEntries)
+
+	assert.False(t, ana.AnalysisResult.AsbrSummaryAnomaly.HasUnAdvertisedPrefixes)
+	assert.False(t, ana.AnalysisResult.AsbrSummaryAnomaly.HasOverAdvertisedPrefixes)
+	assert.False(t, ana.AnalysisResult.AsbrSummaryAnomaly.HasMisconfiguredPrefixes)
+}
+
+func TestSummaryAnomalyUnhappy(t *testing.T) {
+	ana, metrics := initAbrAnalyzer()
+
+	summaryData := getSummaryData("65.0.1.50", []summaryLsaEntry{
+		// range 10.1.0.0/16 is missing and its component leaks instead
+		{"0.0.0.0", "10.1.1.0", 24, 10},
+		// component of a not-advertise range
+		{"0.0.0.0", "10.2.0.0", 24, 10},
+		{"0.0.0.0", "10.5.0.0", 24, 10},
+		{"0.0.0.1", "10.0.50.0", 24, 10},
+		// wrong cost
+		{"0.0.0.1", "10.5.0.0", 24, 99},
+		{"0.0.0.2", "0.0.0.0", 0, 1},
+		// totally stubby area must only receive the default route
+		{"0.0.0.2", "10.0.50.0", 24, 10},
+	})
+	asbrSummaryData := getAsbrSummaryData("65.0.1.50", []summaryLsaEntry{
+		{"0.0.0.2", "65.0.1.60", 0, 10},
+	})
+
+	shouldSummary := ana.GetStaticFileSummaryData(metrics.StaticFrrConfiguration)
+	isSummary := analyzer.GetRuntimeSummaryDataSelf(summaryData, "r150", ana.Logger)
+	ana.SummaryAnomalyAnalysis(metrics.StaticFrrConfiguration.OspfConfig.AreaRange, shouldSummary, isSummary)
+
+	shouldAsbrSummary := ana.GetStaticFileAsbrSummaryData(metrics.StaticFrrConfiguration)
+	isAsbrSummary := analyzer.GetRuntimeAsbrSummaryDataSelf(asbrSummaryData, "r150", ana.Logger)
+	ana.AsbrSummaryAnomalyAnalysis(shouldAsbrSummary, isAsbrSummary)
+
+	t.Run("Summary", func(t *testing.T) {
+		result := ana.AnalysisResult.SummaryAnomaly
+		assert.True(t, result.HasUnAdvertisedPrefixes)
+		assert.True(t, result.HasOverAdvertisedPrefixes)
+		assert.True(t, result.HasMisconfiguredPrefixes)
+
+		assert.Equal(t, []string{"0.0.0.0:10.1.0.0/16"}, getAnomalyPrefixes(result.MissingEntries))
+		assert.Equal(t, []string{
+			"0.0.0.0:10.1.1.0/24",
+			"0.0.0.0:10.2.0.0/24",
+			"0.0.0.2:10.0.50.0/24",
+		}, getAnomalyPrefixes(result.SuperfluousEntries))
+		assert.Equal(t, []string{"0.0.0.1:10.5.0.0/24"}, getAnomalyPrefixes(result.MisconfiguredEntries))
+		assert.Equal(t, int32(99), result.MisconfiguredEntries[0].Metric)
+	})
+
+	t.Run("AsbrSummary", func(t *testing.T) {
+		result := ana.AnalysisResult.AsbrSummaryAnomaly
+		assert.True(t, result.HasUnAdvertisedPrefixes)
+		assert.True(t, result.HasOverAdvertisedPrefixes)
+
+		assert.Equal(t, []string{"0.0.0.0:65.0.1.60/32"}, getAnomalyPrefixes(result.MissingEntries))
+		assert.Equal(t, []string{"0.0.0.2:65.0.1.60/32"}, getAnomalyPrefixes(result.SuperfluousEntries))
+	})
+}
+
+func TestAsbrSummaryAnomalyCost(t *testing.T) {
+	ana, metrics := initAbrAnalyzer()
+
+	asbrSummaryData := getAsbrSummaryData("65.0.1.50", []summaryLsaEntry{
+		{"0.0.0.0", "65.0.1.60", 0, 40},
+	})
+
+	shouldAsbrSummary := ana.GetStaticFileAsbrSummaryData(metrics.StaticFrrConfiguration)
+	isAsbrSummary := analyzer.GetRuntimeAsbrSummaryDataSelf(asbrSummaryData, "r150", ana.Logger)
+	ana.AsbrSummaryAnomalyAnalysis(shouldAsbrSummary, isAsbrSummary)
+
+	result := ana.AnalysisResult.AsbrSummaryAnomaly
+	assert.False(t, result.HasUnAdvertisedPrefixes)
+	assert.True(t, result.HasMisconfiguredPrefixes)
+	assert.Equal(t, []string{"0.0.0.0:65.0.1.60/32"}, getAnomalyPrefixes(result.MisconfiguredEntries))
+	assert.Equal(t, int32(40), result.MisconfiguredEntries[0].Metric)
+}
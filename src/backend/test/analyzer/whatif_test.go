@@ -0,0 +1,68 @@
+package analyzer_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// getWhatIfData returns the data of a router with the given config and an empty LSDB
+func getWhatIfData(t *testing.T, configPath string) *frrProto.FullFRRData {
+	metrics, _, err := aggregator.LoadCapturedData(configPath, t.TempDir())
+	assert.NoError(t, err)
+	return metrics
+}
+
+func getWhatIfAnalyzer(t *testing.T) (*analyzer.Analyzer, *frrProto.StaticFRRConfiguration) {
+	_, appLogger, anomalyLogger := getMockData()
+	candidate := getWhatIfData(t, "./mock-files/r101_candidate.conf").StaticFrrConfiguration
+
+	return analyzer.InitAnalyzer(getWhatIfData(t, "./mock-files/r101.conf"), appLogger, anomalyLogger), candidate
+}
+
+func TestPredictChange(t *testing.T) {
+	ana, candidate := getWhatIfAnalyzer(t)
+
+	prediction := ana.PredictChange(candidate)
+
+	assert.Equal(t, []analyzer.ExpectedLsa{
+		{LsaType: "external", Prefix: "192.168.2.0/23", LinkType: "external"},
+		{LsaType: "nssa-external", Prefix: "192.168.2.0/23", LinkType: "nssa-external"},
+	}, prediction.AddedLsas)
+	assert.Len(t, prediction.RemovedLsas, 1)
+	assert.Equal(t, "router", prediction.RemovedLsas[0].LsaType)
+	assert.Equal(t, "10.0.19.0/24", prediction.RemovedLsas[0].Prefix)
+	assert.Equal(t, "0.0.0.0", prediction.RemovedLsas[0].Area)
+
+	assert.Len(t, prediction.IntroducedAnomalies, 1)
+	assert.Equal(t, "ExternalAnomaly:unadvertised:192.168.2.0/23:", prediction.IntroducedAnomalies[0].ID)
+	assert.Empty(t, prediction.ResolvedAnomalies)
+
+	// the live analysis is not touched by the prediction
+	assert.Empty(t, ana.AnalysisResult.ExternalAnomaly.MissingEntries)
+	assert.Empty(t, ana.AnalysisResult.Lifecycle)
+
+	var buffer bytes.Buffer
+	assert.NoError(t, prediction.Write(&buffer))
+	assert.Contains(t, buffer.String(), "  + external       192.168.2.0/23 (external)")
+	assert.Contains(t, buffer.String(), "  - router         10.0.19.0/24 area 0.0.0.0")
+	assert.Contains(t, buffer.String(), "2 LSAs added, 1 removed, 1 anomalies introduced, 0 resolved")
+}
+
+func TestPredictChangeResolved(t *testing.T) {
+	ana, candidate := getWhatIfAnalyzer(t)
+
+	// swapping running and candidate config turns introduced into resolved anomalies
+	metrics := getWhatIfData(t, "./mock-files/r101.conf")
+	running := metrics.StaticFrrConfiguration
+	metrics.StaticFrrConfiguration = candidate
+	prediction := analyzer.InitAnalyzer(metrics, ana.Logger, ana.AnomalyLogger).PredictChange(running)
+
+	assert.Empty(t, prediction.IntroducedAnomalies)
+	assert.Len(t, prediction.ResolvedAnomalies, 1)
+	assert.Equal(t, "ExternalAnomaly:unadvertised:192.168.2.0/23:", prediction.ResolvedAnomalies[0].ID)
+}
@@ -37,6 +37,11 @@ func TestConfigLoadingHappyPath(t *testing.T) {
 		assert.Equal(t, 5, config.Aggregator.PollInterval)
 		assert.Equal(t, "/var/run/frr", config.Aggregator.SocketPath)
 
+		assert.Equal(t, 1, config.Analyzer.HoldDownCycles)
+		if assert.Contains(t, config.Analyzer.Checks, "ecmp") {
+			assert.False(t, *config.Analyzer.Checks["ecmp"].Enabled)
+		}
+
 		assert.False(t, config.Exporter.OSPFRouterData)
 		assert.False(t, config.Exporter.OSPFNetworkData)
 		assert.False(t, config.Exporter.OSPFSummaryData)
@@ -314,3 +319,83 @@ numeric_array: [1, 2, 3, 4, 5]
 func cleanupIncompatibleYAMLFile(t *testing.T) {
 	os.Remove("mock-files/incompatible-config.yaml")
 }
+
+func TestLoadIntentFile(t *testing.T) {
+	t.Run("LoadIntentFile_success", func(t *testing.T) {
+		intent, err := configs.LoadIntentFile("mock-files/intent.yaml")
+		assert.NoError(t, err)
+		if !assert.NotNil(t, intent) {
+			return
+		}
+
+		assert.Equal(t, []configs.IntentPrefix{
+			{Prefix: "10.20.0.0/16", Area: "0.0.0.1"},
+			{Prefix: "10.30.0.0/24", LsaType: "external"},
+		}, intent.Prefixes.MustHave)
+		assert.Equal(t, []configs.IntentPrefix{
+			{Prefix: "192.168.0.0/16", LsaType: "external"},
+		}, intent.Prefixes.MustNotHave)
+		assert.Equal(t, []configs.IntentNeighbor{{RouterId: "65.0.1.2", Interface: "eth1"}}, intent.Neighbors)
+		assert.Equal(t, []configs.IntentArea{{Interface: "eth1", Area: "0.0.0.0"}}, intent.Areas)
+		assert.Equal(t, []configs.IntentLsaLimit{
+			{LsaType: "external", Max: 100},
+			{LsaType: "router", Area: "0.0.0.1", Max: 20},
+		}, intent.LsaLimits)
+	})
+
+	t.Run("LoadIntentFile_invalid", func(t *testing.T) {
+		intent, err := configs.LoadIntentFile("mock-files/invalid-intent.yaml")
+		assert.Nil(t, intent)
+		if !assert.Error(t, err) {
+			return
+		}
+		for _, setting := range []string{
+			`prefixes.musthave[0]: invalid prefix "10.20.0.0/33"`,
+			`prefixes.musthave[1]: unknown lsatype "type5"`,
+			`prefixes.mustnothave[0]: invalid area "backbone"`,
+			`lsalimits[0]: unknown lsatype "externals"`,
+			`lsalimits[1]: max must be at least 1`,
+		} {
+			assert.Contains(t, err.Error(), setting)
+		}
+		assert.NotContains(t, err.Error(), "areas[0]")
+	})
+
+	t.Run("LoadIntentFile_not_found", func(t *testing.T) {
+		intent, err := configs.LoadIntentFile("mock-files/missing-intent.yaml")
+		assert.Error(t, err)
+		assert.Nil(t, intent)
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	config, err := configs.LoadConfig("mock-files/main.yaml")
+	assert.NoError(t, err)
+	assert.NoError(t, config.Validate())
+
+	config.Default.DebugLevel = "verbose"
+	config.Aggregator.PollInterval = 0
+	config.Exporter.Port = 70000
+	config.Analyzer.Suppressions = []configs.SuppressionRule{
+		{Comment: "matches nothing"},
+		{Prefix: "10.0.0.0/33"},
+	}
+	config.Socket.Access = configs.AccessConfig{
+		DefaultRole: "guest",
+		Users:       map[string]string{"frr": "superuser"},
+	}
+
+	err = config.Validate()
+	assert.Error(t, err)
+	for _, setting := range []string{
+		"default.debuglevel",
+		"aggregator.pollinterval",
+		"exporter.Port",
+		"analyzer.suppressions[0]",
+		"analyzer.suppressions[1]",
+		"socket.access.defaultrole",
+		"socket.access.users.frr",
+	} {
+		assert.Contains(t, err.Error(), setting)
+	}
+}
@@ -16,7 +16,7 @@ func TestAnomalyExporter_NoAnomalies(t *testing.T) {
 	registry := prometheus.NewRegistry()
 	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
 	assert.NoError(t, err)
-	anomalies := &frrProto.AnomalyAnalysis{}
+	anomalies := withCheckResults(&frrProto.AnomalyAnalysis{})
 
 	exp := exporter.NewAnomalyExporter(anomalies, registry, testLogger)
 	exp.Update()
@@ -32,6 +32,8 @@ func TestAnomalyExporter_NoAnomalies(t *testing.T) {
 		"frr_mad_ospf_misconfigured_routes_total",
 		"frr_mad_rib_to_fib_anomalies_total",
 		"frr_mad_lsdb_to_rib_anomalies_total",
+		"frr_mad_ecmp_anomalies_total",
+		"frr_mad_intent_violations_total",
 	} {
 		val := getMetricValue(metrics, name)
 		assert.Equal(t, 0.0, val, "expected %s to be 0", name)
@@ -70,7 +72,7 @@ func TestAnomalyExporter_WithAnomalies(t *testing.T) {
 		},
 	}
 
-	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
 	exp.Update()
 
 	metrics, err := registry.Gather()
@@ -112,7 +114,7 @@ func TestAnomalyExporter_ConcurrentUpdates(t *testing.T) {
 		},
 	}
 
-	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
 
 	var wg sync.WaitGroup
 	for i := 0; i < 10; i++ {
@@ -176,7 +178,7 @@ func TestAnomalyExporter_ToggleAnomalies(t *testing.T) {
 		},
 	}
 
-	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
 	exp.Update()
 
 	// Verify anomaly is present
@@ -197,6 +199,13 @@ func TestAnomalyExporter_ToggleAnomalies(t *testing.T) {
 	assert.Equal(t, 0.0, getMetricValue(metrics, "frr_mad_ospf_overadvertised_routes_total"))
 	assert.Equal(t, 0.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_flags",
 		map[string]string{"source": "RouterAnomaly", "flag_type": "overadvertised"}))
+	// the detail series of the resolved anomaly is removed, only the default series remains
+	details := getMetricFamily(metrics, "frr_mad_anomaly_details")
+	if assert.NotNil(t, details) {
+		assert.Len(t, details.Metric, 1)
+		assert.Equal(t, -1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+			map[string]string{"interface_address": "10.0.0.1"}))
+	}
 }
 
 func TestAnomalyExporter_NoAnomalies_Existence(t *testing.T) {
@@ -204,7 +213,7 @@ func TestAnomalyExporter_NoAnomalies_Existence(t *testing.T) {
 	testLogger, err := logger.NewApplicationLogger("test", "/tmp/exporter_no_anom_exist.log")
 	assert.NoError(t, err)
 
-	anomalyResult := &frrProto.AnomalyAnalysis{}
+	anomalyResult := withCheckResults(&frrProto.AnomalyAnalysis{})
 	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
 	exp.Update()
 
@@ -221,6 +230,8 @@ func TestAnomalyExporter_NoAnomalies_Existence(t *testing.T) {
 		"frr_mad_ospf_misconfigured_routes_total":  dto.MetricType_GAUGE,
 		"frr_mad_rib_to_fib_anomalies_total":       dto.MetricType_GAUGE,
 		"frr_mad_lsdb_to_rib_anomalies_total":      dto.MetricType_GAUGE,
+		"frr_mad_ecmp_anomalies_total":             dto.MetricType_GAUGE,
+		"frr_mad_intent_violations_total":          dto.MetricType_GAUGE,
 	}
 
 	// Check for existence of each required metric
@@ -235,8 +246,8 @@ func TestAnomalyExporter_NoAnomalies_Existence(t *testing.T) {
 	// Check that all flag combinations exist when there are no anomalies
 	flagMetrics := getMetricFamily(metrics, "frr_mad_anomaly_flags")
 	if assert.NotNil(t, flagMetrics, "anomaly_flags metric should exist") {
-		// We should have 5 sources × 4 flag types = 20 metrics
-		assert.Equal(t, 20, len(flagMetrics.Metric),
+		// We should have 8 checks × 4 flag types = 32 metrics
+		assert.Equal(t, 32, len(flagMetrics.Metric),
 			"should have metrics for all source/flag combinations")
 
 		// All flags should be 0 as there are no anomalies
@@ -266,7 +277,7 @@ func TestAnomalyExporter_MixedAnomalies(t *testing.T) {
 		},
 	}
 
-	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
 	exp.Update()
 
 	metrics, err := registry.Gather()
@@ -299,6 +310,32 @@ func TestAnomalyExporter_MixedAnomalies(t *testing.T) {
 
 // Helper functions
 
+// withCheckResults publishes the findings of the compatibility fields as the results of the
+// built-in checks, like the analyzer does.
+func withCheckResults(analysis *frrProto.AnomalyAnalysis) *frrProto.AnomalyAnalysis {
+	for _, check := range []struct {
+		name     string
+		findings *frrProto.AnomalyDetection
+	}{
+		{"RouterAnomaly", analysis.RouterAnomaly},
+		{"ExternalAnomaly", analysis.ExternalAnomaly},
+		{"NssaExternalAnomaly", analysis.NssaExternalAnomaly},
+		{"SummaryAnomaly", analysis.SummaryAnomaly},
+		{"AsbrSummaryAnomaly", analysis.AsbrSummaryAnomaly},
+		{"LsdbToRib", analysis.LsdbToRibAnomaly},
+		{"Ecmp", analysis.EcmpAnomaly},
+		{"IntentAnomaly", analysis.IntentAnomaly},
+	} {
+		analysis.CheckResults = append(analysis.CheckResults, &frrProto.CheckResult{
+			Name:     check.name,
+			Enabled:  true,
+			Builtin:  true,
+			Findings: check.findings,
+		})
+	}
+	return analysis
+}
+
 func getMetricValue(metrics []*dto.MetricFamily, name string) float64 {
 	for _, mf := range metrics {
 		if mf.GetName() == name {
@@ -370,3 +407,256 @@ func getMetricValues(metrics []*dto.MetricFamily) map[string]float64 {
 
 	return result
 }
+
+func TestAnomalyExporter_Lifecycle(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		Lifecycle: []*frrProto.AnomalyLifecycle{
+			{
+				Id:              "RouterAnomaly:unadvertised:10.0.12.0/24:0.0.0.0",
+				Source:          "RouterAnomaly",
+				AnomalyType:     "unadvertised",
+				Prefix:          "10.0.12.0/24",
+				Area:            "0.0.0.0",
+				DurationSeconds: 120,
+				FlapCount:       2,
+				Active:          true,
+				Confirmed:       true,
+			},
+			{
+				Id:              "RouterAnomaly:unadvertised:10.0.13.0/24:0.0.0.0",
+				Source:          "RouterAnomaly",
+				AnomalyType:     "unadvertised",
+				Prefix:          "10.0.13.0/24",
+				Area:            "0.0.0.0",
+				DurationSeconds: 5,
+				Active:          true,
+			},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	labels := map[string]string{"source": "RouterAnomaly", "anomaly_type": "unadvertised", "prefix": "10.0.12.0/24", "area": "0.0.0.0"}
+	assert.Equal(t, 120.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_duration_seconds", labels))
+	assert.Equal(t, 2.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_flap_count", labels))
+
+	// anomalies within the hold-down are not exported
+	duration := getMetricFamily(metrics, "frr_mad_anomaly_duration_seconds")
+	if assert.NotNil(t, duration) {
+		assert.Len(t, duration.Metric, 1)
+	}
+}
+
+func TestAnomalyExporter_SeverityAndSuppression(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		RouterAnomaly: &frrProto.AnomalyDetection{
+			HasUnAdvertisedPrefixes: true,
+			MissingEntries: []*frrProto.Advertisement{
+				{InterfaceAddress: "10.0.0.1", Severity: "critical"},
+				{InterfaceAddress: "10.0.1.1", Severity: "critical", Suppressed: true},
+				{InterfaceAddress: "10.0.2.1", Severity: "critical", Acknowledged: true},
+			},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_severity",
+		map[string]string{"severity": "critical"}))
+	assert.Equal(t, 0.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_severity",
+		map[string]string{"severity": "warning"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+		map[string]string{"interface_address": "10.0.1.1", "severity": "critical", "suppressed": "true"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+		map[string]string{"interface_address": "10.0.2.1", "acknowledged": "true"}))
+}
+
+func TestAnomalyExporter_CheckResults(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		CheckResults: []*frrProto.CheckResult{
+			{Name: "RouterAnomaly", Enabled: true, Builtin: true, DurationMicroseconds: 1500},
+			{Name: "Ecmp", Builtin: true},
+			{
+				Name:                 "Loopback",
+				Enabled:              true,
+				DurationMicroseconds: 250,
+				Findings: &frrProto.AnomalyDetection{
+					HasUnAdvertisedPrefixes: true,
+					MissingEntries: []*frrProto.Advertisement{
+						{LinkStateId: "65.0.1.1", PrefixLength: "32", Severity: "critical"},
+					},
+				},
+			},
+			{Name: "Broken", Enabled: true, Error: "no data"},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(anomalyResult, registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 0.0015, getMetricValueWithLabels(metrics, "frr_mad_check_duration_seconds", map[string]string{"check": "RouterAnomaly"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_check_enabled", map[string]string{"check": "RouterAnomaly"}))
+	assert.Equal(t, 0.0, getMetricValueWithLabels(metrics, "frr_mad_check_enabled", map[string]string{"check": "Ecmp"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_check_failed", map[string]string{"check": "Broken"}))
+	assert.Equal(t, 0.0, getMetricValueWithLabels(metrics, "frr_mad_check_failed", map[string]string{"check": "Loopback"}))
+
+	// findings are published under the check name, whether the check is built in or not
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_flags", map[string]string{"source": "Loopback", "flag_type": "unadvertised"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details", map[string]string{
+		"source":        "Loopback",
+		"anomaly_type":  "unadvertised",
+		"link_state_id": "65.0.1.1",
+	}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_severity", map[string]string{"severity": "critical"}))
+}
+
+func TestAnomalyExporter_Areas(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		RouterAnomaly: &frrProto.AnomalyDetection{
+			HasUnAdvertisedPrefixes: true,
+			MissingEntries: []*frrProto.Advertisement{
+				{InterfaceAddress: "10.0.0.1", OspfArea: "0.0.0.0"},
+				{InterfaceAddress: "10.1.0.1", OspfArea: "0.0.0.1"},
+				{InterfaceAddress: "10.1.1.1", OspfArea: "0.0.0.1"},
+				{InterfaceAddress: "10.1.2.1", OspfArea: "0.0.0.1", Suppressed: true},
+			},
+		},
+		ExternalAnomaly: &frrProto.AnomalyDetection{
+			HasOverAdvertisedPrefixes: true,
+			SuperfluousEntries: []*frrProto.Advertisement{
+				{LinkStateId: "192.168.1.0", PrefixLength: "24"},
+			},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+		map[string]string{"interface_address": "10.1.0.1", "area": "0.0.0.1"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+		map[string]string{"link_state_id": "192.168.1.0", "area": "none"}))
+
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_area",
+		map[string]string{"area": "0.0.0.0", "anomaly_type": "unadvertised"}))
+	assert.Equal(t, 2.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_area",
+		map[string]string{"area": "0.0.0.1", "anomaly_type": "unadvertised"}), "suppressed anomalies are not counted")
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_area",
+		map[string]string{"area": "none", "anomaly_type": "overadvertised"}))
+
+	// resolved anomalies no longer count for their area
+	anomalyResult.RouterAnomaly.MissingEntries = anomalyResult.RouterAnomaly.MissingEntries[:1]
+	exp.Update()
+
+	metrics, err = registry.Gather()
+	assert.NoError(t, err)
+	assert.Equal(t, -1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_area",
+		map[string]string{"area": "0.0.0.1", "anomaly_type": "unadvertised"}))
+}
+
+func TestAnomalyExporter_ExplainedEntries(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		LsdbToRibAnomaly: &frrProto.AnomalyDetection{
+			HasUnAdvertisedPrefixes: true,
+			MissingEntries: []*frrProto.Advertisement{
+				{LinkStateId: "10.1.0.0", PrefixLength: "24", RouteSelection: "missing", Severity: "critical"},
+			},
+			ExplainedEntries: []*frrProto.Advertisement{
+				{LinkStateId: "10.2.0.0", PrefixLength: "24", RouteSelection: "shadowed", Severity: "info"},
+				{LinkStateId: "10.3.0.0", PrefixLength: "24", RouteSelection: "shadowed", Severity: "info"},
+			},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	// shadowed routes are explained deviations, no anomalies
+	assert.Equal(t, 1.0, getMetricValue(metrics, "frr_mad_lsdb_to_rib_anomalies_total"))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_area",
+		map[string]string{"area": "none", "anomaly_type": "unadvertised"}))
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details",
+		map[string]string{"source": "LsdbToRib", "link_state_id": "10.1.0.0"}))
+	for _, m := range getMetricFamily(metrics, "frr_mad_anomaly_details").Metric {
+		for _, label := range m.Label {
+			if label.GetName() == "link_state_id" {
+				assert.NotContains(t, []string{"10.2.0.0", "10.3.0.0"}, label.GetValue())
+			}
+		}
+	}
+	assert.Equal(t, 0.0, getMetricValueWithLabels(metrics, "frr_mad_anomalies_by_severity", map[string]string{"severity": "info"}))
+}
+
+func TestAnomalyExporter_ReasonCode(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	anomalyResult := &frrProto.AnomalyAnalysis{
+		RouterAnomaly: &frrProto.AnomalyDetection{
+			HasUnAdvertisedPrefixes: true,
+			MissingEntries: []*frrProto.Advertisement{
+				{
+					InterfaceAddress: "10.0.13.0",
+					OspfArea:         "0.0.0.0",
+					ReasonCode:       "interface_down",
+					Explanation:      "interface eth3 is operationally down",
+				},
+			},
+		},
+	}
+
+	exp := exporter.NewAnomalyExporter(withCheckResults(anomalyResult), registry, testLogger)
+	exp.Update()
+
+	metrics, err := registry.Gather()
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1.0, getMetricValueWithLabels(metrics, "frr_mad_anomaly_details", map[string]string{
+		"interface_address": "10.0.13.0",
+		"reason_code":       "interface_down",
+	}))
+	// the free-text explanation is no label, it would create a series per distinct text
+	for _, m := range getMetricFamily(metrics, "frr_mad_anomaly_details").Metric {
+		for _, label := range m.Label {
+			assert.NotEqual(t, "explanation", label.GetName())
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package exporter_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/exporter"
+	"github.com/frr-mad/frr-mad/src/backend/internal/socket"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/frr-mad/frr-mad/src/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func getAPIMockServer(t *testing.T) (*exporter.API, *socket.Socket, *httptest.Server) {
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	data := &frrProto.FullFRRData{
+		RoutingInformationBase: &frrProto.RoutingInformationBase{
+			Routes: map[string]*frrProto.RouteEntry{
+				"10.0.12.0/24":   {Routes: []*frrProto.Route{{Prefix: "10.0.12.0", PrefixLen: 24, Protocol: "ospf"}}},
+				"10.0.13.0/24":   {Routes: []*frrProto.Route{{Prefix: "10.0.13.0", PrefixLen: 24, Protocol: "connected"}}},
+				"192.168.1.0/24": {Routes: []*frrProto.Route{{Prefix: "192.168.1.0", PrefixLen: 24, Protocol: "ospf"}}},
+			},
+		},
+	}
+	analysis := &frrProto.AnomalyAnalysis{
+		Lifecycle: []*frrProto.AnomalyLifecycle{{Id: "RouterAnomaly:unadvertised:10.0.12.0/24:0.0.0.0"}},
+	}
+	socketInstance := socket.NewSocket(configs.SocketConfig{}, data, analysis, testLogger, &frrProto.ParsedAnalyzerData{})
+
+	api := exporter.NewAPI(socketInstance)
+	socketInstance.SetAcknowledgeHandler(api.NextGeneration)
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	return api, socketInstance, server
+}
+
+func getAPI(t *testing.T, url string, header map[string]string) (*http.Response, []byte) {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	for name, value := range header {
+		request.Header.Set(name, value)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, err)
+	return response, body
+}
+
+func TestAPI(t *testing.T) {
+	api, socketInstance, server := getAPIMockServer(t)
+
+	t.Run("TestFilteredRib", func(t *testing.T) {
+		response, body := getAPI(t, server.URL+"/api/v1/rib?prefix=10.0.0.0/8&limit=1", nil)
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+		assert.Equal(t, "2", response.Header.Get("X-Page-Total"))
+		assert.Equal(t, "1", response.Header.Get("X-Page-Next-Offset"))
+
+		rib := &frrProto.RoutingInformationBase{}
+		assert.NoError(t, protojson.Unmarshal(body, rib))
+		assert.Len(t, rib.Routes, 1)
+		assert.Contains(t, rib.Routes, "10.0.12.0/24")
+	})
+
+	t.Run("TestETag", func(t *testing.T) {
+		response, _ := getAPI(t, server.URL+"/api/v1/rib", nil)
+		etag := response.Header.Get("ETag")
+		assert.NotEmpty(t, etag)
+
+		response, body := getAPI(t, server.URL+"/api/v1/rib", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, response.StatusCode)
+		assert.Empty(t, body)
+
+		filtered, _ := getAPI(t, server.URL+"/api/v1/rib?protocol=ospf", nil)
+		assert.NotEqual(t, etag, filtered.Header.Get("ETag"), "filters change the body")
+
+		api.NextGeneration()
+		response, _ = getAPI(t, server.URL+"/api/v1/rib", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.NotEqual(t, etag, response.Header.Get("ETag"))
+	})
+
+	t.Run("TestETagAfterAcknowledge", func(t *testing.T) {
+		response, _ := getAPI(t, server.URL+"/api/v1/anomalies/lifecycle", nil)
+		etag := response.Header.Get("ETag")
+
+		ack := socketInstance.ProcessCommand(&frrProto.Message{
+			Service: "analysis",
+			Command: "acknowledge",
+			Params: map[string]*frrProto.ResponseValue{
+				"id": {Kind: &frrProto.ResponseValue_StringValue{StringValue: "RouterAnomaly:unadvertised:10.0.12.0/24:0.0.0.0"}},
+			},
+		})
+		assert.Equal(t, "success", ack.Status, ack.Message)
+
+		response, _ = getAPI(t, server.URL+"/api/v1/anomalies/lifecycle", map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusOK, response.StatusCode, "the acknowledgement changes the body")
+	})
+
+	t.Run("TestInvalidFilter", func(t *testing.T) {
+		response, body := getAPI(t, server.URL+"/api/v1/rib?prefix=10.0.0/8", nil)
+
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		var apiError map[string]string
+		assert.NoError(t, json.Unmarshal(body, &apiError))
+		assert.Contains(t, apiError["error"], "Invalid prefix")
+	})
+
+	t.Run("TestUnsupportedFilter", func(t *testing.T) {
+		response, _ := getAPI(t, server.URL+"/api/v1/anomalies?area=0.0.0.0", nil)
+
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	})
+
+	t.Run("TestUnknownEndpoint", func(t *testing.T) {
+		response, _ := getAPI(t, server.URL+"/api/v1/unknown", nil)
+
+		assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+
+	t.Run("TestMethodNotAllowed", func(t *testing.T) {
+		response, err := http.Post(server.URL+"/api/v1/rib", "application/json", nil)
+		assert.NoError(t, err)
+		response.Body.Close()
+
+		assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	})
+}
@@ -1133,3 +1133,46 @@ func TestMetricExporter_OutageSimulation(t *testing.T) {
 		"link_state_id": "172.16.0.0",
 	}))
 }
+
+func TestMetricExporter_Reconfigure(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	testLogger, err := logger.NewApplicationLogger("test", "/tmp/frrMadExporter.log")
+	assert.NoError(t, err)
+
+	data := &frrProto.FullFRRData{
+		OspfDatabase: &frrProto.OSPFDatabase{AsExternalCount: 3},
+		Interfaces: &frrProto.InterfaceList{
+			Interfaces: map[string]*frrProto.SingleInterface{
+				"eth0": {OperationalStatus: "Up", AdministrativeStatus: "Up", VrfName: "default"},
+			},
+		},
+	}
+	frrMadExporter := exporter.NewMetricExporter(data, registry, testLogger, configs.ExporterConfig{OSPFDatabase: true})
+	frrMadExporter.Update()
+
+	metricNames := func() []string {
+		metrics, err := registry.Gather()
+		assert.NoError(t, err)
+		var names []string
+		for _, metric := range metrics {
+			names = append(names, metric.GetName())
+		}
+		return names
+	}
+	assert.Equal(t, []string{"frr_mad_ospf_database_lsa_count"}, metricNames())
+
+	frrMadExporter.Reconfigure(configs.ExporterConfig{OSPFDatabase: true, InterfaceList: true})
+	frrMadExporter.Update()
+	assert.ElementsMatch(t, []string{
+		"frr_mad_ospf_database_lsa_count",
+		"frr_mad_interface_operational_status",
+		"frr_mad_interface_admin_status",
+	}, metricNames())
+
+	frrMadExporter.Reconfigure(configs.ExporterConfig{InterfaceList: true})
+	frrMadExporter.Update()
+	assert.ElementsMatch(t, []string{
+		"frr_mad_interface_operational_status",
+		"frr_mad_interface_admin_status",
+	}, metricNames())
+}
@@ -0,0 +1,90 @@
+package health_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthStates(t *testing.T) {
+	t.Run("TestFreshDaemonIsOK", func(t *testing.T) {
+		h := health.NewHealth(time.Minute)
+		h.ComponentStarted("aggregator")
+
+		status := h.Status()
+		assert.Equal(t, health.StateOK, status.State)
+		assert.Empty(t, status.Problems)
+		assert.Equal(t, health.ComponentRunning, status.Components[0].State)
+		assert.Zero(t, status.LastCollection)
+	})
+
+	t.Run("TestFailedCommandDegrades", func(t *testing.T) {
+		h := health.NewHealth(time.Minute)
+		h.CommandSucceeded("OSPFNeighbors", 20*time.Millisecond)
+		h.CommandFailed("OSPFNeighbors", errors.New("unexpected end of JSON input"), true)
+		h.CommandFailed("InterfaceStatus", errors.New("connection refused"), false)
+
+		status := h.Status()
+		assert.Equal(t, health.StateDegraded, status.State)
+		assert.Len(t, status.Problems, 2)
+		assert.Equal(t, uint64(1), status.ParseErrors)
+
+		neighbors := status.Commands[0]
+		assert.Equal(t, "OSPFNeighbors", neighbors.Name)
+		assert.Equal(t, int64(20000), neighbors.DurationMicroseconds)
+		assert.NotZero(t, neighbors.LastSuccess)
+		assert.Equal(t, uint64(1), neighbors.ParseErrors)
+		assert.Equal(t, uint64(0), status.Commands[1].ParseErrors)
+
+		// a later success clears the problem, the counters remain
+		h.CommandSucceeded("OSPFNeighbors", 10*time.Millisecond)
+		h.CommandSucceeded("InterfaceStatus", 10*time.Millisecond)
+		status = h.Status()
+		assert.Equal(t, health.StateOK, status.State)
+		assert.Equal(t, uint64(1), status.Commands[0].Failures)
+	})
+
+	t.Run("TestRestartingComponentFails", func(t *testing.T) {
+		h := health.NewHealth(time.Minute)
+		h.ComponentStarted("analyzer")
+		h.ComponentFailed("analyzer", errors.New("panic: nil map"))
+
+		status := h.Status()
+		assert.Equal(t, health.StateFailed, status.State)
+		assert.Equal(t, health.ComponentRestarting, status.Components[0].State)
+		assert.Equal(t, uint32(1), status.Components[0].Restarts)
+		assert.Equal(t, "panic: nil map", status.Components[0].LastError)
+
+		h.ComponentStarted("analyzer")
+		status = h.Status()
+		assert.Equal(t, health.StateOK, status.State)
+		assert.Equal(t, uint32(1), status.Components[0].Restarts)
+	})
+
+	t.Run("TestStaleCollectionFails", func(t *testing.T) {
+		h := health.NewHealth(10 * time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+
+		status := h.Status()
+		assert.Equal(t, health.StateFailed, status.State)
+		assert.Len(t, status.Problems, 2, "collection and analysis are stale")
+
+		h.CollectionCompleted(5 * time.Millisecond)
+		status = h.Status()
+		assert.Equal(t, health.StateDegraded, status.State)
+		assert.Equal(t, int64(5000), status.CollectionDurationMicroseconds)
+
+		h.AnalysisCompleted(time.Millisecond)
+		assert.Equal(t, health.StateOK, h.Status().State)
+	})
+
+	t.Run("TestNilHealth", func(t *testing.T) {
+		var h *health.Health
+		h.ComponentStarted("socket")
+		h.CommandFailed("Rib", errors.New("timeout"), false)
+		assert.Nil(t, h.Status())
+	})
+}
@@ -0,0 +1,105 @@
+package linter_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/aggregator"
+	"github.com/frr-mad/frr-mad/src/backend/internal/linter"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+)
+
+func getLintResult(t *testing.T, configPath string) *frrProto.LintResult {
+	config, err := aggregator.ParseStaticFRRConfig(configPath)
+	if err != nil {
+		t.Fatalf("ParseStaticFRRConfig failed: %v", err)
+	}
+	return linter.Lint(config)
+}
+
+func getFindingsByRule(result *frrProto.LintResult) map[string][]*frrProto.LintFinding {
+	findings := map[string][]*frrProto.LintFinding{}
+	for _, finding := range result.Findings {
+		findings[finding.RuleId] = append(findings[finding.RuleId], finding)
+	}
+	return findings
+}
+
+func TestLint(t *testing.T) {
+	result := getLintResult(t, "./mock-files/lint.conf")
+	findings := getFindingsByRule(result)
+
+	expected := map[string][]string{
+		"L001": {"route-map missing-acl", "route-map missing-pl"},
+		"L002": {"access-list unused", "prefix-list orphan"},
+		"L003": {"interface eth1"},
+		"L004": {"interface eth3"},
+		"L005": {"router ospf"},
+		"L006": {"interface eth4"},
+		"L007": {"area 0.0.0.3"},
+	}
+
+	for _, rule := range linter.Rules {
+		t.Run(rule.ID+"_"+rule.Name, func(t *testing.T) {
+			objects := expected[rule.ID]
+			if len(findings[rule.ID]) != len(objects) {
+				t.Fatalf("Expected %d findings for %s, got %d: %v", len(objects), rule.ID, len(findings[rule.ID]), findings[rule.ID])
+			}
+			for i, finding := range findings[rule.ID] {
+				if finding.Object != objects[i] {
+					t.Errorf("Expected finding on %s, got %s", objects[i], finding.Object)
+				}
+				if finding.Severity != rule.Severity || finding.RuleName != rule.Name {
+					t.Errorf("Expected severity %s and name %s, got %s and %s", rule.Severity, rule.Name, finding.Severity, finding.RuleName)
+				}
+			}
+		})
+	}
+
+	if msg := findings["L005"][0].Message; !strings.Contains(msg, "redistribute static") {
+		t.Errorf("Expected the redistribution without route-map to be static, got %q", msg)
+	}
+	if msg := findings["L003"][0].Message; !strings.Contains(msg, "10.0.1.129/25") {
+		t.Errorf("Expected the overlap with 10.0.1.129/25, got %q", msg)
+	}
+}
+
+func TestLintNilConfig(t *testing.T) {
+	result := linter.Lint(nil)
+	if len(result.Findings) != 0 {
+		t.Errorf("Expected no findings for a nil config, got %d", len(result.Findings))
+	}
+}
+
+func TestLintSeverity(t *testing.T) {
+	result := getLintResult(t, "./mock-files/lint.conf")
+
+	if !linter.ExceedsSeverity(result, linter.SeverityCritical) {
+		t.Error("Expected a critical finding")
+	}
+
+	result.Findings = getFindingsByRule(result)["L002"]
+	if linter.ExceedsSeverity(result, linter.SeverityWarning) {
+		t.Error("Expected info findings not to exceed warning")
+	}
+	if !linter.ExceedsSeverity(result, linter.SeverityInfo) {
+		t.Error("Expected info findings to exceed info")
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	result := getLintResult(t, "./mock-files/lint.conf")
+
+	var out bytes.Buffer
+	if err := linter.WriteText(&out, result); err != nil {
+		t.Fatalf("WriteText failed: %v", err)
+	}
+
+	if !strings.Contains(out.String(), "L006 warning  interface eth4: has ip ospf area 0.0.0.0 but no ip address") {
+		t.Errorf("Expected the L006 finding in the text output, got:\n%s", out.String())
+	}
+	if !strings.HasSuffix(out.String(), "9 findings\n") {
+		t.Errorf("Expected the finding count at the end, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,94 @@
+package socket_test
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/frr-mad/frr-mad/src/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccessControl(t *testing.T) {
+	uid := strconv.Itoa(os.Getuid())
+
+	t.Run("TestReadOnlyPeer", func(t *testing.T) {
+		auditPath := "/tmp/test-access-audit.log"
+		os.Remove(auditPath)
+		auditLogger, err := logger.NewApplicationLogger("test-audit", auditPath)
+		assert.NoError(t, err)
+
+		socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+			UnixSocketLocation: "/tmp",
+			UnixSocketName:     "test-access-readonly-socket",
+			Access:             configs.AccessConfig{Users: map[string]string{uid: "readonly"}},
+		})
+		socketInstance.SetAuditLogger(auditLogger)
+
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "exit"})
+		assert.NoError(t, err)
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "Permission denied: system exit requires the role admin")
+
+		response, err = sendRequest(socketPath, &frrProto.Message{Service: "analysis", Command: "acknowledge", Params: stringParams(map[string]string{"id": "router:1"})})
+		assert.NoError(t, err)
+		assert.Contains(t, response.Message, "Permission denied")
+
+		response, err = sendRequest(socketPath, &frrProto.Message{Service: "frr", Command: "rib"})
+		assert.NoError(t, err)
+		assert.Equal(t, "success", response.Status, "reading needs no elevated role")
+
+		audit, err := os.ReadFile(auditPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(audit), "Command denied")
+		assert.Contains(t, string(audit), `"uid":`+uid)
+		assert.Contains(t, string(audit), `"command":"exit"`)
+	})
+
+	t.Run("TestOperatorPeer", func(t *testing.T) {
+		_, socketPath := startTestSocket(t, configs.SocketConfig{
+			UnixSocketLocation: "/tmp",
+			UnixSocketName:     "test-access-operator-socket",
+			Access:             configs.AccessConfig{Users: map[string]string{uid: "operator"}},
+		})
+
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "analysis", Command: "acknowledge", Params: stringParams(map[string]string{"id": "router:1"})})
+		assert.NoError(t, err)
+		assert.NotContains(t, response.Message, "Permission denied")
+
+		response, err = sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "exit"})
+		assert.NoError(t, err)
+		assert.Contains(t, response.Message, "Permission denied")
+	})
+
+	t.Run("TestGrpcReadOnlyPeer", func(t *testing.T) {
+		_, client := startTestGrpc(t, configs.AccessConfig{Users: map[string]string{uid: "readonly"}})
+
+		_, err := client.AcknowledgeAnomaly(context.Background(), &frrProto.AcknowledgeRequest{Id: "router:1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = client.GetRib(context.Background(), &frrProto.Query{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestInvalidRuleIsSkipped", func(t *testing.T) {
+		_, socketPath := startTestSocket(t, configs.SocketConfig{
+			UnixSocketLocation: "/tmp",
+			UnixSocketName:     "test-access-invalid-socket",
+			Access: configs.AccessConfig{
+				DefaultRole: "readonly",
+				Users:       map[string]string{uid: "superuser"},
+			},
+		})
+
+		// the daemon user is admin without a valid rule
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "analysis", Command: "acknowledge", Params: stringParams(map[string]string{"id": "router:1"})})
+		assert.NoError(t, err)
+		assert.NotContains(t, response.Message, "Permission denied")
+	})
+}
@@ -1,16 +1,20 @@
 package socket_test
 
 import (
+	"context"
 	"encoding/binary"
+	"io"
 	"net"
 	"os"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/frr-mad/frr-mad/src/backend/internal/analyzer"
 	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
 	"github.com/frr-mad/frr-mad/src/backend/internal/socket"
 	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/frr-mad/frr-mad/src/logger"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
 )
@@ -290,3 +294,229 @@ func sendRequest(socketPath string, request *frrProto.Message) (*frrProto.Respon
 
 	return response, nil
 }
+
+func startTestSocket(t *testing.T, config configs.SocketConfig) (*socket.Socket, string) {
+	socketPath := config.UnixSocketLocation + "/" + config.UnixSocketName
+	os.Remove(socketPath)
+
+	mockLoggerInstance, mockAnalyzerInstance, mockFullFRRData, parsedAnalyzerData := getMockData()
+	socketInstance := socket.NewSocket(config, mockFullFRRData, mockAnalyzerInstance.AnalysisResult, mockLoggerInstance, parsedAnalyzerData)
+
+	go func() {
+		err := socketInstance.Start()
+		if err != nil {
+			t.Logf("Socket server returned error: %v", err)
+		}
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	t.Cleanup(socketInstance.Close)
+	return socketInstance, socketPath
+}
+
+func writeFrame(conn net.Conn, request *frrProto.Message) error {
+	requestData, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	sizeBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sizeBuf, uint32(len(requestData)))
+	if _, err := conn.Write(sizeBuf); err != nil {
+		return err
+	}
+	_, err = conn.Write(requestData)
+	return err
+}
+
+func readFrame(conn net.Conn) (*frrProto.Response, error) {
+	sizeBuf := make([]byte, 4)
+	if _, err := io.ReadFull(conn, sizeBuf); err != nil {
+		return nil, err
+	}
+
+	responseData := make([]byte, binary.LittleEndian.Uint32(sizeBuf))
+	if _, err := io.ReadFull(conn, responseData); err != nil {
+		return nil, err
+	}
+
+	response := &frrProto.Response{}
+	return response, proto.Unmarshal(responseData, response)
+}
+
+// TestPersistentConnection sends several requests over a single connection
+func TestPersistentConnection(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-persistent-socket",
+	})
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	for _, command := range []string{"database", "router", "neighbors"} {
+		assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "ospf", Command: command}))
+
+		response, err := readFrame(conn)
+		if assert.NoError(t, err, command) {
+			assert.Equal(t, "success", response.Status, command)
+		}
+	}
+}
+
+// TestIdleConnectionTimeout closes a persistent connection idling past the read timeout
+// without logging an error, as it is the expected disconnect of an idle client
+func TestIdleConnectionTimeout(t *testing.T) {
+	logPath := "/tmp/test-idle-connection.log"
+	os.Remove(logPath)
+	idleLogger, err := logger.NewApplicationLogger("test-idle", logPath)
+	assert.NoError(t, err)
+
+	socketPath := "/tmp/test-idle-socket"
+	os.Remove(socketPath)
+	socketInstance := socket.NewSocket(configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-idle-socket",
+		ReadTimeout:        1,
+	}, CreateMockFullFRRData(), &frrProto.AnomalyAnalysis{}, idleLogger, &frrProto.ParsedAnalyzerData{})
+	go socketInstance.Start()
+	t.Cleanup(socketInstance.Close)
+	time.Sleep(100 * time.Millisecond)
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	_, err = readFrame(conn)
+	assert.ErrorIs(t, err, io.EOF, "the idle connection is closed by the socket")
+
+	log, err := os.ReadFile(logPath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(log), "i/o timeout")
+	assert.NotContains(t, string(log), `"level":"ERROR"`)
+}
+
+// TestSlowClientDoesNotBlock ensures a client which never completes its request
+// doesn't delay the requests of other clients
+func TestSlowClientDoesNotBlock(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-slow-client-socket",
+	})
+
+	slowConn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer slowConn.Close()
+	_, err = slowConn.Write([]byte{0x10, 0x00})
+	assert.NoError(t, err)
+
+	done := make(chan *frrProto.Response, 1)
+	go func() {
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "allResources"})
+		if err != nil {
+			t.Logf("Request failed: %v", err)
+		}
+		done <- response
+	}()
+
+	select {
+	case response := <-done:
+		if assert.NotNil(t, response) {
+			assert.Equal(t, "success", response.Status)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Request was blocked by a slow client")
+	}
+}
+
+// TestMaxMessageSize rejects requests exceeding the configured size without reading them
+func TestMaxMessageSize(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-max-message-socket",
+		MaxMessageSize:     16,
+	})
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	sizeBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(sizeBuf, 1024)
+	_, err = conn.Write(sizeBuf)
+	assert.NoError(t, err)
+
+	response, err := readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "exceeds the maximum of 16 bytes")
+	}
+
+	_, err = readFrame(conn)
+	assert.Error(t, err, "the connection is closed after an oversized message")
+}
+
+// TestMaxConnections rejects clients while the worker pool is exhausted
+func TestMaxConnections(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-max-connections-socket",
+		MaxConnections:     1,
+	})
+
+	idleConn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+
+	response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "allResources"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "Too many connections")
+	}
+
+	idleConn.Close()
+	time.Sleep(50 * time.Millisecond)
+
+	response, err = sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "allResources"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "success", response.Status, "a slot is freed when a client disconnects")
+	}
+}
+
+// TestReadsDuringAnalysis reads and acknowledges anomalies while analysis cycles update
+// the analysis result, the race detector reports reads which aren't locked.
+func TestReadsDuringAnalysis(t *testing.T) {
+	mockLoggerInstance, _, mockFullFRRData, parsedAnalyzerData := getMockData()
+	mockFullFRRData.StaticFrrConfiguration = &frrProto.StaticFRRConfiguration{Hostname: "r101"}
+	detection := analyzer.InitAnalyzer(mockFullFRRData, mockLoggerInstance, mockLoggerInstance)
+	socketInstance := socket.NewSocket(configs.SocketConfig{}, mockFullFRRData, detection.AnalysisResult, mockLoggerInstance, parsedAnalyzerData)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		analyzer.RunAnalyzer(ctx, detection, time.Millisecond)
+		close(stopped)
+	}()
+
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		for _, command := range []string{"router", "lifecycle", "areas", "checks"} {
+			response := socketInstance.ProcessCommand(&frrProto.Message{Service: "analysis", Command: command})
+			assert.Equal(t, "success", response.Status, command)
+		}
+		response := socketInstance.ProcessCommand(&frrProto.Message{Service: "ospf", Command: "database"})
+		assert.Equal(t, "success", response.Status)
+
+		response = socketInstance.ProcessCommand(&frrProto.Message{
+			Service: "analysis",
+			Command: "acknowledge",
+			Params:  stringParams(map[string]string{"id": "RouterAnomaly:unadvertised:10.0.0.0/24:0.0.0.0"}),
+		})
+		assert.Equal(t, "error", response.Status, "the id is unknown to the lifecycle")
+	}
+
+	cancel()
+	<-stopped
+}
@@ -0,0 +1,123 @@
+package socket_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/socket"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func startTestGrpc(t *testing.T, access configs.AccessConfig) (*socket.Socket, frrProto.FrrMadClient) {
+	config := configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-grpc-socket",
+		GrpcSocketName:     "test-grpc-api-socket",
+		Access:             access,
+	}
+	socketInstance, _ := startTestSocket(t, config)
+
+	go func() {
+		if err := socketInstance.StartGrpc(); err != nil {
+			t.Logf("gRPC server returned error: %v", err)
+		}
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	conn, err := grpc.NewClient("unix:///tmp/test-grpc-api-socket", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return socketInstance, frrProto.NewFrrMadClient(conn)
+}
+
+func TestGrpc(t *testing.T) {
+	socketInstance, client := startTestGrpc(t, configs.AccessConfig{})
+	metrics := socketInstance.Metrics
+	metrics.RoutingInformationBase = getQueryMockRib()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.Run("TestGetHello", func(t *testing.T) {
+		hello, err := client.GetHello(ctx, &emptypb.Empty{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(frrProto.ProtocolVersion_PROTOCOL_VERSION_CURRENT), hello.GetProtocolVersion())
+	})
+
+	t.Run("TestGetRibWithQuery", func(t *testing.T) {
+		var header metadata.MD
+		rib, err := client.GetRib(ctx, &frrProto.Query{Prefix: "10.0.0.0/8", Limit: 1}, grpc.Header(&header))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, countRoutes(rib))
+		assert.Equal(t, []string{"4"}, header.Get("page-total"))
+		assert.Equal(t, []string{"1"}, header.Get("page-next-offset"))
+	})
+
+	t.Run("TestInvalidQuery", func(t *testing.T) {
+		_, err := client.GetRib(ctx, &frrProto.Query{Area: "0.0.0.0"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "Parameter area is not supported")
+	})
+
+	t.Run("TestUnknownAnomalySource", func(t *testing.T) {
+		_, err := client.GetAnomalies(ctx, &frrProto.AnomaliesRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TestGetAnomaliesOfCheck", func(t *testing.T) {
+		socketInstance.Anomalies.CheckResults = []*frrProto.CheckResult{
+			{Name: "RouterAnomaly", Enabled: true, Builtin: true, Findings: &frrProto.AnomalyDetection{HasUnAdvertisedPrefixes: true}},
+			{Name: "Loopback", Enabled: true, Findings: &frrProto.AnomalyDetection{HasDuplicatePrefixes: true}},
+		}
+
+		byName, err := client.GetAnomalies(ctx, &frrProto.AnomaliesRequest{Check: "Loopback"})
+		assert.NoError(t, err)
+		assert.True(t, byName.GetHasDuplicatePrefixes())
+
+		bySource, err := client.GetAnomalies(ctx, &frrProto.AnomaliesRequest{Source: frrProto.AnomalySource_ANOMALY_SOURCE_ROUTER})
+		assert.NoError(t, err)
+		assert.True(t, bySource.GetHasUnAdvertisedPrefixes())
+
+		_, err = client.GetAnomalies(ctx, &frrProto.AnomaliesRequest{Check: "unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TestWatchOspfNeighbors", func(t *testing.T) {
+		stream, err := client.WatchOspfNeighbors(ctx, &frrProto.Query{})
+		assert.NoError(t, err)
+
+		initial, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NotContains(t, initial.GetNeighbors(), "65.0.1.2")
+
+		metrics.OspfNeighbors = &frrProto.OSPFNeighbors{
+			Neighbors: map[string]*frrProto.NeighborList{
+				"65.0.1.2": {Neighbors: []*frrProto.Neighbor{{IfaceName: "eth1:10.0.12.1", State: "Full/DR"}}},
+			},
+		}
+		socketInstance.Publish()
+
+		changed, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Contains(t, changed.GetNeighbors(), "65.0.1.2")
+	})
+
+	t.Run("TestSocketFileCreated", func(t *testing.T) {
+		_, err := os.Stat("/tmp/test-grpc-api-socket")
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,44 @@
+package socket_test
+
+import (
+	"testing"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHello(t *testing.T) {
+	s := getEmptyMockSocket()
+	s.Metrics = CreateMockFullFRRData()
+	s.SetDaemonInfo("1.2.3", []string{"intent"})
+
+	response := s.ProcessCommand(&frrProto.Message{Service: "system", Command: "hello"})
+
+	assert.Equal(t, "success", response.Status)
+	hello := response.Data.GetHello()
+	assert.Equal(t, int32(frrProto.ProtocolVersion_PROTOCOL_VERSION_CURRENT), hello.ProtocolVersion)
+	assert.Equal(t, "1.2.3", hello.DaemonVersion)
+	assert.Equal(t, []string{"query", "subscribe", "intent"}, hello.Features)
+
+	services := map[string][]string{}
+	for _, service := range hello.Services {
+		services[service.Service] = service.Commands
+	}
+	assert.ElementsMatch(t, []string{"analysis", "frr", "ospf", "subscribe", "system"}, mapKeys(services))
+	assert.Contains(t, services["subscribe"], "anomalies")
+	assert.Contains(t, services["system"], "hello")
+
+	// every advertised command is served by the socket
+	for service, commands := range services {
+		if service == "subscribe" {
+			continue
+		}
+		for _, command := range commands {
+			if command == "exit" {
+				continue
+			}
+			response := s.ProcessCommand(&frrProto.Message{Service: service, Command: command})
+			assert.NotContains(t, response.Message, "Unknown command", "%s/%s", service, command)
+		}
+	}
+}
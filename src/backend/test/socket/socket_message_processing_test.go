@@ -78,7 +78,7 @@ func TestMessageProcessing(t *testing.T) {
 		response := sendRequestAndGetResponse(t, request, "/tmp/test-message-socket")
 
 		assert.Equal(t, "error", response.Status)
-		assert.Equal(t, "There was an error getting system resources", response.Message)
+		assert.Equal(t, "Unknown command: foobar", response.Message)
 	})
 
 	t.Run("TestInvalidCommand", func(t *testing.T) {
@@ -102,7 +102,7 @@ func TestMessageProcessing(t *testing.T) {
 		response := sendRequestAndGetResponse(t, request, "/tmp/test-message-socket")
 
 		assert.Equal(t, "error", response.Status)
-		assert.Contains(t, response.Message, "There was an error getting system resources")
+		assert.Contains(t, response.Message, "Unknown command: foo")
 
 	})
 
@@ -330,7 +330,7 @@ func TestFrrUnhappyPath(t *testing.T) {
 
 		assert.Equal(t, "success", response.Status)
 		assert.Equal(t, "Returning FRR meta data of router itself", response.Message)
-		assert.Nil(t, response.Data.GetFrrRouterData())
+		assert.Empty(t, response.Data.GetFrrRouterData())
 		assert.NotNil(t, response.Data)
 	})
 
@@ -0,0 +1,202 @@
+package socket_test
+
+import (
+	"strconv"
+	"testing"
+
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringParams(params map[string]string) map[string]*frrProto.ResponseValue {
+	result := map[string]*frrProto.ResponseValue{}
+	for name, value := range params {
+		result[name] = &frrProto.ResponseValue{Kind: &frrProto.ResponseValue_StringValue{StringValue: value}}
+	}
+	return result
+}
+
+func getQueryMockRib() *frrProto.RoutingInformationBase {
+	return &frrProto.RoutingInformationBase{
+		Routes: map[string]*frrProto.RouteEntry{
+			"10.0.12.0/24": {Routes: []*frrProto.Route{
+				{Prefix: "10.0.12.0", PrefixLen: 24, Protocol: "ospf", Distance: 110},
+				{Prefix: "10.0.12.0", PrefixLen: 24, Protocol: "connected", Selected: true},
+			}},
+			"10.0.13.0/24":   {Routes: []*frrProto.Route{{Prefix: "10.0.13.0", PrefixLen: 24, Protocol: "ospf", Selected: true}}},
+			"10.1.0.0/16":    {Routes: []*frrProto.Route{{Prefix: "10.1.0.0", PrefixLen: 16, Protocol: "static", Selected: true}}},
+			"192.168.1.0/24": {Routes: []*frrProto.Route{{Prefix: "192.168.1.0", PrefixLen: 24, Protocol: "ospf", Selected: true}}},
+		},
+	}
+}
+
+func countRoutes(rib *frrProto.RoutingInformationBase) int {
+	count := 0
+	for _, entry := range rib.GetRoutes() {
+		count += len(entry.Routes)
+	}
+	return count
+}
+
+func TestQueryParams(t *testing.T) {
+	s := getEmptyMockSocket()
+	s.Metrics = CreateMockFullFRRData()
+	s.Metrics.RoutingInformationBase = getQueryMockRib()
+	s.Metrics.OspfDatabase.Areas["0.0.0.1"] = &frrProto.OSPFDatabaseArea{
+		RouterLinkStates: []*frrProto.RouterDataLSA{
+			{Base: &frrProto.BaseLSA{LsId: "192.168.1.2", AdvertisedRouter: "192.168.1.2"}},
+		},
+		SummaryLinkStates: []*frrProto.SummaryDataLSA{
+			{Base: &frrProto.BaseLSA{LsId: "10.0.12.0", AdvertisedRouter: "192.168.1.1"}, SummaryAddress: "10.0.12.0/24"},
+		},
+	}
+
+	t.Run("TestPrefixContainment", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: "rib", Params: stringParams(map[string]string{
+			"prefix": "10.0.0.0/8",
+		})})
+
+		assert.Equal(t, "success", response.Status, response.Message)
+		rib := response.Data.GetRoutingInformationBase()
+		assert.ElementsMatch(t, []string{"10.0.12.0/24", "10.0.13.0/24", "10.1.0.0/16"}, mapKeys(rib.GetRoutes()))
+		assert.Equal(t, int32(4), response.Page.Total)
+	})
+
+	t.Run("TestProtocol", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: "rib", Params: stringParams(map[string]string{
+			"prefix":   "10.0.0.0/8",
+			"protocol": "OSPF",
+		})})
+
+		rib := response.Data.GetRoutingInformationBase()
+		assert.Equal(t, 2, countRoutes(rib))
+		assert.Len(t, rib.Routes["10.0.12.0/24"].Routes, 1)
+		assert.Equal(t, "ospf", rib.Routes["10.0.12.0/24"].Routes[0].Protocol)
+	})
+
+	t.Run("TestPagination", func(t *testing.T) {
+		var prefixes []string
+		offset := "0"
+		for pages := 0; pages < 5; pages++ {
+			response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: "rib", Params: stringParams(map[string]string{
+				"offset": offset,
+				"limit":  "2",
+			})})
+			assert.Equal(t, "success", response.Status, response.Message)
+			assert.Equal(t, int32(5), response.Page.Total)
+			assert.LessOrEqual(t, countRoutes(response.Data.GetRoutingInformationBase()), 2)
+
+			for prefix, entry := range response.Data.GetRoutingInformationBase().GetRoutes() {
+				for range entry.Routes {
+					prefixes = append(prefixes, prefix)
+				}
+			}
+			if response.Page.NextOffset == 0 {
+				break
+			}
+			offset = strconv.Itoa(int(response.Page.NextOffset))
+		}
+
+		assert.ElementsMatch(t, []string{"10.0.12.0/24", "10.0.12.0/24", "10.0.13.0/24", "10.1.0.0/16", "192.168.1.0/24"}, prefixes)
+	})
+
+	t.Run("TestFieldMask", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: "rib", Params: stringParams(map[string]string{
+			"prefix": "10.1.0.0/16",
+			"fields": "prefix,prefixLen",
+		})})
+
+		route := response.Data.GetRoutingInformationBase().Routes["10.1.0.0/16"].Routes[0]
+		assert.Equal(t, "10.1.0.0", route.Prefix)
+		assert.Equal(t, int32(16), route.PrefixLen)
+		assert.Empty(t, route.Protocol)
+		assert.False(t, route.Selected)
+	})
+
+	t.Run("TestDatabaseFilters", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "ospf", Command: "database", Params: stringParams(map[string]string{
+			"area":    "1",
+			"lsaType": "summary",
+			"fields":  "base.ls_id,summary_address",
+		})})
+
+		assert.Equal(t, "success", response.Status, response.Message)
+		database := response.Data.GetOspfDatabase()
+		assert.Equal(t, []string{"0.0.0.1"}, mapKeys(database.GetAreas()))
+		assert.Empty(t, database.Areas["0.0.0.1"].RouterLinkStates)
+		assert.Empty(t, database.AsExternalLinkStates)
+
+		lsa := database.Areas["0.0.0.1"].SummaryLinkStates[0]
+		assert.Equal(t, "10.0.12.0", lsa.Base.LsId)
+		assert.Empty(t, lsa.Base.AdvertisedRouter)
+		assert.Equal(t, "10.0.12.0/24", lsa.SummaryAddress)
+	})
+
+	t.Run("TestAdvertisingRouter", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "ospf", Command: "database", Params: stringParams(map[string]string{
+			"advertisingRouter": "192.168.1.2",
+		})})
+
+		database := response.Data.GetOspfDatabase()
+		assert.Equal(t, []string{"0.0.0.1"}, mapKeys(database.GetAreas()))
+		assert.Len(t, database.Areas["0.0.0.1"].RouterLinkStates, 1)
+		assert.Empty(t, database.Areas["0.0.0.1"].SummaryLinkStates)
+	})
+
+	t.Run("TestSharedDataUnchanged", func(t *testing.T) {
+		assert.Len(t, s.Metrics.OspfDatabase.Areas, 2)
+		assert.Equal(t, 5, countRoutes(s.Metrics.RoutingInformationBase))
+		assert.Equal(t, "192.168.1.1", s.Metrics.OspfDatabase.Areas["0.0.0.1"].SummaryLinkStates[0].Base.AdvertisedRouter)
+	})
+
+	t.Run("TestWithoutParams", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: "rib"})
+
+		assert.Nil(t, response.Page)
+		assert.Equal(t, 5, countRoutes(response.Data.GetRoutingInformationBase()))
+	})
+}
+
+func TestQueryParamsUnhappyPath(t *testing.T) {
+	s := getEmptyMockSocket()
+	s.Metrics = CreateMockFullFRRData()
+
+	tests := map[string]struct {
+		command string
+		params  map[string]string
+		message string
+	}{
+		"unknown param":     {"rib", map[string]string{"foo": "bar"}, "Unknown parameter: foo"},
+		"unsupported param": {"rib", map[string]string{"area": "0.0.0.0"}, "Parameter area is not supported by this command"},
+		"invalid prefix":    {"rib", map[string]string{"prefix": "10.0.0/8"}, "Invalid prefix"},
+		"negative limit":    {"rib", map[string]string{"limit": "-1"}, "Parameter limit must be a non-negative number"},
+		"unknown field":     {"rib", map[string]string{"fields": "prefix,foo"}, "Unknown field: foo"},
+		"no pagination":     {"routerData", map[string]string{"limit": "1"}, "Parameter limit is not supported by this command"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			response := s.ProcessCommand(&frrProto.Message{Service: "frr", Command: tt.command, Params: stringParams(tt.params)})
+
+			assert.Equal(t, "error", response.Status)
+			assert.Contains(t, response.Message, tt.message)
+		})
+	}
+
+	t.Run("TestUnknownLsaType", func(t *testing.T) {
+		response := s.ProcessCommand(&frrProto.Message{Service: "ospf", Command: "database", Params: stringParams(map[string]string{
+			"lsaType": "opaque",
+		})})
+
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "Unknown lsa type: opaque")
+	})
+}
+
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
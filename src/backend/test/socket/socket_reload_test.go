@@ -0,0 +1,60 @@
+package socket_test
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReload(t *testing.T) {
+	t.Run("TestWithoutHandler", func(t *testing.T) {
+		s := getEmptyMockSocket()
+
+		response := s.ProcessCommand(&frrProto.Message{Service: "system", Command: "reload"})
+		assert.Equal(t, "error", response.Status)
+	})
+
+	t.Run("TestHandlerResult", func(t *testing.T) {
+		s := getEmptyMockSocket()
+		s.SetReloadHandler(func() (string, error) {
+			return "Configuration reloaded, nothing changed", nil
+		})
+
+		response := s.ProcessCommand(&frrProto.Message{Service: "system", Command: "reload"})
+		assert.Equal(t, "success", response.Status)
+		assert.Equal(t, "Configuration reloaded, nothing changed", response.Message)
+
+		s.SetReloadHandler(func() (string, error) {
+			return "", errors.New("Invalid configuration, nothing was applied")
+		})
+		response = s.ProcessCommand(&frrProto.Message{Service: "system", Command: "reload"})
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "nothing was applied")
+	})
+
+	t.Run("TestAccessChangesLive", func(t *testing.T) {
+		uid := strconv.Itoa(os.Getuid())
+		socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+			UnixSocketLocation: "/tmp",
+			UnixSocketName:     "test-reload-access-socket",
+		})
+		socketInstance.SetReloadHandler(func() (string, error) {
+			return "Configuration reloaded", nil
+		})
+
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "reload"})
+		assert.NoError(t, err)
+		assert.Equal(t, "success", response.Status)
+
+		socketInstance.SetAccess(configs.AccessConfig{Users: map[string]string{uid: "operator"}})
+
+		response, err = sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "reload"})
+		assert.NoError(t, err)
+		assert.Contains(t, response.Message, "Permission denied: system reload requires the role admin")
+	})
+}
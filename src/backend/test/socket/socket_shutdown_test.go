@@ -1,12 +1,15 @@
 package socket_test
 
 import (
+	"context"
 	"net"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
 	"github.com/frr-mad/frr-mad/src/backend/internal/socket"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,3 +48,87 @@ func TestSocketShutdown(t *testing.T) {
 	assert.Error(t, err, "Connection should fail after socket is closed")
 
 }
+
+// TestSocketRun serves until the context is canceled and can be run again afterwards
+func TestSocketRun(t *testing.T) {
+	config := configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-run-socket",
+		GrpcSocketName:     "test-run-grpc-socket",
+	}
+	socketPath := config.UnixSocketLocation + "/" + config.UnixSocketName
+
+	mockLoggerInstance, mockAnalyzerInstance, mockMetrics, parsedAnalyzerdata := getMockData()
+	socketInstance := socket.NewSocket(config, mockMetrics, mockAnalyzerInstance.AnalysisResult, mockLoggerInstance, parsedAnalyzerdata)
+
+	for run := 0; run < 2; run++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- socketInstance.Run(ctx)
+		}()
+		time.Sleep(100 * time.Millisecond)
+
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "ospf", Command: "neighbors"})
+		assert.NoError(t, err)
+		assert.Equal(t, "success", response.Status)
+
+		cancel()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("Run did not return after the context was canceled")
+		}
+
+		_, err = os.Stat(socketPath)
+		assert.True(t, os.IsNotExist(err), "Socket file should be removed after Run returned")
+	}
+}
+
+// TestExitCommand hands the shutdown to the handler instead of exiting the process
+func TestExitCommand(t *testing.T) {
+	socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-exit-socket",
+	})
+
+	shutdown := make(chan struct{})
+	socketInstance.SetShutdownHandler(func() { close(shutdown) })
+
+	response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "exit"})
+	assert.NoError(t, err)
+	assert.Equal(t, "success", response.Status)
+
+	select {
+	case <-shutdown:
+	case <-time.After(time.Second):
+		t.Fatal("exit command did not call the shutdown handler")
+	}
+}
+
+// TestExitCommandWithoutHandler closes the socket once the exit request is answered, without
+// waiting for the drain timeout
+func TestExitCommandWithoutHandler(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-exit-fallback-socket",
+		DrainTimeout:       5,
+	})
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	start := time.Now()
+	assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "system", Command: "exit"}))
+	response, err := readFrame(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "success", response.Status)
+
+	// the connection is closed when Close completed
+	conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	_, err = readFrame(conn)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 2*time.Second, "Close waited for the exit request to drain")
+}
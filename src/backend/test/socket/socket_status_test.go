@@ -0,0 +1,54 @@
+package socket_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestStatus(t *testing.T) {
+	t.Run("TestWithoutHealth", func(t *testing.T) {
+		s := getEmptyMockSocket()
+
+		response := s.ProcessCommand(&frrProto.Message{Service: "system", Command: "status"})
+		assert.Equal(t, "error", response.Status)
+	})
+
+	t.Run("TestSocketClients", func(t *testing.T) {
+		socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+			UnixSocketLocation: "/tmp",
+			UnixSocketName:     "test-status-socket",
+		})
+		h := health.NewHealth(time.Minute)
+		h.ComponentStarted("socket")
+		h.CommandFailed("OSPFNeighbors", errors.New("timeout"), false)
+		socketInstance.SetHealth(h)
+		socketInstance.SetDaemonInfo("1.2.3", nil)
+
+		response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "status"})
+		assert.NoError(t, err)
+		assert.Equal(t, "success", response.Status)
+
+		status := response.Data.GetHealthStatus()
+		assert.Equal(t, health.StateDegraded, status.State)
+		assert.Equal(t, "1.2.3", status.DaemonVersion)
+		assert.Equal(t, "socket", status.Components[0].Name)
+		assert.Equal(t, int32(1), status.SocketClients.Connections, "the requesting client")
+		assert.Equal(t, uint64(1), status.SocketClients.Served)
+	})
+
+	t.Run("TestGrpcStatus", func(t *testing.T) {
+		_, client := startTestGrpc(t, configs.AccessConfig{})
+
+		// the test socket does not track its health
+		_, err := client.GetStatus(context.Background(), &emptypb.Empty{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,159 @@
+package socket_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/configs"
+	frrProto "github.com/frr-mad/frr-mad/src/backend/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func subscribe(t *testing.T, socketPath, topics string) net.Conn {
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+
+	assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "subscribe", Command: topics}))
+	response, err := readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "success", response.Status, response.Message)
+	}
+	return conn
+}
+
+// TestSubscription pushes the current state of every topic and later only changed topics
+func TestSubscription(t *testing.T) {
+	socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-subscription-socket",
+	})
+
+	conn := subscribe(t, socketPath, "anomalies,neighbors")
+	defer conn.Close()
+
+	for _, topic := range []string{"anomalies", "neighbors"} {
+		frame, err := readFrame(conn)
+		if assert.NoError(t, err) {
+			assert.Equal(t, topic, frame.Topic)
+			assert.Equal(t, "success", frame.Status)
+		}
+	}
+
+	// nothing changed, nothing is pushed
+	socketInstance.Publish()
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(200*time.Millisecond)))
+	_, err := readFrame(conn)
+	assert.Error(t, err, "unchanged topics are not pushed")
+
+	socketInstance.Metrics.OspfNeighbors = &frrProto.OSPFNeighbors{
+		Neighbors: map[string]*frrProto.NeighborList{
+			"65.0.1.2": {Neighbors: []*frrProto.Neighbor{{IfaceName: "eth1:10.0.12.1", State: "Full/DR"}}},
+		},
+	}
+	socketInstance.Publish()
+
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	frame, err := readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "neighbors", frame.Topic)
+		assert.Contains(t, frame.Data.GetOspfNeighbors().GetNeighbors(), "65.0.1.2")
+	}
+}
+
+// TestSubscriptionUnknownTopic rejects the subscription, the connection keeps serving requests
+func TestSubscriptionUnknownTopic(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-subscription-topic-socket",
+	})
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "subscribe", Command: "anomalies,unknown"}))
+	response, err := readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "Unknown topic")
+	}
+
+	assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "system", Command: "allResources"}))
+	response, err = readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "success", response.Status)
+	}
+}
+
+// TestSubscriptionSlowClient publishes without waiting for a subscriber which doesn't read,
+// the subscriber receives the latest state once it reads again
+func TestSubscriptionSlowClient(t *testing.T) {
+	socketInstance, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-subscription-slow-socket",
+	})
+
+	conn := subscribe(t, socketPath, "neighbors")
+	defer conn.Close()
+
+	// frames larger than the socket buffer block a writer until the client reads
+	neighbors := func(state string) *frrProto.OSPFNeighbors {
+		list := &frrProto.NeighborList{}
+		for i := 0; i < 20000; i++ {
+			list.Neighbors = append(list.Neighbors, &frrProto.Neighbor{IfaceName: fmt.Sprintf("eth%d", i), State: state})
+		}
+		return &frrProto.OSPFNeighbors{Neighbors: map[string]*frrProto.NeighborList{"65.0.1.2": list}}
+	}
+
+	start := time.Now()
+	for _, state := range []string{"Init", "2-Way", "ExStart", "Exchange", "Full/DR"} {
+		socketInstance.Metrics.OspfNeighbors = neighbors(state)
+		socketInstance.Publish()
+	}
+	// a blocked write would hold up every publish for the write timeout of 10s
+	assert.Less(t, time.Since(start), 5*time.Second, "publishing does not wait for the subscriber")
+
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	for {
+		frame, err := readFrame(conn)
+		if !assert.NoError(t, err) {
+			return
+		}
+		if list := frame.Data.GetOspfNeighbors().GetNeighbors()["65.0.1.2"].GetNeighbors(); len(list) > 0 && list[0].State == "Full/DR" {
+			break
+		}
+	}
+}
+
+// TestSubscriptionLimit keeps subscribers out of the worker pool, they are capped separately
+func TestSubscriptionLimit(t *testing.T) {
+	_, socketPath := startTestSocket(t, configs.SocketConfig{
+		UnixSocketLocation: "/tmp",
+		UnixSocketName:     "test-subscription-limit-socket",
+		MaxConnections:     1,
+		MaxSubscribers:     1,
+	})
+
+	subscription := subscribe(t, socketPath, "anomalies")
+	defer subscription.Close()
+
+	response, err := sendRequest(socketPath, &frrProto.Message{Service: "system", Command: "hello"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "success", response.Status, "a subscriber does not take the only worker")
+	}
+	// the worker of the request is given back once its connection is closed
+	time.Sleep(100 * time.Millisecond)
+
+	conn, err := net.Dial("unix", socketPath)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	assert.NoError(t, writeFrame(conn, &frrProto.Message{Service: "subscribe", Command: "neighbors"}))
+	response, err = readFrame(conn)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "error", response.Status)
+		assert.Contains(t, response.Message, "Too many subscriptions")
+	}
+}
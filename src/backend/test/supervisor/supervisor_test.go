@@ -0,0 +1,157 @@
+package supervisor_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/frr-mad/frr-mad/src/backend/internal/health"
+	"github.com/frr-mad/frr-mad/src/backend/internal/supervisor"
+	"github.com/frr-mad/frr-mad/src/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestSupervisor(t *testing.T) *supervisor.Supervisor {
+	testLogger, err := logger.NewApplicationLogger("test-supervisor", "/tmp/frrMadSupervisor.log")
+	assert.NoError(t, err)
+
+	s := supervisor.NewSupervisor(testLogger)
+	s.RestartDelay = 10 * time.Millisecond
+	s.MaxRestartDelay = 50 * time.Millisecond
+	s.StopTimeout = time.Second
+	return s
+}
+
+// blocking returns a component which records its start and stop until its context is canceled
+func blocking(name string, events chan<- string) supervisor.Component {
+	return supervisor.Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			events <- "start " + name
+			<-ctx.Done()
+			events <- "stop " + name
+			return nil
+		},
+	}
+}
+
+func TestSupervisorStopsInReverseOrder(t *testing.T) {
+	s := getTestSupervisor(t)
+	events := make(chan string, 10)
+	s.Add(blocking("aggregator", events))
+	s.Add(blocking("socket", events))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	started := []string{<-events, <-events}
+	assert.ElementsMatch(t, []string{"start aggregator", "start socket"}, started)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, "stop socket", <-events)
+	assert.Equal(t, "stop aggregator", <-events)
+}
+
+func TestSupervisorRestartsFailedComponent(t *testing.T) {
+	s := getTestSupervisor(t)
+
+	var runs atomic.Int32
+	restarted := make(chan struct{})
+	var once sync.Once
+	s.Add(supervisor.Component{
+		Name: "analyzer",
+		Run: func(ctx context.Context) error {
+			switch runs.Add(1) {
+			case 1:
+				return errors.New("collection failed")
+			case 2:
+				panic("nil map")
+			}
+			once.Do(func() { close(restarted) })
+			<-ctx.Done()
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	select {
+	case <-restarted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("component was not restarted after an error and a panic")
+	}
+	assert.Equal(t, int32(3), runs.Load())
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestSupervisorStopTimeout(t *testing.T) {
+	s := getTestSupervisor(t)
+	s.StopTimeout = 50 * time.Millisecond
+	s.Add(supervisor.Component{
+		Name: "exporter",
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(time.Second)
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := s.Run(ctx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "exporter")
+}
+
+func TestSupervisorReportsHealth(t *testing.T) {
+	s := getTestSupervisor(t)
+	s.Health = health.NewHealth(time.Minute)
+
+	var runs atomic.Int32
+	running := make(chan struct{})
+	s.Add(supervisor.Component{
+		Name: "exporter",
+		Run: func(ctx context.Context) error {
+			if runs.Add(1) == 1 {
+				return errors.New("address already in use")
+			}
+			close(running)
+			<-ctx.Done()
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Run(ctx)
+	}()
+
+	select {
+	case <-running:
+	case <-time.After(2 * time.Second):
+		t.Fatal("component was not restarted")
+	}
+	component := s.Health.Status().Components[0]
+	assert.Equal(t, health.ComponentRunning, component.State)
+	assert.Equal(t, uint32(1), component.Restarts)
+	assert.Equal(t, "address already in use", component.LastError)
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.Equal(t, health.ComponentStopped, s.Health.Status().Components[0].State)
+}
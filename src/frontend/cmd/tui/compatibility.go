@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/frr-mad/frr-mad/src/frontend/internal/common"
+	backend "github.com/frr-mad/frr-mad/src/frontend/internal/services"
+	frrProto "github.com/frr-mad/frr-mad/src/frontend/pkg"
+)
+
+// pageCommands are the socket commands a page needs, pages are hidden if the daemon lacks one of them
+var pageCommands = map[common.AppState][]string{
+	ViewOSPFMonitoring: {"ospf/database", "ospf/router", "ospf/network", "ospf/summary", "ospf/asbrSummary",
+		"ospf/externalData", "ospf/nssaExternalData", "ospf/neighbors", "ospf/peerMap", "ospf/staticConfig"},
+	ViewRIB: {"frr/rib", "frr/ribfibSummary"},
+}
+
+// checkDaemonCompatibility asks the daemon for its protocol version and capabilities. It returns
+// an error if the daemon speaks another protocol version or doesn't know the handshake, and hides
+// pages the daemon cannot serve. An unreachable daemon is not checked, the pages report the
+// missing connection themselves.
+func (m *AppModel) checkDaemonCompatibility() error {
+	tuiProtocol := int32(frrProto.ProtocolVersion_PROTOCOL_VERSION_CURRENT)
+
+	hello, err := backend.GetHello(m.logger)
+	if err != nil && !isUnknownCommandError(err) {
+		m.logger.Warning(fmt.Sprintf("Cannot check the compatibility of frr-mad-analyzer: %v", err))
+		return nil
+	}
+	if err != nil || hello == nil {
+		return fmt.Errorf("frr-mad-analyzer is older than frr-mad-tui %s and does not announce its protocol version, "+
+			"frr-mad-tui requires protocol version %d: install matching versions of frr-mad-analyzer and frr-mad-tui",
+			TUIVersion, tuiProtocol)
+	}
+
+	if hello.ProtocolVersion != tuiProtocol {
+		return fmt.Errorf("frr-mad-analyzer %s speaks protocol version %d, but frr-mad-tui %s requires version %d: "+
+			"install matching versions of frr-mad-analyzer and frr-mad-tui",
+			hello.DaemonVersion, hello.ProtocolVersion, TUIVersion, tuiProtocol)
+	}
+	if hello.DaemonVersion != "" {
+		DaemonVersion = hello.DaemonVersion
+	}
+
+	supported := map[string]bool{}
+	for _, service := range hello.Services {
+		for _, command := range service.Commands {
+			supported[service.Service+"/"+command] = true
+		}
+	}
+
+	m.activeViews = slices.DeleteFunc(m.activeViews, func(view common.AppState) bool {
+		for _, command := range pageCommands[view] {
+			if !supported[command] {
+				m.logger.Warning(fmt.Sprintf("Hiding page %s, frr-mad-analyzer %s does not support %s",
+					m.pageTitle(view), hello.DaemonVersion, command))
+				return true
+			}
+		}
+		return false
+	})
+
+	return nil
+}
+
+// unknownCommandAnswers are the answers of daemons without the handshake to system/hello
+var unknownCommandAnswers = []string{"Unknown command", "There was an error getting system resources"}
+
+// isUnknownCommandError reports whether the daemon answered that it doesn't know the command,
+// in contrast to a request which didn't reach the daemon.
+func isUnknownCommandError(err error) bool {
+	return slices.ContainsFunc(unknownCommandAnswers, func(answer string) bool {
+		return strings.Contains(err.Error(), "backend error: "+answer)
+	})
+}
+
+func (m *AppModel) pageTitle(view common.AppState) string {
+	for _, page := range []common.PageInterface{m.dashboard, m.ospf, m.rib, m.shell} {
+		if page.GetAppState() == view {
+			return page.GetPageInfo().Title
+		}
+	}
+	return fmt.Sprintf("%d", view)
+}
@@ -4,11 +4,19 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/frr-mad/frr-mad/src/frontend/internal/common"
 	"github.com/frr-mad/frr-mad/src/frontend/internal/configs"
+	"github.com/frr-mad/frr-mad/src/frontend/internal/services"
 )
 
+// subscriptionTopics are pushed by the backend whenever their data changes
+var subscriptionTopics = []string{"anomalies", "lifecycle", "neighbors", "interfaces", "lsdb", "rib"}
+
+const subscriptionRetryInterval = 10 * time.Second
+
 // maybeUpdateTERM updates the environment variable 'TERM' to 'xterm-256color'
 // if necessary before program start.
 func maybeUpdateTERM() {
@@ -100,3 +108,27 @@ func (m *AppModel) indexOfAppState(state common.AppState) int {
 	}
 	return 0
 }
+
+// subscribeBackend subscribes to the pushed updates of the backend. Without a subscription
+// the views keep polling in their usual interval and the subscription is retried later.
+func (m *AppModel) subscribeBackend() tea.Cmd {
+	updates, cancel, err := backend.Subscribe(subscriptionTopics, m.logger)
+	if err != nil {
+		m.logger.Error(fmt.Sprintf("Error subscribing to backend updates: %v", err))
+		return common.RetryBackendSubscribe(subscriptionRetryInterval)
+	}
+
+	m.updates = updates
+	m.cancelUpdates = cancel
+	m.dashboard.SetPushUpdates(true)
+	return common.WaitForBackendUpdate(updates)
+}
+
+func (m *AppModel) unsubscribeBackend() {
+	if m.cancelUpdates != nil {
+		m.cancelUpdates()
+	}
+	m.updates = nil
+	m.cancelUpdates = nil
+	m.dashboard.SetPushUpdates(false)
+}
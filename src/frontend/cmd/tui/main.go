@@ -16,6 +16,7 @@ import (
 	"github.com/frr-mad/frr-mad/src/frontend/internal/pages/shell"
 	"github.com/frr-mad/frr-mad/src/frontend/internal/ui/components"
 	"github.com/frr-mad/frr-mad/src/frontend/internal/ui/styles"
+	frrProto "github.com/frr-mad/frr-mad/src/frontend/pkg"
 	"github.com/frr-mad/frr-mad/src/logger"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -60,6 +61,9 @@ type AppModel struct {
 	footer            *components.Footer
 	footerOptions     []common.FooterOption
 	textFilter        *common.Filter
+	updates           <-chan *frrProto.Response
+	cancelUpdates     func()
+	exitError         error
 	logger            *logger.Logger
 }
 
@@ -111,6 +115,12 @@ func initModel(config *configs.Config) *AppModel {
 }
 
 func (m *AppModel) Init() tea.Cmd {
+	if err := m.checkDaemonCompatibility(); err != nil {
+		m.logger.Error(err.Error())
+		m.exitError = err
+		return tea.Quit
+	}
+
 	m.setTitles()
 	startupConfig, err := m.setStartupConfig()
 	if err != nil {
@@ -126,6 +136,7 @@ func (m *AppModel) Init() tea.Cmd {
 
 	return tea.Batch(
 		m.dashboard.Init(),
+		m.subscribeBackend(),
 	)
 }
 
@@ -236,6 +247,7 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.footer.SetMainMenuOptions()
 			}
 		case "ctrl+c":
+			m.unsubscribeBackend()
 			currentConfig, err := common.GetRunningConfig(m.logger)
 			if err != nil {
 				m.logger.Error(fmt.Sprintf("Error fetching OSPF Running-Config: %v", err))
@@ -259,6 +271,16 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	//	return m, nil
 	//case tea.MouseButton:
 	//	return m, nil
+	case common.BackendUpdateMsg:
+		if msg.Closed {
+			m.logger.Info("Backend subscription ended, falling back to polling")
+			m.unsubscribeBackend()
+			return m, common.RetryBackendSubscribe(subscriptionRetryInterval)
+		}
+		// the view fetches the fresh data when it is rendered again
+		return m, common.WaitForBackendUpdate(m.updates)
+	case common.BackendSubscribeMsg:
+		return m, m.subscribeBackend()
 	case tea.WindowSizeMsg:
 		m.windowSize.Width = msg.Width
 		m.windowSize.Height = msg.Height
@@ -399,8 +421,13 @@ func startFrrMadTui() {
 	// TODO: find a way to fix the TUI that you cant scroll away (in apple terminal)
 	// TODO: the problem with mouseMotion is, you cannot highlight text anymore with the mouse
 	// p := tea.NewProgram(initModel(), tea.WithMouseCellMotion()) // start program with msg.MouseMsg options
-	if _, err := p.Run(); err != nil {
+	model, err := p.Run()
+	if err != nil {
 		fmt.Printf("Error running program: %v\n", err)
 		os.Exit(1)
 	}
+	if appModel, ok := model.(*AppModel); ok && appModel.exitError != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", appModel.exitError)
+		os.Exit(1)
+	}
 }
@@ -3,6 +3,7 @@ package common
 import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	frrProto "github.com/frr-mad/frr-mad/src/frontend/pkg"
 	"log"
 	"net"
 	"net/netip"
@@ -137,3 +138,31 @@ func QuitTuiFailedCmd(reason string) tea.Cmd {
 		return QuitTuiFailedMsg(reason)
 	}
 }
+
+// BackendUpdateMsg is sent for every frame the backend pushes to a subscription.
+// Closed is set once the subscription ended.
+type BackendUpdateMsg struct {
+	Topic  string
+	Closed bool
+}
+
+// BackendSubscribeMsg requests a new subscription after the previous one ended.
+type BackendSubscribeMsg struct{}
+
+// WaitForBackendUpdate waits for the next frame pushed to the subscription.
+func WaitForBackendUpdate(updates <-chan *frrProto.Response) tea.Cmd {
+	return func() tea.Msg {
+		response, ok := <-updates
+		if !ok {
+			return BackendUpdateMsg{Closed: true}
+		}
+		return BackendUpdateMsg{Topic: response.Topic}
+	}
+}
+
+// RetryBackendSubscribe requests a new subscription after the delay.
+func RetryBackendSubscribe(delay time.Duration) tea.Cmd {
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return BackendSubscribeMsg{}
+	})
+}
@@ -35,6 +35,28 @@ func HasAnyAnomaly(a *frrProto.AnomalyDetection) bool {
 		a.HasMisconfiguredPrefixes
 }
 
+// HasActionableAnomaly reports anomalies which are neither suppressed, acknowledged nor only informational
+func HasActionableAnomaly(a *frrProto.AnomalyDetection) bool {
+	if !HasAnyAnomaly(a) {
+		return false
+	}
+
+	for _, entries := range [][]*frrProto.Advertisement{
+		a.SuperfluousEntries,
+		a.MissingEntries,
+		a.DuplicateEntries,
+		a.MisconfiguredEntries,
+	} {
+		for _, entry := range entries {
+			if !entry.Suppressed && !entry.Acknowledged && entry.Severity != "info" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func PrintBackendError(err error, functionName string) string {
 	return fmt.Sprintf(
 		"Error: \n%v\n\nNo data received from backend for '%s()'. Press 'r' to reload...",
@@ -15,6 +15,12 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+const (
+	reloadInterval = time.Second
+	// pushReloadInterval is the fallback reload while pushed updates re-render the view
+	pushReloadInterval = 30 * time.Second
+)
+
 type Model struct {
 	appState           common.AppState
 	title              string
@@ -40,6 +46,7 @@ type Model struct {
 	statusSeverity     styles.StatusSeverity
 	statusTimer        time.Time
 	statusDuration     time.Duration
+	pushUpdates        bool
 	logger             *logger.Logger
 }
 
@@ -103,28 +110,29 @@ func (m *Model) GetFooterOptions() common.FooterOption {
 	}
 }
 
-func reloadView() tea.Cmd {
-	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+// SetPushUpdates slows down the periodic reload while the backend pushes changes.
+func (m *Model) SetPushUpdates(active bool) {
+	m.pushUpdates = active
+}
+
+func (m *Model) reloadView() tea.Cmd {
+	interval := reloadInterval
+	if m.pushUpdates {
+		interval = pushReloadInterval
+	}
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return common.ReloadMessage(t)
 	})
 }
 
 func (m *Model) detectAnomaly() {
-	ospfRouterAnomalies, _ := backend.GetRouterAnomalies(m.logger)
-	ospfExternalAnomalies, _ := backend.GetExternalAnomalies(m.logger)
-	ospfNSSAExternalAnomalies, _ := backend.GetNSSAExternalAnomalies(m.logger)
-	ospfLSDBToRibAnomalies, _ := backend.GetLSDBToRibAnomalies(m.logger)
-	ribToFibAnomalies, _ := backend.GetRibToFibAnomalies(m.logger)
-
-	if common.HasAnyAnomaly(ospfRouterAnomalies) ||
-		common.HasAnyAnomaly(ospfExternalAnomalies) ||
-		common.HasAnyAnomaly(ospfNSSAExternalAnomalies) ||
-		common.HasAnyAnomaly(ospfLSDBToRibAnomalies) ||
-		common.HasAnyAnomaly(ribToFibAnomalies) {
-
-		m.hasAnomalyDetected = true
-	} else {
-		m.hasAnomalyDetected = false
+	checkResults, _ := backend.GetCheckResults(m.logger)
+
+	m.hasAnomalyDetected = false
+	for _, check := range checkResults.GetCheckResults() {
+		if check.Enabled && common.HasActionableAnomaly(check.Findings) {
+			m.hasAnomalyDetected = true
+		}
 	}
 }
 
@@ -162,6 +170,24 @@ func (m *Model) fetchLatestData() error {
 			filename: "type7_nssa_anomalies.json",
 			fetch:    func() (proto.Message, error) { return backend.GetNSSAExternalAnomalies(m.logger) },
 		},
+		{
+			key:      "GetCheckResults",
+			label:    "analysis checks – status, duration and findings",
+			filename: "check_results.json",
+			fetch:    func() (proto.Message, error) { return backend.GetCheckResults(m.logger) },
+		},
+		{
+			key:      "GetAreaAnomalies",
+			label:    "anomalies – grouped by ospf area",
+			filename: "area_anomalies.json",
+			fetch:    func() (proto.Message, error) { return backend.GetAreaAnomalies(m.logger) },
+		},
+		{
+			key:      "GetAnomalyLifecycle",
+			label:    "anomaly lifecycle – first seen, duration and flaps",
+			filename: "anomaly_lifecycle.json",
+			fetch:    func() (proto.Message, error) { return backend.GetAnomalyLifecycle(m.logger) },
+		},
 		{
 			key:      "GetOSPF",
 			label:    "summary of the current OSPF router",
@@ -201,6 +227,6 @@ func (m *Model) Init() tea.Cmd {
 	m.detectAnomaly()
 	return tea.Batch(
 		common.FetchOSPFData(m.logger),
-		reloadView(),
+		m.reloadView(),
 	)
 }
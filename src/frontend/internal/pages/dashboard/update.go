@@ -160,7 +160,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case common.ReloadMessage:
 		m.currentTime = time.Time(msg)
-		return m, reloadView()
+		return m, m.reloadView()
 
 	case common.QuitTuiFailedMsg:
 		m.statusSeverity = styles.SeverityError
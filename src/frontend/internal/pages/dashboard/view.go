@@ -1,7 +1,9 @@
 package dashboard
 
 import (
+	"encoding/binary"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 	"time"
@@ -585,181 +587,68 @@ func (m *Model) getOspfDashboardLsdbSelf() string {
 }
 
 func (m *Model) getDashboardAnomalies() string {
-	ospfRouterAnomalies, err := backend.GetRouterAnomalies(m.logger)
+	checkResults, err := backend.GetCheckResults(m.logger)
 	if err != nil {
-		m.statusMessage = "Failed to fetch router anomalies"
+		m.statusMessage = "Failed to fetch check results"
 		m.statusSeverity = styles.SeverityError
-		return common.PrintBackendError(err, "GetRouterAnomalies")
+		return common.PrintBackendError(err, "GetCheckResults")
 	}
 
-	ospfExternalAnomalies, err := backend.GetExternalAnomalies(m.logger)
+	areaAnomalies, err := backend.GetAreaAnomalies(m.logger)
 	if err != nil {
-		m.statusMessage = "Failed to fetch external anomalies"
+		m.statusMessage = "Failed to fetch anomalies by area"
 		m.statusSeverity = styles.SeverityError
-		return common.PrintBackendError(err, "GetExternalAnomalies")
+		return common.PrintBackendError(err, "GetAreaAnomalies")
 	}
 
-	ospfNSSAExternalAnomalies, err := backend.GetNSSAExternalAnomalies(m.logger)
+	anomalyLifecycle, err := backend.GetAnomalyLifecycle(m.logger)
 	if err != nil {
-		m.statusMessage = "Failed to fetch NSSA external anomalies"
+		m.statusMessage = "Failed to fetch anomaly lifecycle"
 		m.statusSeverity = styles.SeverityError
-		return common.PrintBackendError(err, "GetNSSAExternalAnomalies")
+		return common.PrintBackendError(err, "GetAnomalyLifecycle")
 	}
 
-	ospfLSDBToRibAnomalies, err := backend.GetLSDBToRibAnomalies(m.logger)
-	if err != nil {
-		m.statusMessage = "Failed to fetch LSDB to Rib anomalies"
-		m.statusSeverity = styles.SeverityError
-		return common.PrintBackendError(err, "GetLSDBToRibAnomalies")
-	}
-
-	ribToFibAnomalies, err := backend.GetRibToFibAnomalies(m.logger)
-	if err != nil {
-		m.statusMessage = "Failed to fetch Rib to Fib anomalies"
-		m.statusSeverity = styles.SeverityError
-		return common.PrintBackendError(err, "GetRibToFibAnomalies")
+	// prevents printing empty strings
+	var allAnomaliesList []string
+	if areaTable := createAreaTable(areaAnomalies, m.textFilter.Query); areaTable != "" {
+		allAnomaliesList = append(allAnomaliesList, areaTable)
 	}
 
+	// one table per check with findings, in the order of the analysis
 	totalAnomalies := 0
-	if common.HasAnyAnomaly(ospfRouterAnomalies) {
-		totalAnomalies += countAnomalies(ospfRouterAnomalies)
-	}
-	if common.HasAnyAnomaly(ospfExternalAnomalies) {
-		totalAnomalies += countAnomalies(ospfExternalAnomalies)
-	}
-	if common.HasAnyAnomaly(ospfNSSAExternalAnomalies) {
-		totalAnomalies += countAnomalies(ospfNSSAExternalAnomalies)
-	}
-	if common.HasAnyAnomaly(ospfLSDBToRibAnomalies) {
-		totalAnomalies += countAnomalies(ospfLSDBToRibAnomalies)
-	}
-	if common.HasAnyAnomaly(ribToFibAnomalies) {
-		totalAnomalies += countAnomalies(ribToFibAnomalies)
-	}
-
-	var routerAnomalyTable string
-	var routerAnomalyCount int
-	if common.HasAnyAnomaly(ospfRouterAnomalies) {
-		routerAnomalyCount = countAnomalies(ospfRouterAnomalies)
-		routerAnomalyTable = createAnomalyTable(
-			ospfRouterAnomalies,
-			"Router Anomalies (Type 1 LSAs)",
-			m.textFilter.Query,
-		)
-
-		m.logger.WithAttrs(map[string]interface{}{
-			"anomaly_type":         "Router (Type 1)",
-			"count":                routerAnomalyCount,
-			"has_under_advertised": ospfRouterAnomalies.HasUnAdvertisedPrefixes,
-			"has_over_advertised":  ospfRouterAnomalies.HasOverAdvertisedPrefixes,
-			"has_duplicates":       ospfRouterAnomalies.HasDuplicatePrefixes,
-		}).Warning("Router anomalies detected")
-	}
-
-	var externalAnomalyTable string
-	var externalAnomalyCount int
-	if common.HasAnyAnomaly(ospfExternalAnomalies) {
-		externalAnomalyCount = countAnomalies(ospfExternalAnomalies)
-		externalAnomalyTable = createAnomalyTable(
-			ospfExternalAnomalies,
-			"External Link State Anomalies (Type 5 LSAs)",
-			m.textFilter.Query,
-		)
-
-		m.logger.WithAttrs(map[string]interface{}{
-			"anomaly_type":         "External (Type 5)",
-			"count":                externalAnomalyCount,
-			"has_under_advertised": ospfExternalAnomalies.HasUnAdvertisedPrefixes,
-			"has_over_advertised":  ospfExternalAnomalies.HasOverAdvertisedPrefixes,
-			"has_duplicates":       ospfExternalAnomalies.HasDuplicatePrefixes,
-		}).Warning("External anomalies detected")
-	}
-
-	var nssaExternalAnomalyTable string
-	var nssaAnomalyCount int
-	if common.HasAnyAnomaly(ospfNSSAExternalAnomalies) {
-		nssaAnomalyCount = countAnomalies(ospfNSSAExternalAnomalies)
-		nssaExternalAnomalyTable = createAnomalyTable(
-			ospfNSSAExternalAnomalies,
-			"NSSA External Link State Anomalies (Type 7 LSAs)",
-			m.textFilter.Query,
-		)
-
-		m.logger.WithAttrs(map[string]interface{}{
-			"anomaly_type":         "NSSA External (Type 7)",
-			"count":                nssaAnomalyCount,
-			"has_under_advertised": ospfNSSAExternalAnomalies.HasUnAdvertisedPrefixes,
-			"has_over_advertised":  ospfNSSAExternalAnomalies.HasOverAdvertisedPrefixes,
-			"has_duplicates":       ospfNSSAExternalAnomalies.HasDuplicatePrefixes,
-		}).Warning("NSSA External anomalies detected")
-	}
-
-	var lsdbToRibAnomalyTable string
-	var lsdbToRibAnomalyCount int
-	if common.HasAnyAnomaly(ospfLSDBToRibAnomalies) {
-		lsdbToRibAnomalyCount = countAnomalies(ospfLSDBToRibAnomalies)
-		lsdbToRibAnomalyTable = createAnomalyTable(
-			ospfLSDBToRibAnomalies,
-			"Deviation from the LSDB and RIB",
-			m.textFilter.Query,
-		)
+	anomalyCounts := map[string]interface{}{}
+	for _, check := range checkResults.GetCheckResults() {
+		if !check.Enabled || !common.HasAnyAnomaly(check.Findings) {
+			continue
+		}
 
-		m.logger.WithAttrs(map[string]interface{}{
-			"anomaly_type":         "LSDB To RIB",
-			"count":                lsdbToRibAnomalyCount,
-			"has_under_advertised": ospfLSDBToRibAnomalies.HasUnAdvertisedPrefixes,
-			"has_over_advertised":  ospfLSDBToRibAnomalies.HasOverAdvertisedPrefixes,
-			"has_duplicates":       ospfLSDBToRibAnomalies.HasDuplicatePrefixes,
-		}).Warning("LSDB to RIB anomalies detected")
-	}
-
-	var ribToFibAnomalyTable string
-	var ribToFibAnomalyCount int
-	if common.HasAnyAnomaly(ribToFibAnomalies) {
-		ribToFibAnomalyCount = countAnomalies(ribToFibAnomalies)
-		ribToFibAnomalyTable = createAnomalyTable(
-			ribToFibAnomalies,
-			"Deviation from the RIB and FIB",
+		count := countAnomalies(check.Findings)
+		totalAnomalies += count
+		anomalyCounts[check.Name] = count
+		allAnomaliesList = append(allAnomaliesList, createAnomalyTable(
+			check.Findings,
+			checkTitle(check),
 			m.textFilter.Query,
-		)
+		))
 
 		m.logger.WithAttrs(map[string]interface{}{
-			"anomaly_type":         "RIB To FIB",
-			"count":                ribToFibAnomalyCount,
-			"has_under_advertised": ribToFibAnomalies.HasUnAdvertisedPrefixes,
-			"has_over_advertised":  ribToFibAnomalies.HasOverAdvertisedPrefixes,
-			"has_duplicates":       ribToFibAnomalies.HasDuplicatePrefixes,
-		}).Warning("RIB to FIB anomalies detected")
+			"anomaly_type":         check.Name,
+			"count":                count,
+			"has_under_advertised": check.Findings.HasUnAdvertisedPrefixes,
+			"has_over_advertised":  check.Findings.HasOverAdvertisedPrefixes,
+			"has_duplicates":       check.Findings.HasDuplicatePrefixes,
+			"has_misconfigured":    check.Findings.HasMisconfiguredPrefixes,
+		}).Warning("Check anomalies detected")
 	}
 
-	// prevents printing empty strings
-	var allAnomaliesList []string
-	if routerAnomalyTable != "" {
-		allAnomaliesList = append(allAnomaliesList, routerAnomalyTable)
-	}
-	if externalAnomalyTable != "" {
-		allAnomaliesList = append(allAnomaliesList, externalAnomalyTable)
-	}
-	if nssaExternalAnomalyTable != "" {
-		allAnomaliesList = append(allAnomaliesList, nssaExternalAnomalyTable)
-	}
-	if lsdbToRibAnomalyTable != "" {
-		allAnomaliesList = append(allAnomaliesList, lsdbToRibAnomalyTable)
-	}
-	if ribToFibAnomalyTable != "" {
-		allAnomaliesList = append(allAnomaliesList, ribToFibAnomalyTable)
+	if lifecycleTable := createLifecycleTable(anomalyLifecycle, m.textFilter.Query); lifecycleTable != "" {
+		allAnomaliesList = append(allAnomaliesList, lifecycleTable)
 	}
 
 	// Log summary if any anomalies were found
 	if len(allAnomaliesList) > 0 {
-		m.logger.WithAttrs(map[string]interface{}{
-			"total_anomalies":       routerAnomalyCount + externalAnomalyCount + nssaAnomalyCount + lsdbToRibAnomalyCount + ribToFibAnomalyCount,
-			"router_anomalies":      routerAnomalyCount,
-			"external_anomalies":    externalAnomalyCount,
-			"nssa_anomalies":        nssaAnomalyCount,
-			"lsdb_to_rib_anomalies": lsdbToRibAnomalyCount,
-			"rib_to_fib_anomalies":  ribToFibAnomalyCount,
-		}).Info("OSPF anomalies summary")
+		anomalyCounts["total_anomalies"] = totalAnomalies
+		m.logger.WithAttrs(anomalyCounts).Info("OSPF anomalies summary")
 	}
 
 	allAnomalies := lipgloss.JoinVertical(lipgloss.Left, allAnomaliesList...)
@@ -767,6 +656,26 @@ func (m *Model) getDashboardAnomalies() string {
 	return allAnomalies
 }
 
+// builtinCheckTitles are the table titles of the built-in checks, createAnomalyTable derives the
+// columns of the entries from them.
+var builtinCheckTitles = map[string]string{
+	"RouterAnomaly":       "Router Anomalies (Type 1 LSAs)",
+	"ExternalAnomaly":     "External Link State Anomalies (Type 5 LSAs)",
+	"NssaExternalAnomaly": "NSSA External Link State Anomalies (Type 7 LSAs)",
+	"SummaryAnomaly":      "Summary Link State Anomalies (Type 3 LSAs)",
+	"AsbrSummaryAnomaly":  "ASBR Summary Link State Anomalies (Type 4 LSAs)",
+	"LsdbToRib":           "Deviation from the LSDB and RIB",
+	"Ecmp":                "Deviation from the equal-cost paths of the LSDB",
+	"IntentAnomaly":       "Deviation from the declared intent",
+}
+
+func checkTitle(check *frrProto.CheckResult) string {
+	if title, exists := builtinCheckTitles[check.Name]; exists && check.Builtin {
+		return title
+	}
+	return fmt.Sprintf("%s: %s", check.Name, check.Description)
+}
+
 func createAnomalyTable(a *frrProto.AnomalyDetection, lsaTypeHeader string, filterQuery string) string {
 	// extract data for tables
 	var tableData [][]string
@@ -785,10 +694,13 @@ func createAnomalyTable(a *frrProto.AnomalyDetection, lsaTypeHeader string, filt
 			}
 
 			tableData = append(tableData, []string{
+				anomalyArea(superfluousEntry),
 				firstCol,
 				cidr,
 				superfluousEntry.LinkType,
 				"Overadvertised Route",
+				superfluousEntry.Severity,
+				anomalyStatus(superfluousEntry.Suppressed, superfluousEntry.Acknowledged),
 			})
 		}
 	}
@@ -807,25 +719,83 @@ func createAnomalyTable(a *frrProto.AnomalyDetection, lsaTypeHeader string, filt
 			}
 
 			if strings.Contains(lsaTypeHeader, "LSDB and RIB") {
-				anomalyType = "Missing Route"
+				switch missingEntry.RouteSelection {
+				case "not-selected":
+					anomalyType = "Not selected Route"
+				default:
+					anomalyType = "Missing Route"
+				}
 			} else if strings.Contains(lsaTypeHeader, "RIB and FIB") {
 				anomalyType = "Not installed Route"
+			} else if strings.Contains(lsaTypeHeader, "declared intent") {
+				anomalyType = "Intent not met"
 			} else {
 				anomalyType = "Unadvertised Route"
 			}
 
 			tableData = append(tableData, []string{
+				anomalyArea(missingEntry),
 				firstCol,
 				cidr,
 				missingEntry.LinkType,
 				anomalyType,
+				missingEntry.Severity,
+				anomalyStatus(missingEntry.Suppressed, missingEntry.Acknowledged),
 			})
 		}
 	}
 
-	// Order all Table Data
+	// explained deviations are shown with the anomalies of their table, but not counted
+	for _, explainedEntry := range a.ExplainedEntries {
+		anomalyType := "Explained Deviation"
+		if explainedEntry.RouteSelection == "shadowed" {
+			anomalyType = "Shadowed Route"
+		}
+		tableData = append(tableData, []string{
+			anomalyArea(explainedEntry),
+			explainedEntry.LinkStateId,
+			"/" + explainedEntry.PrefixLength,
+			explainedEntry.LinkType,
+			anomalyType,
+			explainedEntry.Severity,
+			"explained",
+		})
+	}
+
+	if a.HasMisconfiguredPrefixes {
+		for _, misconfiguredEntry := range a.MisconfiguredEntries {
+			tableData = append(tableData, []string{
+				anomalyArea(misconfiguredEntry),
+				misconfiguredEntry.LinkStateId,
+				"/" + misconfiguredEntry.PrefixLength,
+				misconfiguredEntry.LinkType,
+				"Misconfigured Route",
+				misconfiguredEntry.Severity,
+				anomalyStatus(misconfiguredEntry.Suppressed, misconfiguredEntry.Acknowledged),
+			})
+		}
+	}
+
+	if a.HasDuplicatePrefixes {
+		for _, duplicateEntry := range a.DuplicateEntries {
+			tableData = append(tableData, []string{
+				anomalyArea(duplicateEntry),
+				duplicateEntry.LinkStateId,
+				"/" + duplicateEntry.PrefixLength,
+				duplicateEntry.LinkType,
+				"Duplicate Route",
+				duplicateEntry.Severity,
+				anomalyStatus(duplicateEntry.Suppressed, duplicateEntry.Acknowledged),
+			})
+		}
+	}
+
+	// Order all Table Data, grouped by area
 	sort.Slice(tableData, func(i, j int) bool {
-		return tableData[i][0] < tableData[j][0]
+		if tableData[i][0] != tableData[j][0] {
+			return lessArea(tableData[i][0], tableData[j][0])
+		}
+		return tableData[i][1] < tableData[j][1]
 	})
 
 	// Apply filter if active
@@ -837,12 +807,16 @@ func createAnomalyTable(a *frrProto.AnomalyDetection, lsaTypeHeader string, filt
 	rows := len(tableData)
 	table := components.NewAnomalyTable(
 		[]string{
+			"Area",
 			"Network Address",
 			"CIDR",
 			"Link Type",
 			"Anomaly Type",
+			"Severity",
+			"Status",
 		},
 		rows,
+		dimmedRows(tableData),
 	)
 	for _, r := range tableData {
 		table = table.Row(r...)
@@ -860,6 +834,125 @@ func createAnomalyTable(a *frrProto.AnomalyDetection, lsaTypeHeader string, filt
 	return tableBox
 }
 
+// createAreaTable summarizes the anomalies of all sources per OSPF area
+func createAreaTable(areas *frrProto.AreaAnomaliesList, filterQuery string) string {
+	var tableData [][]string
+
+	for _, area := range areas.GetAreas() {
+		var sources []string
+		for source := range area.Sources {
+			sources = append(sources, source)
+		}
+		sort.Strings(sources)
+
+		tableData = append(tableData, []string{
+			area.Area,
+			strconv.Itoa(int(area.Total)),
+			strconv.Itoa(int(area.Actionable)),
+			strconv.Itoa(int(area.Critical)),
+			strings.Join(sources, ", "),
+		})
+	}
+
+	if len(tableData) == 0 {
+		return ""
+	}
+
+	if filterQuery != "" {
+		tableData = common.FilterRows(tableData, filterQuery)
+	}
+
+	table := components.NewAnomalyTable(
+		[]string{
+			"Area",
+			"Anomalies",
+			"Actionable",
+			"Critical",
+			"Sources",
+		},
+		len(tableData),
+		map[int]bool{},
+	)
+	for _, r := range tableData {
+		table = table.Row(r...)
+	}
+
+	tableBox := lipgloss.JoinVertical(lipgloss.Left,
+		styles.H1BadTitleStyle().Width(styles.WidthTwoH1ThreeFourth).Render("Anomalies by Area"),
+		styles.H1ContentBoxCenterStyle().Width(styles.WidthTwoH1ThreeFourthBox).Render(table.String()),
+		styles.H1BadBoxBottomBorderStyle().Width(styles.WidthTwoH1ThreeFourth).Render(""),
+	)
+
+	return tableBox
+}
+
+// createLifecycleTable lists all anomalies which passed the hold-down, including recently resolved ones
+func createLifecycleTable(lifecycle *frrProto.AnomalyLifecycleList, filterQuery string) string {
+	var tableData [][]string
+
+	for _, entry := range lifecycle.GetAnomalies() {
+		if !entry.Confirmed {
+			continue
+		}
+
+		state := "active"
+		if !entry.Active {
+			state = "resolved " + time.Unix(entry.ResolvedAt, 0).Format("15:04:05")
+		}
+
+		tableData = append(tableData, []string{
+			entry.Prefix,
+			entry.Source,
+			entry.AnomalyType,
+			state,
+			(time.Duration(entry.DurationSeconds) * time.Second).String(),
+			strconv.Itoa(int(entry.FlapCount)),
+			time.Unix(entry.FirstSeen, 0).Format("01-02 15:04:05"),
+			entry.Severity,
+			anomalyStatus(entry.Suppressed, entry.Acknowledged),
+		})
+	}
+
+	if len(tableData) == 0 {
+		return ""
+	}
+
+	sort.Slice(tableData, func(i, j int) bool {
+		return tableData[i][0] < tableData[j][0]
+	})
+
+	if filterQuery != "" {
+		tableData = common.FilterRows(tableData, filterQuery)
+	}
+
+	table := components.NewAnomalyTable(
+		[]string{
+			"Prefix",
+			"Source",
+			"Anomaly Type",
+			"State",
+			"Duration",
+			"Flaps",
+			"First Seen",
+			"Severity",
+			"Status",
+		},
+		len(tableData),
+		dimmedRows(tableData),
+	)
+	for _, r := range tableData {
+		table = table.Row(r...)
+	}
+
+	tableBox := lipgloss.JoinVertical(lipgloss.Left,
+		styles.H1BadTitleStyle().Width(styles.WidthTwoH1ThreeFourth).Render("Anomaly Lifecycle"),
+		styles.H1ContentBoxCenterStyle().Width(styles.WidthTwoH1ThreeFourthBox).Render(table.String()),
+		styles.H1BadBoxBottomBorderStyle().Width(styles.WidthTwoH1ThreeFourth).Render(""),
+	)
+
+	return tableBox
+}
+
 func (m *Model) renderAnomalyDetails() string {
 	m.viewport.Width = styles.WidthViewPortCompletePage
 	m.viewport.Height = styles.HeightViewPortCompletePage
@@ -875,31 +968,57 @@ func (m *Model) renderAnomalyDetails() string {
 		"Interface addresses that should be announced in Type 1 Router LSAs",
 		"Type 5 External LSAs and Type 7 NSSA External LSAs expected from static routes",
 		"Type 5 External LSAs and Type 7 NSSA External LSAs expected from static routes",
+		"Type 3 Summary LSAs and Type 4 ASBR Summary LSAs expected from an ABR, including area ranges",
 		"LSDB entries that should appear at least once in the FIB",
+		"Equal-cost next-hops derived from the LSDB costs, capped at maximum-paths",
+		"Prefixes, neighbors, area memberships and LSA limits declared in an optional intent file",
+		"Findings of further registered checks, listed under the name of the check",
 	}
 	for i, item := range anomalyPossibilities {
 		anomalyPossibilities[i] = " > " + item // →
 	}
 	anomalyProcessText2 := "\nIt then retrieves the 'is-state' using vtysh queries and compares it against the predicted state.\n" +
-		"If a mismatch is detected, the anomaly is identified and classified into one of the defined types listed below."
+		"If a mismatch is detected, the anomaly is identified and classified into one of the defined types listed below.\n" +
+		"Anomalies are grouped by OSPF area, anomalies of AS external LSAs or the FIB are listed under the area 'none'.\n" +
+		"Anomalies are tracked across analysis cycles: first seen, duration and flap count are listed in the Anomaly Lifecycle table.\n" +
+		"Every anomaly carries a reason code and an explanation of its root cause, e.g. an interface which is down or passive.\n" +
+		"Each anomaly is rated info, warning or critical. Suppressed or acknowledged anomalies are dimmed and keep the dashboard green."
 
 	anomalyTypesTitle := styles.TextTitleStyle.Padding(1, 2, 0, 0).Render("OSPF Anomaly Types")
 	anomalyTypes := [][]string{
 		{"Unadvertised", "A prefix that is expected to be announced (advertised) to other devices in the network but is missing."},
 		{"Overadvertised", "A prefix that is being announced (advertised) to other devices in the network but should not be."},
 		{"Duplicated", "A prefix that is present multiple times in the Link-State Database."},
+		{"Misconfigured", "A prefix that is announced (advertised) with unexpected attributes, e.g. a wrong cost."},
 	}
 	anomalyTypesTable := components.NewAnomalyTypesTable(
 		[]string{
 			"Anomaly Type",
 			"Description",
 		},
-		3,
+		len(anomalyTypes),
 	)
 	for _, r := range anomalyTypes {
 		anomalyTypesTable = anomalyTypesTable.Row(r...)
 	}
 
+	rootCauseTitle := styles.TextTitleStyle.Padding(1, 2, 0, 0).Render("Root Causes of the Current Anomalies")
+	rootCauses := "No anomalies detected."
+	if rootCauseRows := m.getRootCauseRows(); len(rootCauseRows) > 0 {
+		rootCauseTable := components.NewAnomalyTypesTable(
+			[]string{
+				"Anomaly",
+				"Reason Code",
+				"Explanation",
+			},
+			len(rootCauseRows),
+		)
+		for _, r := range rootCauseRows {
+			rootCauseTable = rootCauseTable.Row(r...)
+		}
+		rootCauses = rootCauseTable.String()
+	}
+
 	anomalyDetailsOverlay := lipgloss.JoinVertical(lipgloss.Left,
 		anomalyProcessTitle,
 		anomalyProcessText1,
@@ -907,6 +1026,8 @@ func (m *Model) renderAnomalyDetails() string {
 		anomalyProcessText2,
 		anomalyTypesTitle,
 		anomalyTypesTable.String(),
+		rootCauseTitle,
+		rootCauses,
 	)
 
 	m.viewport.SetContent(anomalyDetailsOverlay)
@@ -914,10 +1035,108 @@ func (m *Model) renderAnomalyDetails() string {
 	return m.viewport.View()
 }
 
+// getRootCauseRows lists the anomalies of all sources with their reason code and explanation
+func (m *Model) getRootCauseRows() [][]string {
+	areaAnomalies, err := backend.GetAreaAnomalies(m.logger)
+	if err != nil {
+		m.logger.WithAttrs(map[string]interface{}{
+			"error": err.Error(),
+		}).Error("Failed to fetch root causes of the anomalies")
+		return nil
+	}
+
+	var tableData [][]string
+	for _, area := range areaAnomalies.GetAreas() {
+		for source, detection := range area.Sources {
+			for anomalyType, entries := range map[string][]*frrProto.Advertisement{
+				"overadvertised": detection.SuperfluousEntries,
+				"unadvertised":   detection.MissingEntries,
+				"duplicate":      detection.DuplicateEntries,
+				"misconfigured":  detection.MisconfiguredEntries,
+			} {
+				for _, entry := range entries {
+					address := entry.InterfaceAddress
+					if address == "" {
+						address = entry.LinkStateId
+					}
+					if entry.PrefixLength != "" {
+						address += "/" + entry.PrefixLength
+					}
+
+					tableData = append(tableData, []string{
+						fmt.Sprintf("%s %s %s (area %s)", source, anomalyType, address, area.Area),
+						entry.ReasonCode,
+						truncateExplanation(entry.Explanation),
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(tableData, func(i, j int) bool {
+		return tableData[i][0] < tableData[j][0]
+	})
+
+	return tableData
+}
+
+// truncateExplanation keeps the root cause table within the minimum supported width of the TUI
+func truncateExplanation(explanation string) string {
+	const maxLength = 70
+	if len(explanation) <= maxLength {
+		return explanation
+	}
+	return explanation[:maxLength-3] + "..."
+}
+
 // ============================== //
 // HELPERS:                       //
 // ============================== //
 
+// anomalyStatus labels anomalies which are silenced by a suppression rule or an acknowledgement
+func anomalyStatus(suppressed bool, acknowledged bool) string {
+	switch {
+	case suppressed:
+		return "suppressed"
+	case acknowledged:
+		return "acknowledged"
+	default:
+		return ""
+	}
+}
+
+// anomalyArea returns the OSPF area of an anomaly, "none" e.g. for AS external LSAs or the FIB
+func anomalyArea(entry *frrProto.Advertisement) string {
+	if entry.GetOspfArea() == "" {
+		return "none"
+	}
+	return entry.GetOspfArea()
+}
+
+// lessArea orders areas numerically, anomalies without an area come last
+func lessArea(first, second string) bool {
+	if first == "none" || second == "none" {
+		return second == "none" && first != "none"
+	}
+
+	firstIP, secondIP := net.ParseIP(first).To4(), net.ParseIP(second).To4()
+	if firstIP == nil || secondIP == nil {
+		return first < second
+	}
+	return binary.BigEndian.Uint32(firstIP) < binary.BigEndian.Uint32(secondIP)
+}
+
+// dimmedRows returns the indices of all rows whose last column holds an anomaly status
+func dimmedRows(tableData [][]string) map[int]bool {
+	result := map[int]bool{}
+	for i, row := range tableData {
+		if len(row) > 0 && row[len(row)-1] != "" {
+			result[i] = true
+		}
+	}
+	return result
+}
+
 // countAnomalies return the total amount of detected anomalies
 func countAnomalies(a *frrProto.AnomalyDetection) int {
 	count := 0
@@ -930,5 +1149,8 @@ func countAnomalies(a *frrProto.AnomalyDetection) int {
 	if a.HasDuplicatePrefixes {
 		count += len(a.DuplicateEntries)
 	}
+	if a.HasMisconfiguredPrefixes {
+		count += len(a.MisconfiguredEntries)
+	}
 	return count
 }
@@ -2,9 +2,12 @@ package backend
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
@@ -20,13 +23,22 @@ const (
 	// Path to the Unix domain socket your analyzer listens on.
 	socketPath        = "/var/run/frr-mad/analyzer.sock"
 	socketDialTimeout = 2 * time.Second
+	// Time a single request may take, including the response.
+	socketRequestTimeout = 30 * time.Second
 
 	// Maximum response size we’re willing to read (for sanity checking).
 	maxResponseSize = 10 * 1024 * 1024 // 10 MB
 )
 
-// SendMessage sends a Message and waits for a Response from the backend.
+// connection is the persistent connection to the backend, shared by all requests.
+var (
+	connection      net.Conn
+	connectionMutex sync.Mutex
+)
 
+// SendMessage sends a Message and waits for a Response from the backend.
+// Requests reuse one connection, which is redialed once if the backend closed it
+// before the message reached it.
 func SendMessage(
 	service string,
 	command string,
@@ -40,35 +52,125 @@ func SendMessage(
 		Params:  params,
 	}
 
-	// Open the Unix socket
-	conn, err := openSocket(socketPath, logger)
+	connectionMutex.Lock()
+	defer connectionMutex.Unlock()
+
+	reused := connection != nil
+	res, unsent, err := exchange(message, logger)
+	if err != nil && reused && unsent {
+		// the backend closes idle connections, a fresh one is tried before giving up. Only a
+		// message the backend can't have processed is sent again, e.g. system/exit runs once.
+		res, _, err = exchange(message, logger)
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer func(conn net.Conn) {
-		err := conn.Close()
+
+	if res.Status != "success" {
+		logger.Error(fmt.Sprintf("Error backend error sending and receiving proto status: %v message: %v", res.Status, res.Message))
+		return nil, fmt.Errorf("backend error: %s", res.Message)
+	}
+
+	return res, nil
+}
+
+// exchange sends the message over the persistent connection and reads the response.
+// The connection is dropped on any error. unsent reports a failure which shows that the
+// backend didn't process the message: it couldn't be written, or the backend closed the
+// connection before any byte of a response.
+func exchange(message *frrProto.Message, logger *logger.Logger) (res *frrProto.Response, unsent bool, err error) {
+	if connection == nil {
+		conn, err := openSocket(socketPath, logger)
 		if err != nil {
-			// todo: log to logger
-			logger.Error(fmt.Sprintf("Failed to close connection: %s\n", err))
-			fmt.Printf("Failed to close connection: %s\n", err)
+			return nil, false, err
 		}
-	}(conn)
+		connection = conn
+	}
 
-	if err := sendProto(conn, message, logger); err != nil {
-		return nil, err
+	res, unsent, err = func() (*frrProto.Response, bool, error) {
+		if err := connection.SetDeadline(time.Now().Add(socketRequestTimeout)); err != nil {
+			return nil, true, fmt.Errorf("failed setting deadline: %w", err)
+		}
+		if err := sendProto(connection, message, logger); err != nil {
+			return nil, true, err
+		}
+		res, err := receiveProto(connection, logger)
+		return res, errors.Is(err, io.EOF), err
+	}()
+	if err != nil {
+		closeConnection(logger)
+		return nil, unsent, err
 	}
 
-	res, err := receiveProto(conn, logger)
+	return res, false, nil
+}
+
+func closeConnection(logger *logger.Logger) {
+	if connection == nil {
+		return
+	}
+	if err := connection.Close(); err != nil {
+		logger.Error(fmt.Sprintf("Failed to close connection: %s\n", err))
+	}
+	connection = nil
+}
+
+// Subscribe opens a dedicated connection on which the backend pushes the responses of the
+// given topics, e.g. anomalies or neighbors, whenever they change. The channel is closed when
+// the connection ends, cancel ends it from the client side.
+func Subscribe(topics []string, logger *logger.Logger) (<-chan *frrProto.Response, func(), error) {
+	conn, err := openSocket(socketPath, logger)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	message := &frrProto.Message{
+		Service: "subscribe",
+		Command: strings.Join(topics, ","),
+	}
+	if err := conn.SetDeadline(time.Now().Add(socketRequestTimeout)); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed setting deadline: %w", err)
+	}
+	if err := sendProto(conn, message, logger); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	res, err := receiveProto(conn, logger)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
 	if res.Status != "success" {
-		logger.Error(fmt.Sprintf("Error backend error sending and receiving proto status: %v message: %v", res.Status, res.Message))
-		return nil, fmt.Errorf("backend error: %s", res.Message)
+		conn.Close()
+		logger.Error(fmt.Sprintf("Error backend rejected subscription: %v", res.Message))
+		return nil, nil, fmt.Errorf("backend error: %s", res.Message)
+	}
+
+	// pushed frames arrive at any time, only requests are bound to a deadline
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed clearing deadline: %w", err)
+	}
+
+	frames := make(chan *frrProto.Response, len(topics))
+	go func() {
+		defer close(frames)
+		for {
+			frame, err := receiveProto(conn, logger)
+			if err != nil {
+				return
+			}
+			frames <- frame
+		}
+	}()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() { conn.Close() })
 	}
 
-	return res, nil
+	return frames, cancel, nil
 }
 
 // openSocket dials the Unix‐domain socket at path and returns a live connection.
@@ -148,6 +250,16 @@ func GetRouterName(logger *logger.Logger) (string, string, error) {
 	return routerName, ospfRouterId, nil
 }
 
+// GetHello returns the protocol version and the capabilities of the daemon.
+func GetHello(logger *logger.Logger) (*frrProto.Hello, error) {
+	response, err := SendMessage("system", "hello", nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.GetHello(), nil
+}
+
 func GetSystemResources(logger *logger.Logger) (int64, float64, float64, error) {
 
 	response, err := SendMessage("system", "allResources", nil, logger)
@@ -370,6 +482,42 @@ func GetRibToFibAnomalies(logger *logger.Logger) (*frrProto.AnomalyDetection, er
 	return response.Data.GetAnomaly(), nil
 }
 
+func GetCheckResults(logger *logger.Logger) (*frrProto.CheckResultList, error) {
+	response, err := SendMessage("analysis", "checks", nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.GetCheckResults(), nil
+}
+
+func GetAreaAnomalies(logger *logger.Logger) (*frrProto.AreaAnomaliesList, error) {
+	response, err := SendMessage("analysis", "areas", nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.GetAreaAnomalies(), nil
+}
+
+func GetLintResult(logger *logger.Logger) (*frrProto.LintResult, error) {
+	response, err := SendMessage("analysis", "lint", nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.GetLintResult(), nil
+}
+
+func GetAnomalyLifecycle(logger *logger.Logger) (*frrProto.AnomalyLifecycleList, error) {
+	response, err := SendMessage("analysis", "lifecycle", nil, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.GetAnomalyLifecycle(), nil
+}
+
 func GetParsedShouldStates(logger *logger.Logger) (*frrProto.ParsedAnalyzerData, error) {
 	response, err := SendMessage("analysis", "shouldParsedLsdb", nil, logger)
 	if err != nil {
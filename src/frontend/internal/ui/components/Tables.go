@@ -50,7 +50,8 @@ func NewOspfMonitorMultilineTable(headers []string, rows int) *ltable.Table {
 	return t
 }
 
-func NewAnomalyTable(headers []string, rows int) *ltable.Table {
+// NewAnomalyTable renders rows contained in dimmedRows (suppressed or acknowledged anomalies) dimmed.
+func NewAnomalyTable(headers []string, rows int, dimmedRows map[int]bool) *ltable.Table {
 	t := ltable.New().
 		Border(lipgloss.NormalBorder()).
 		BorderTop(true).
@@ -64,6 +65,8 @@ func NewAnomalyTable(headers []string, rows int) *ltable.Table {
 			switch {
 			case row == ltable.HeaderRow:
 				return styles.HeaderStyle
+			case dimmedRows[row]:
+				return styles.DimmedCellStyle
 			default:
 				return styles.NormalCellStyle
 			}
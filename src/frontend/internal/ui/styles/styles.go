@@ -513,6 +513,7 @@ var (
 	LastCellOfMultiline     = lipgloss.NewStyle().Padding(0, 1)
 	BadCellStyle            = lipgloss.NewStyle().Padding(0, 1)
 	EvenRowCell             = NormalCellStyle.Foreground(lipgloss.Color(LightBlue))
+	DimmedCellStyle         = NormalCellStyle.Foreground(lipgloss.Color(Grey))
 )
 
 func BuildTableStyles() table.Styles {
@@ -14,7 +14,7 @@ type Logger struct {
 	logger   *slog.Logger
 	file     *os.File
 	filePath string
-	level    slog.Level
+	level    *slog.LevelVar // shared with the loggers derived by WithComponent and WithAttrs
 }
 
 var (
@@ -32,8 +32,10 @@ func NewApplicationLogger(appName, filePath string) (*Logger, error) {
 	}
 
 	// Slog is thread-safe, each log Operation like Info() or Error() are atomic
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelInfo)
 	opts := &slog.HandlerOptions{
-		Level: slog.LevelInfo,
+		Level: level,
 	}
 	handler := slog.NewJSONHandler(file, opts)
 
@@ -44,7 +46,7 @@ func NewApplicationLogger(appName, filePath string) (*Logger, error) {
 		logger:   slogger,
 		file:     file,
 		filePath: filePath,
-		level:    slog.LevelInfo,
+		level:    level,
 	}
 
 	registry[appName] = logger
@@ -80,14 +82,10 @@ func (l *Logger) Close() error {
 	return l.file.Close()
 }
 
+// SetDebugLevel changes the level of the logger and of all loggers derived from it. It may be
+// called while other goroutines are logging.
 func (l *Logger) SetDebugLevel(level slog.Level) {
-	registryMu.Lock()
-	defer registryMu.Unlock()
-
-	l.level = level
-	opts := &slog.HandlerOptions{Level: level}
-	newHandler := slog.NewJSONHandler(l.file, opts)
-	l.logger = slog.New(newHandler).With("logger", l.name)
+	l.level.Set(level)
 }
 
 func ConvertLogLevelFromConfig(level string) slog.Level {
@@ -108,9 +106,7 @@ func ConvertLogLevelFromConfig(level string) slog.Level {
 }
 
 func (l *Logger) GetDebugLevel() slog.Level {
-	registryMu.Lock()
-	defer registryMu.Unlock()
-	return l.level
+	return l.level.Level()
 }
 
 func (l *Logger) SetInfoMode() {
@@ -134,28 +130,28 @@ func (l *Logger) SetNoneMode() {
 }
 
 func (l *Logger) Debug(msg string) error {
-	if l.level <= slog.LevelDebug && l.level != LevelNone {
+	if level := l.level.Level(); level <= slog.LevelDebug && level != LevelNone {
 		l.logger.Debug(msg)
 	}
 	return nil
 }
 
 func (l *Logger) Info(msg string) error {
-	if l.level <= slog.LevelInfo && l.level != LevelNone {
+	if level := l.level.Level(); level <= slog.LevelInfo && level != LevelNone {
 		l.logger.Info(msg)
 	}
 	return nil
 }
 
 func (l *Logger) Warning(msg string) error {
-	if l.level <= slog.LevelWarn && l.level != LevelNone {
+	if level := l.level.Level(); level <= slog.LevelWarn && level != LevelNone {
 		l.logger.Warn(msg)
 	}
 	return nil
 }
 
 func (l *Logger) Error(msg string) error {
-	if l.level <= slog.LevelError && l.level != LevelNone {
+	if level := l.level.Level(); level <= slog.LevelError && level != LevelNone {
 		l.logger.Error(msg)
 	}
 	return nil